@@ -4,6 +4,8 @@ import (
 	"flag"
 	harbor "github.com/nevercase/harbor-api"
 	"k8s.io/klog/v2"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
 )
 
 type arrayFlags []string
@@ -26,6 +28,7 @@ var (
 	dockerPassword arrayFlags
 	rbacRulePath   string
 	rbacMysqlPath  string
+	dynamicConfig  string
 )
 
 func init() {
@@ -37,6 +40,7 @@ func init() {
 	flag.Var(&dockerPassword, "dockerpwd", "The password of the Harbor's password")
 	flag.StringVar(&rbacRulePath, "rbacRulePath", "", "The path of the rbac rule.")
 	flag.StringVar(&rbacMysqlPath, "rbacMysqlPath", "", "The path of the rbac mysql.")
+	flag.StringVar(&dynamicConfig, "dynamic-config", "", "The YAML file of the custom resources which were served with the dynamic client, the same one as the controller's.")
 }
 
 type Config interface {
@@ -46,6 +50,7 @@ type Config interface {
 	DockerHub() []harbor.Config
 	RbacRulePath() string
 	RbacMysqlPath() string
+	DynamicResources() []k8sCoreV1.DynamicResource
 }
 
 type config struct {
@@ -55,6 +60,7 @@ type config struct {
 	dockerHub     []harbor.Config
 	rbacRulePath  string
 	rbacMysqlPath string
+	dynamic       []k8sCoreV1.DynamicResource
 }
 
 func (c *config) MasterUrl() string {
@@ -80,6 +86,10 @@ func (c *config) RbacMysqlPath() string {
 	return c.rbacMysqlPath
 }
 
+func (c *config) DynamicResources() []k8sCoreV1.DynamicResource {
+	return c.dynamic
+}
+
 func Init() Config {
 	dockerHub := make([]harbor.Config, 0)
 	for k, url := range dockerUrl {
@@ -97,6 +107,16 @@ func Init() Config {
 		})
 	}
 	klog.Info("dockerhub:", dockerHub)
+	dynamic := make([]k8sCoreV1.DynamicResource, 0)
+	if dynamicConfig != "" {
+		configs, err := k8sCoreV1.ReadDynamicConfigs(dynamicConfig)
+		if err != nil {
+			klog.Fatal(err)
+		}
+		for _, v := range configs {
+			dynamic = append(dynamic, v.Resource)
+		}
+	}
 	return &config{
 		masterUrl:     masterUrl,
 		kubeConfig:    kubeconfig,
@@ -104,5 +124,6 @@ func Init() Config {
 		dockerHub:     dockerHub,
 		rbacRulePath:  rbacRulePath,
 		rbacMysqlPath: rbacMysqlPath,
+		dynamic:       dynamic,
 	}
 }
@@ -0,0 +1,53 @@
+package group
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+)
+
+// dynamicResource is held by the Option of a custom resource which was declared in the config,
+// the objects of it were the *unstructured.Unstructured
+type dynamicResource struct {
+	gvk    schema.GroupVersionKind
+	client dynamic.NamespaceableResourceInterface
+}
+
+// NewDynamicOption returns the Option of the custom resource of r, whose ResourceType was the kind of r
+func NewDynamicOption(client dynamic.Interface, r k8sCoreV1.DynamicResource) Option {
+	return NewOption(ResourceType(r.Kind), &dynamicResource{
+		gvk:    r.GroupVersionKind(),
+		client: client.Resource(r.GroupVersionResource()),
+	})
+}
+
+// dynamic returns the dynamicResource of rt if rt was declared in the config
+func (r *resource) dynamic(rt ResourceType) (*dynamicResource, bool) {
+	opt, err := r.options.Get(rt)
+	if err != nil {
+		return nil, false
+	}
+	d, ok := opt.Get().(*dynamicResource)
+	return d, ok
+}
+
+func (r *resource) IsDynamic(rt ResourceType) bool {
+	_, ok := r.dynamic(rt)
+	return ok
+}
+
+// object returns obj with the apiVersion and the kind of d, which the dashboard may leave out
+func (d *dynamicResource) object(obj interface{}) (*unstructured.Unstructured, error) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("err unexpected object %T of %s", obj, d.gvk.Kind)
+	}
+	if u.GetKind() == "" {
+		u.SetGroupVersionKind(d.gvk)
+	}
+	return u, nil
+}
@@ -5,6 +5,8 @@ import (
 
 	harbor "github.com/nevercase/harbor-api"
 	"k8s.io/apimachinery/pkg/watch"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
 )
 
 type Group interface {
@@ -14,10 +16,10 @@ type Group interface {
 	WatchEvents() chan watch.Event
 }
 
-func NewGroup(ctx context.Context, masterUrl, kubeConfigPath string, dockerHub []harbor.Config) Group {
+func NewGroup(ctx context.Context, masterUrl, kubeConfigPath string, dockerHub []harbor.Config, dynamicResources []k8sCoreV1.DynamicResource) Group {
 	events := make(chan watch.Event, 1024)
 	var g = &group{
-		resource: NewResource(ctx, masterUrl, kubeConfigPath, events),
+		resource: NewResource(ctx, masterUrl, kubeConfigPath, events, dynamicResources),
 		harbor:   harbor.NewHub(dockerHub),
 		events:   events,
 	}
@@ -7,14 +7,17 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/klog/v2"
 
 	helixsagaoperatorv1 "github.com/Shanghai-Lunara/helixsaga-operator/pkg/apis/helixsaga/v1"
 	helixsagaclientset "github.com/Shanghai-Lunara/helixsaga-operator/pkg/generated/helixsaga/clientset/versioned"
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
 	mysqloperatorv1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
 	redisoperatorv1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/redisoperator/v1"
 	mysqlclientset "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned"
@@ -47,6 +50,8 @@ const (
 
 	// custom resource definition
 	MysqlOperator     ResourceType = "MysqlOperator"
+	MysqlDatabase     ResourceType = "MysqlDatabase"
+	MysqlUser         ResourceType = "MysqlUser"
 	RedisOperator     ResourceType = "RedisOperator"
 	HelixSagaOperator ResourceType = "HelixSagaOperator"
 )
@@ -66,10 +71,12 @@ type ResourceInterface interface {
 	List(rt ResourceType, nameSpace string, selector labels.Selector) (res interface{}, err error)
 	Watch(rt ResourceType, nameSpace string, selector labels.Selector, eventsChan chan watch.Event) (err error)
 	ResourceTypes() []ResourceType
+	// IsDynamic reports whether rt was declared in the dynamic config, whose objects were *unstructured.Unstructured
+	IsDynamic(rt ResourceType) bool
 }
 
-// NewResource returns a ResourceInterface
-func NewResource(ctx context.Context, masterUrl, kubeconfigPath string, eventsChan chan watch.Event) ResourceInterface {
+// NewResource returns a ResourceInterface, the custom resources of dynamicResources were served with the dynamic client
+func NewResource(ctx context.Context, masterUrl, kubeconfigPath string, eventsChan chan watch.Event, dynamicResources []k8sCoreV1.DynamicResource) ResourceInterface {
 	cfg, err := clientcmd.BuildConfigFromFlags(masterUrl, kubeconfigPath)
 	if err != nil {
 		klog.Fatalf("Error building kubeconfig: %s", err.Error())
@@ -90,6 +97,10 @@ func NewResource(ctx context.Context, masterUrl, kubeconfigPath string, eventsCh
 	if err != nil {
 		klog.Fatalf("Error building redisclientset: %s", err.Error())
 	}
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		klog.Fatalf("Error building dynamic client: %s", err.Error())
+	}
 	opts := NewOptions()
 	var empty interface{}
 	opts.Add(
@@ -100,9 +111,17 @@ func NewResource(ctx context.Context, masterUrl, kubeconfigPath string, eventsCh
 		NewOption(Service, empty),
 		NewOption(ServiceAccount, empty),
 		NewOption(MysqlOperator, mysql),
+		NewOption(MysqlDatabase, mysql),
+		NewOption(MysqlUser, mysql),
 		NewOption(RedisOperator, redis),
 		NewOption(HelixSagaOperator, helixsaga),
 	)
+	for _, v := range dynamicResources {
+		if _, err := opts.Get(ResourceType(v.Kind)); err == nil {
+			klog.Fatalf("Error adding the dynamic ResourceType:%s which was compiled in", v.Kind)
+		}
+		opts.Add(NewDynamicOption(dynamicClient, v))
+	}
 	ctx2, cancel := context.WithCancel(ctx)
 	timeout, _ := env.GetExecutionTimeoutDuration()
 	var r = &resource{
@@ -113,7 +132,7 @@ func NewResource(ctx context.Context, masterUrl, kubeconfigPath string, eventsCh
 		cancel:                cancel,
 	}
 	for _, v := range opts.GetOptionTypeList() {
-		if v != Pod && v != ConfigMap && v != MysqlOperator && v != RedisOperator && v != HelixSagaOperator {
+		if _, ok := r.dynamic(v); !ok && v != Pod && v != ConfigMap && v != MysqlOperator && v != MysqlDatabase && v != MysqlUser && v != RedisOperator && v != HelixSagaOperator {
 			continue
 		}
 		if err := r.Watch(v, "", labels.NewSelector(), eventsChan); err != nil {
@@ -148,6 +167,16 @@ func (r *resource) Create(rt ResourceType, nameSpace string, obj interface{}) (r
 			break
 		}
 		res, err = opt.Get().(*mysqlclientset.Clientset).NevercaseV1().MysqlOperators(nameSpace).Create(ctx, obj.(*mysqloperatorv1.MysqlOperator), createOpt)
+	case MysqlDatabase:
+		if opt, err = r.options.Get(rt); err != nil {
+			break
+		}
+		res, err = opt.Get().(*mysqlclientset.Clientset).NevercaseV1().MysqlDatabases(nameSpace).Create(ctx, obj.(*mysqloperatorv1.MysqlDatabase), createOpt)
+	case MysqlUser:
+		if opt, err = r.options.Get(rt); err != nil {
+			break
+		}
+		res, err = opt.Get().(*mysqlclientset.Clientset).NevercaseV1().MysqlUsers(nameSpace).Create(ctx, obj.(*mysqloperatorv1.MysqlUser), createOpt)
 	case RedisOperator:
 		if opt, err = r.options.Get(rt); err != nil {
 			break
@@ -158,6 +187,16 @@ func (r *resource) Create(rt ResourceType, nameSpace string, obj interface{}) (r
 			break
 		}
 		res, err = opt.Get().(*helixsagaclientset.Clientset).NevercaseV1().HelixSagas(nameSpace).Create(ctx, obj.(*helixsagaoperatorv1.HelixSaga), createOpt)
+	default:
+		d, ok := r.dynamic(rt)
+		if !ok {
+			break
+		}
+		var u *unstructured.Unstructured
+		if u, err = d.object(obj); err != nil {
+			break
+		}
+		res, err = d.client.Namespace(nameSpace).Create(ctx, u, createOpt)
 	}
 	cancel()
 	if err != nil {
@@ -180,6 +219,16 @@ func (r *resource) Update(rt ResourceType, nameSpace string, obj interface{}) (r
 			break
 		}
 		res, err = opt.Get().(*mysqlclientset.Clientset).NevercaseV1().MysqlOperators(nameSpace).Update(ctx, obj.(*mysqloperatorv1.MysqlOperator), updateOpt)
+	case MysqlDatabase:
+		if opt, err = r.options.Get(rt); err != nil {
+			break
+		}
+		res, err = opt.Get().(*mysqlclientset.Clientset).NevercaseV1().MysqlDatabases(nameSpace).Update(ctx, obj.(*mysqloperatorv1.MysqlDatabase), updateOpt)
+	case MysqlUser:
+		if opt, err = r.options.Get(rt); err != nil {
+			break
+		}
+		res, err = opt.Get().(*mysqlclientset.Clientset).NevercaseV1().MysqlUsers(nameSpace).Update(ctx, obj.(*mysqloperatorv1.MysqlUser), updateOpt)
 	case RedisOperator:
 		if opt, err = r.options.Get(rt); err != nil {
 			break
@@ -190,6 +239,16 @@ func (r *resource) Update(rt ResourceType, nameSpace string, obj interface{}) (r
 			break
 		}
 		res, err = opt.Get().(*helixsagaclientset.Clientset).NevercaseV1().HelixSagas(nameSpace).Update(ctx, obj.(*helixsagaoperatorv1.HelixSaga), updateOpt)
+	default:
+		d, ok := r.dynamic(rt)
+		if !ok {
+			break
+		}
+		var u *unstructured.Unstructured
+		if u, err = d.object(obj); err != nil {
+			break
+		}
+		res, err = d.client.Namespace(nameSpace).Update(ctx, u, updateOpt)
 	}
 	cancel()
 	if err != nil {
@@ -214,6 +273,16 @@ func (r *resource) Delete(rt ResourceType, nameSpace, specName string) (err erro
 			break
 		}
 		err = opt.Get().(*mysqlclientset.Clientset).NevercaseV1().MysqlOperators(nameSpace).Delete(ctx, specName, delOpts)
+	case MysqlDatabase:
+		if opt, err = r.options.Get(rt); err != nil {
+			break
+		}
+		err = opt.Get().(*mysqlclientset.Clientset).NevercaseV1().MysqlDatabases(nameSpace).Delete(ctx, specName, delOpts)
+	case MysqlUser:
+		if opt, err = r.options.Get(rt); err != nil {
+			break
+		}
+		err = opt.Get().(*mysqlclientset.Clientset).NevercaseV1().MysqlUsers(nameSpace).Delete(ctx, specName, delOpts)
 	case RedisOperator:
 		if opt, err = r.options.Get(rt); err != nil {
 			break
@@ -224,6 +293,10 @@ func (r *resource) Delete(rt ResourceType, nameSpace, specName string) (err erro
 			break
 		}
 		err = opt.Get().(*helixsagaclientset.Clientset).NevercaseV1().HelixSagas(nameSpace).Delete(ctx, specName, delOpts)
+	default:
+		if d, ok := r.dynamic(rt); ok {
+			err = d.client.Namespace(nameSpace).Delete(ctx, specName, delOpts)
+		}
 	}
 	cancel()
 	if err != nil {
@@ -254,6 +327,16 @@ func (r *resource) Get(rt ResourceType, nameSpace, specName string) (res interfa
 			break
 		}
 		res, err = opt.Get().(*mysqlclientset.Clientset).NevercaseV1().MysqlOperators(nameSpace).Get(ctx, specName, getOpts)
+	case MysqlDatabase:
+		if opt, err = r.options.Get(rt); err != nil {
+			break
+		}
+		res, err = opt.Get().(*mysqlclientset.Clientset).NevercaseV1().MysqlDatabases(nameSpace).Get(ctx, specName, getOpts)
+	case MysqlUser:
+		if opt, err = r.options.Get(rt); err != nil {
+			break
+		}
+		res, err = opt.Get().(*mysqlclientset.Clientset).NevercaseV1().MysqlUsers(nameSpace).Get(ctx, specName, getOpts)
 	case RedisOperator:
 		if opt, err = r.options.Get(rt); err != nil {
 			break
@@ -264,6 +347,10 @@ func (r *resource) Get(rt ResourceType, nameSpace, specName string) (res interfa
 			break
 		}
 		res, err = opt.Get().(*helixsagaclientset.Clientset).NevercaseV1().HelixSagas(nameSpace).Get(ctx, specName, getOpts)
+	default:
+		if d, ok := r.dynamic(rt); ok {
+			res, err = d.client.Namespace(nameSpace).Get(ctx, specName, getOpts)
+		}
 	}
 	cancel()
 	if err != nil {
@@ -298,6 +385,16 @@ func (r *resource) List(rt ResourceType, nameSpace string, selector labels.Selec
 			break
 		}
 		res, err = opt.Get().(*mysqlclientset.Clientset).NevercaseV1().MysqlOperators(nameSpace).List(ctx, opts)
+	case MysqlDatabase:
+		if opt, err = r.options.Get(rt); err != nil {
+			break
+		}
+		res, err = opt.Get().(*mysqlclientset.Clientset).NevercaseV1().MysqlDatabases(nameSpace).List(ctx, opts)
+	case MysqlUser:
+		if opt, err = r.options.Get(rt); err != nil {
+			break
+		}
+		res, err = opt.Get().(*mysqlclientset.Clientset).NevercaseV1().MysqlUsers(nameSpace).List(ctx, opts)
 	case RedisOperator:
 		if opt, err = r.options.Get(rt); err != nil {
 			break
@@ -308,6 +405,10 @@ func (r *resource) List(rt ResourceType, nameSpace string, selector labels.Selec
 			break
 		}
 		res, err = opt.Get().(*helixsagaclientset.Clientset).NevercaseV1().HelixSagas(nameSpace).List(ctx, opts)
+	default:
+		if d, ok := r.dynamic(rt); ok {
+			res, err = d.client.Namespace(nameSpace).List(ctx, opts)
+		}
 	}
 	cancel()
 	if err != nil {
@@ -343,6 +444,16 @@ func (r *resource) Watch(rt ResourceType, nameSpace string, selector labels.Sele
 			break
 		}
 		res, err = opt.Get().(*mysqlclientset.Clientset).NevercaseV1().MysqlOperators(nameSpace).Watch(ctx, opts)
+	case MysqlDatabase:
+		if opt, err = r.options.Get(rt); err != nil {
+			break
+		}
+		res, err = opt.Get().(*mysqlclientset.Clientset).NevercaseV1().MysqlDatabases(nameSpace).Watch(ctx, opts)
+	case MysqlUser:
+		if opt, err = r.options.Get(rt); err != nil {
+			break
+		}
+		res, err = opt.Get().(*mysqlclientset.Clientset).NevercaseV1().MysqlUsers(nameSpace).Watch(ctx, opts)
 	case RedisOperator:
 		if opt, err = r.options.Get(rt); err != nil {
 			break
@@ -353,6 +464,10 @@ func (r *resource) Watch(rt ResourceType, nameSpace string, selector labels.Sele
 			break
 		}
 		res, err = opt.Get().(*helixsagaclientset.Clientset).NevercaseV1().HelixSagas(nameSpace).Watch(ctx, opts)
+	default:
+		if d, ok := r.dynamic(rt); ok {
+			res, err = d.client.Namespace(nameSpace).Watch(ctx, opts)
+		}
 	}
 	if err != nil {
 		klog.V(2).Info(err)
@@ -9,8 +9,10 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/klog/v2"
 	"reflect"
@@ -103,6 +105,28 @@ func (h *k8sHandle) Create(ctx context.Context, req proto.Param, obj []byte) (re
 		}
 		e = convertMysqlCrdToProto(n.(*mysqloperatorv1.MysqlOperator))
 		res, err = e.Marshal()
+	case group.MysqlDatabase:
+		var e proto.MysqlDatabaseCrd
+		if err = e.Unmarshal(obj); err != nil {
+			break
+		}
+		m := convertProtoToMysqlDatabaseCrd(req, e)
+		if n, err = resourceCreate(h.group, req, m.Name, m); err != nil {
+			break
+		}
+		e = convertMysqlDatabaseCrdToProto(n.(*mysqloperatorv1.MysqlDatabase))
+		res, err = e.Marshal()
+	case group.MysqlUser:
+		var e proto.MysqlUserCrd
+		if err = e.Unmarshal(obj); err != nil {
+			break
+		}
+		m := convertProtoToMysqlUserCrd(req, e)
+		if n, err = resourceCreate(h.group, req, m.Name, m); err != nil {
+			break
+		}
+		e = convertMysqlUserCrdToProto(n.(*mysqloperatorv1.MysqlUser))
+		res, err = e.Marshal()
 	case group.RedisOperator:
 		var e proto.RedisCrd
 		if err = e.Unmarshal(obj); err != nil {
@@ -125,6 +149,19 @@ func (h *k8sHandle) Create(ctx context.Context, req proto.Param, obj []byte) (re
 		}
 		e = covertHelixSagaCrdToProto(n.(*helixsagaoperatorv1.HelixSaga))
 		res, err = e.Marshal()
+	default:
+		// The custom resources which were declared in the dynamic config were carried in the JSON
+		if !h.group.Resource().IsDynamic(req.ResourceType) {
+			break
+		}
+		var u *unstructured.Unstructured
+		if u, err = decodeUnstructured(req, obj); err != nil {
+			break
+		}
+		if n, err = resourceCreate(h.group, req, u.GetName(), u); err != nil {
+			break
+		}
+		res, err = encodeUnstructured(n)
 	}
 	if err != nil {
 		klog.V(2).Info(err)
@@ -180,6 +217,28 @@ func (h *k8sHandle) Update(ctx context.Context, req proto.Param, obj []byte) (re
 		}
 		e = convertMysqlCrdToProto(n.(*mysqloperatorv1.MysqlOperator))
 		res, err = e.Marshal()
+	case group.MysqlDatabase:
+		var e proto.MysqlDatabaseCrd
+		if err = e.Unmarshal(obj); err != nil {
+			break
+		}
+		m := convertProtoToMysqlDatabaseCrd(req, e)
+		if n, err = resourceUpdate(h.group, req, m.Name, m); err != nil {
+			break
+		}
+		e = convertMysqlDatabaseCrdToProto(n.(*mysqloperatorv1.MysqlDatabase))
+		res, err = e.Marshal()
+	case group.MysqlUser:
+		var e proto.MysqlUserCrd
+		if err = e.Unmarshal(obj); err != nil {
+			break
+		}
+		m := convertProtoToMysqlUserCrd(req, e)
+		if n, err = resourceUpdate(h.group, req, m.Name, m); err != nil {
+			break
+		}
+		e = convertMysqlUserCrdToProto(n.(*mysqloperatorv1.MysqlUser))
+		res, err = e.Marshal()
 	case group.RedisOperator:
 		var e proto.RedisCrd
 		if err = e.Unmarshal(obj); err != nil {
@@ -202,6 +261,18 @@ func (h *k8sHandle) Update(ctx context.Context, req proto.Param, obj []byte) (re
 		}
 		e = covertHelixSagaCrdToProto(n.(*helixsagaoperatorv1.HelixSaga))
 		res, err = e.Marshal()
+	default:
+		if !h.group.Resource().IsDynamic(req.ResourceType) {
+			break
+		}
+		var u *unstructured.Unstructured
+		if u, err = decodeUnstructured(req, obj); err != nil {
+			break
+		}
+		if n, err = resourceUpdate(h.group, req, u.GetName(), u); err != nil {
+			break
+		}
+		res, err = encodeUnstructured(n)
 	}
 	if err != nil {
 		klog.V(2).Info(err)
@@ -237,6 +308,18 @@ func (h *k8sHandle) Delete(ctx context.Context, req proto.Param, obj []byte) (er
 			break
 		}
 		name = e.Name
+	case group.MysqlDatabase:
+		var e proto.MysqlDatabaseCrd
+		if err = e.Unmarshal(obj); err != nil {
+			break
+		}
+		name = e.Name
+	case group.MysqlUser:
+		var e proto.MysqlUserCrd
+		if err = e.Unmarshal(obj); err != nil {
+			break
+		}
+		name = e.Name
 	case group.RedisOperator:
 		var e proto.RedisCrd
 		if err = e.Unmarshal(obj); err != nil {
@@ -249,6 +332,15 @@ func (h *k8sHandle) Delete(ctx context.Context, req proto.Param, obj []byte) (er
 			break
 		}
 		name = e.Name
+	default:
+		if !h.group.Resource().IsDynamic(req.ResourceType) {
+			break
+		}
+		var u *unstructured.Unstructured
+		if u, err = decodeUnstructured(req, obj); err != nil {
+			break
+		}
+		name = u.GetName()
 	}
 	if err != nil {
 		klog.V(2).Info(err)
@@ -327,6 +419,28 @@ func (h *k8sHandle) Get(ctx context.Context, req proto.Param, obj []byte) (res [
 		}
 		e = convertMysqlCrdToProto(n.(*mysqloperatorv1.MysqlOperator))
 		res, err = e.Marshal()
+	case group.MysqlDatabase:
+		var e proto.MysqlDatabaseCrd
+		if err = e.Unmarshal(obj); err != nil {
+			break
+		}
+		n, err = h.group.Resource().Get(req.ResourceType, req.NameSpace, e.Name)
+		if err != nil {
+			break
+		}
+		e = convertMysqlDatabaseCrdToProto(n.(*mysqloperatorv1.MysqlDatabase))
+		res, err = e.Marshal()
+	case group.MysqlUser:
+		var e proto.MysqlUserCrd
+		if err = e.Unmarshal(obj); err != nil {
+			break
+		}
+		n, err = h.group.Resource().Get(req.ResourceType, req.NameSpace, e.Name)
+		if err != nil {
+			break
+		}
+		e = convertMysqlUserCrdToProto(n.(*mysqloperatorv1.MysqlUser))
+		res, err = e.Marshal()
 	case group.RedisOperator:
 		var e proto.RedisCrd
 		if err = e.Unmarshal(obj); err != nil {
@@ -349,6 +463,18 @@ func (h *k8sHandle) Get(ctx context.Context, req proto.Param, obj []byte) (res [
 		}
 		e = covertHelixSagaCrdToProto(n.(*helixsagaoperatorv1.HelixSaga))
 		res, err = e.Marshal()
+	default:
+		if !h.group.Resource().IsDynamic(req.ResourceType) {
+			break
+		}
+		var u *unstructured.Unstructured
+		if u, err = decodeUnstructured(req, obj); err != nil {
+			break
+		}
+		if n, err = h.group.Resource().Get(req.ResourceType, req.NameSpace, u.GetName()); err != nil {
+			break
+		}
+		res, err = encodeUnstructured(n)
 	}
 	if err != nil {
 		klog.V(2).Info(err)
@@ -443,6 +569,22 @@ func (h *k8sHandle) List(ctx context.Context, req proto.Param) (res []byte, err
 			m.Items = append(m.Items, convertMysqlCrdToProto(&v))
 		}
 		res, err = m.Marshal()
+	case group.MysqlDatabase:
+		m := proto.MysqlDatabaseCrdList{
+			Items: make([]proto.MysqlDatabaseCrd, 0),
+		}
+		for _, v := range d.(*mysqloperatorv1.MysqlDatabaseList).Items {
+			m.Items = append(m.Items, convertMysqlDatabaseCrdToProto(&v))
+		}
+		res, err = m.Marshal()
+	case group.MysqlUser:
+		m := proto.MysqlUserCrdList{
+			Items: make([]proto.MysqlUserCrd, 0),
+		}
+		for _, v := range d.(*mysqloperatorv1.MysqlUserList).Items {
+			m.Items = append(m.Items, convertMysqlUserCrdToProto(&v))
+		}
+		res, err = m.Marshal()
 	case group.RedisOperator:
 		m := proto.RedisCrdList{
 			Items: make([]proto.RedisCrd, 0),
@@ -459,6 +601,8 @@ func (h *k8sHandle) List(ctx context.Context, req proto.Param) (res []byte, err
 			m.Items = append(m.Items, covertHelixSagaCrdToProto(&v))
 		}
 		res, err = m.Marshal()
+	default:
+		res, err = encodeUnstructured(d)
 	}
 	if err != nil {
 		klog.V(2).Info(err)
@@ -515,6 +659,20 @@ func (h *k8sHandle) convertObjFromEvent(obj interface{}, et watch.EventType) (bm
 		req.NameSpace = n.Namespace
 		e = convertMysqlCrdToProto(n)
 		res, err = e.Marshal()
+	case reflect.TypeOf(&mysqloperatorv1.MysqlDatabase{}):
+		var e proto.MysqlDatabaseCrd
+		n := obj.(*mysqloperatorv1.MysqlDatabase)
+		req.ResourceType = group.MysqlDatabase
+		req.NameSpace = n.Namespace
+		e = convertMysqlDatabaseCrdToProto(n)
+		res, err = e.Marshal()
+	case reflect.TypeOf(&mysqloperatorv1.MysqlUser{}):
+		var e proto.MysqlUserCrd
+		n := obj.(*mysqloperatorv1.MysqlUser)
+		req.ResourceType = group.MysqlUser
+		req.NameSpace = n.Namespace
+		e = convertMysqlUserCrdToProto(n)
+		res, err = e.Marshal()
 	case reflect.TypeOf(&redisoperatorv1.RedisOperator{}):
 		var e proto.RedisCrd
 		n := obj.(*redisoperatorv1.RedisOperator)
@@ -529,6 +687,14 @@ func (h *k8sHandle) convertObjFromEvent(obj interface{}, et watch.EventType) (bm
 		req.NameSpace = n.Namespace
 		e = covertHelixSagaCrdToProto(n)
 		res, err = e.Marshal()
+	default:
+		n, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			break
+		}
+		req.ResourceType = group.ResourceType(n.GetKind())
+		req.NameSpace = n.GetNamespace()
+		res, err = n.MarshalJSON()
 	}
 	if err != nil {
 		return nil, err
@@ -594,6 +760,30 @@ func (h *k8sHandle) Resources(ctx context.Context, req proto.Param) (res []byte,
 	return proto.GetResponse(req, o)
 }
 
+// decodeUnstructured decodes the JSON of the custom resource which was declared in the dynamic config,
+// the apiVersion and the kind could be left out
+func decodeUnstructured(req proto.Param, obj []byte) (*unstructured.Unstructured, error) {
+	m := make(map[string]interface{})
+	if err := utiljson.Unmarshal(obj, &m); err != nil {
+		return nil, err
+	}
+	u := &unstructured.Unstructured{Object: m}
+	u.SetNamespace(req.NameSpace)
+	return u, nil
+}
+
+// encodeUnstructured encodes the custom resource or the list of them into the JSON,
+// nothing was encoded if obj was not unstructured
+func encodeUnstructured(obj interface{}) ([]byte, error) {
+	switch n := obj.(type) {
+	case *unstructured.Unstructured:
+		return n.MarshalJSON()
+	case *unstructured.UnstructuredList:
+		return n.MarshalJSON()
+	}
+	return nil, nil
+}
+
 func resourceCreate(g group.Group, req proto.Param, specName string, m interface{}) (res interface{}, err error) {
 	_, err = g.Resource().Get(req.ResourceType, req.NameSpace, specName)
 	if err != nil {
@@ -786,8 +976,9 @@ func convertProtoToMysqlCrd(req proto.Param, mysqlCrd proto.MysqlCrd) *mysqloper
 
 func convertMysqlCrdToProto(m *mysqloperatorv1.MysqlOperator) proto.MysqlCrd {
 	return proto.MysqlCrd{
-		Name:            m.Name,
-		ResourceVersion: m.ResourceVersion,
+		Name:             m.Name,
+		ResourceVersion:  m.ResourceVersion,
+		ConnectionSecret: m.Spec.MasterSpec.Status.ConnectionSecret,
 		Master: proto.NodeSpec{
 			Name:             m.Spec.MasterSpec.Spec.Name,
 			Replicas:         *m.Spec.MasterSpec.Spec.Replicas,
@@ -841,6 +1032,87 @@ func convertMysqlCrdToProto(m *mysqloperatorv1.MysqlOperator) proto.MysqlCrd {
 	}
 }
 
+func convertProtoToMysqlDatabaseCrd(req proto.Param, v proto.MysqlDatabaseCrd) *mysqloperatorv1.MysqlDatabase {
+	return &mysqloperatorv1.MysqlDatabase{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            v.Name,
+			Namespace:       req.NameSpace,
+			ResourceVersion: v.ResourceVersion,
+		},
+		Spec: mysqloperatorv1.MysqlDatabaseSpec{
+			Operator:     v.Operator,
+			Name:         v.Database,
+			CharacterSet: v.CharacterSet,
+			Collation:    v.Collation,
+			DropOnDelete: v.DropOnDelete,
+		},
+	}
+}
+
+func convertMysqlDatabaseCrdToProto(m *mysqloperatorv1.MysqlDatabase) proto.MysqlDatabaseCrd {
+	return proto.MysqlDatabaseCrd{
+		Name:            m.Name,
+		ResourceVersion: m.ResourceVersion,
+		Operator:        m.Spec.Operator,
+		Database:        m.Spec.Name,
+		CharacterSet:    m.Spec.CharacterSet,
+		Collation:       m.Spec.Collation,
+		DropOnDelete:    m.Spec.DropOnDelete,
+		Phase:           m.Status.Phase,
+		Message:         m.Status.Message,
+	}
+}
+
+func convertProtoToMysqlUserCrd(req proto.Param, v proto.MysqlUserCrd) *mysqloperatorv1.MysqlUser {
+	grants := make([]mysqloperatorv1.MysqlGrant, 0)
+	for _, g := range v.Grants {
+		grants = append(grants, mysqloperatorv1.MysqlGrant{
+			Database:   g.Database,
+			Table:      g.Table,
+			Privileges: g.Privileges,
+		})
+	}
+	return &mysqloperatorv1.MysqlUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            v.Name,
+			Namespace:       req.NameSpace,
+			ResourceVersion: v.ResourceVersion,
+		},
+		Spec: mysqloperatorv1.MysqlUserSpec{
+			Operator:       v.Operator,
+			User:           v.User,
+			Host:           v.Host,
+			PasswordSecret: v.PasswordSecret,
+			Grants:         grants,
+			DropOnDelete:   v.DropOnDelete,
+		},
+	}
+}
+
+func convertMysqlUserCrdToProto(m *mysqloperatorv1.MysqlUser) proto.MysqlUserCrd {
+	grants := make([]proto.MysqlGrant, 0)
+	for _, g := range m.Spec.Grants {
+		grants = append(grants, proto.MysqlGrant{
+			Database:   g.Database,
+			Table:      g.Table,
+			Privileges: g.Privileges,
+		})
+	}
+	return proto.MysqlUserCrd{
+		Name:            m.Name,
+		ResourceVersion: m.ResourceVersion,
+		Operator:        m.Spec.Operator,
+		User:            m.Spec.User,
+		Host:            m.Spec.Host,
+		PasswordSecret:  m.Spec.PasswordSecret,
+		Grants:          grants,
+		DropOnDelete:    m.Spec.DropOnDelete,
+		Phase:           m.Status.Phase,
+		Message:         m.Status.Message,
+		SecretName:      m.Status.SecretName,
+	}
+}
+
 func convertNodeSpecToV1Affinity(v *proto.NodeSpec) *corev1.Affinity {
 	var in *proto.Affinity
 	if v.Affinity == nil {
@@ -964,8 +1236,9 @@ func convertProtoToRedisCrd(req proto.Param, redisCrd proto.RedisCrd) *redisoper
 
 func convertRedisCrdToProto(v *redisoperatorv1.RedisOperator) proto.RedisCrd {
 	return proto.RedisCrd{
-		Name:            v.Name,
-		ResourceVersion: v.ResourceVersion,
+		Name:             v.Name,
+		ResourceVersion:  v.ResourceVersion,
+		ConnectionSecret: v.Spec.MasterSpec.Status.ConnectionSecret,
 		Master: proto.NodeSpec{
 			Name:             v.Spec.MasterSpec.Spec.Name,
 			Replicas:         *v.Spec.MasterSpec.Spec.Replicas,
@@ -20,4 +20,51 @@ type MysqlCrd struct {
 	ResourceVersion string   `json:"resourceVersion,omitempty" protobuf:"bytes,2,opt,name=resourceVersion"`
 	Master          NodeSpec `json:"master" protobuf:"bytes,3,rep,name=master"`
 	Slave           NodeSpec `json:"slave" protobuf:"bytes,4,rep,name=slave"`
+	// ConnectionSecret is the name of the Secret which holds the endpoints and the credentials.
+	// Read-only.
+	ConnectionSecret string `json:"connectionSecret,omitempty" protobuf:"bytes,5,opt,name=connectionSecret"`
+}
+
+type MysqlDatabaseCrdList struct {
+	Items []MysqlDatabaseCrd `json:"items" protobuf:"bytes,1,rep,name=items"`
+}
+
+type MysqlDatabaseCrd struct {
+	Name            string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	ResourceVersion string `json:"resourceVersion,omitempty" protobuf:"bytes,2,opt,name=resourceVersion"`
+	// Operator is the name of the MysqlOperator in the same namespace
+	Operator     string `json:"operator" protobuf:"bytes,3,opt,name=operator"`
+	Database     string `json:"database" protobuf:"bytes,4,opt,name=database"`
+	CharacterSet string `json:"characterSet" protobuf:"bytes,5,opt,name=characterSet"`
+	Collation    string `json:"collation" protobuf:"bytes,6,opt,name=collation"`
+	DropOnDelete bool   `json:"dropOnDelete" protobuf:"varint,7,opt,name=dropOnDelete"`
+	// Read-only
+	Phase   string `json:"phase" protobuf:"bytes,8,opt,name=phase"`
+	Message string `json:"message" protobuf:"bytes,9,opt,name=message"`
+}
+
+type MysqlUserCrdList struct {
+	Items []MysqlUserCrd `json:"items" protobuf:"bytes,1,rep,name=items"`
+}
+
+type MysqlUserCrd struct {
+	Name            string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	ResourceVersion string `json:"resourceVersion,omitempty" protobuf:"bytes,2,opt,name=resourceVersion"`
+	// Operator is the name of the MysqlOperator in the same namespace
+	Operator       string       `json:"operator" protobuf:"bytes,3,opt,name=operator"`
+	User           string       `json:"user" protobuf:"bytes,4,opt,name=user"`
+	Host           string       `json:"host" protobuf:"bytes,5,opt,name=host"`
+	PasswordSecret string       `json:"passwordSecret" protobuf:"bytes,6,opt,name=passwordSecret"`
+	Grants         []MysqlGrant `json:"grants" protobuf:"bytes,7,rep,name=grants"`
+	DropOnDelete   bool         `json:"dropOnDelete" protobuf:"varint,8,opt,name=dropOnDelete"`
+	// Read-only
+	Phase      string `json:"phase" protobuf:"bytes,9,opt,name=phase"`
+	Message    string `json:"message" protobuf:"bytes,10,opt,name=message"`
+	SecretName string `json:"secretName" protobuf:"bytes,11,opt,name=secretName"`
+}
+
+type MysqlGrant struct {
+	Database   string   `json:"database" protobuf:"bytes,1,opt,name=database"`
+	Table      string   `json:"table" protobuf:"bytes,2,opt,name=table"`
+	Privileges []string `json:"privileges" protobuf:"bytes,3,rep,name=privileges"`
 }
@@ -20,4 +20,7 @@ type RedisCrd struct {
 	ResourceVersion string   `json:"resourceVersion,omitempty" protobuf:"bytes,2,opt,name=resourceVersion"`
 	Master          NodeSpec `json:"master" protobuf:"bytes,3,rep,name=master"`
 	Slave           NodeSpec `json:"slave" protobuf:"bytes,4,rep,name=slave"`
+	// ConnectionSecret is the name of the Secret which holds the endpoints and the credentials.
+	// Read-only.
+	ConnectionSecret string `json:"connectionSecret,omitempty" protobuf:"bytes,5,opt,name=connectionSecret"`
 }
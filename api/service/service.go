@@ -34,7 +34,7 @@ func (s *service) Close() {
 
 func NewService(c conf.Config) Service {
 	ctx, cancel := context.WithCancel(context.Background())
-	g := group.NewGroup(ctx, c.MasterUrl(), c.KubeConfig(), c.DockerHub())
+	g := group.NewGroup(ctx, c.MasterUrl(), c.KubeConfig(), c.DockerHub(), c.DynamicResources())
 	s := &service{
 		conf:   c,
 		conn:   NewConnHub(ctx, g),
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"k8s.io/klog/v2"
+
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/crd"
+)
+
+var outputDir string
+
+func init() {
+	flag.StringVar(&outputDir, "output-dir", "example/crds", "The directory which the CustomResourceDefinitions were written to, one file each.")
+}
+
+func main() {
+	klog.InitFlags(nil)
+	flag.Parse()
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		klog.Fatal(err)
+	}
+	for _, d := range crd.Definitions() {
+		b, err := d.Marshal()
+		if err != nil {
+			klog.Fatal(err)
+		}
+		file := filepath.Join(outputDir, d.Name()+".yaml")
+		if err = ioutil.WriteFile(file, b, 0644); err != nil {
+			klog.Fatal(err)
+		}
+		klog.Infof("wrote %s", file)
+	}
+}
@@ -1,19 +1,39 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
 	"github.com/Shanghai-Lunara/helixsaga-operator/pkg/serviceloadbalancer"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
 
 	"github.com/Shanghai-Lunara/helixsaga-operator/pkg/controllers/helixsaga"
 	harbor "github.com/nevercase/harbor-api"
 	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/config"
+	mysqlDatabase "github.com/nevercase/k8s-controller-custom-resource/pkg/controller/mysqldatabase"
 	mysql "github.com/nevercase/k8s-controller-custom-resource/pkg/controller/mysqloperator"
+	mysqlUser "github.com/nevercase/k8s-controller-custom-resource/pkg/controller/mysqluser"
 	redis "github.com/nevercase/k8s-controller-custom-resource/pkg/controller/redisoperator"
+	webApp "github.com/nevercase/k8s-controller-custom-resource/pkg/controller/webappoperator"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/crd"
 	"github.com/nevercase/k8s-controller-custom-resource/pkg/signals"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/webhook"
 )
 
 type arrayFlags []string
@@ -34,6 +54,19 @@ var (
 	dockerUrl                 arrayFlags
 	dockerAdmin               arrayFlags
 	dockerPassword            arrayFlags
+	dryRun                    bool
+	installCRDs               bool
+	webhookPort               int
+	webhookCertDir            string
+	webhookSelfSignedHosts    string
+	webhookConfiguration      string
+	webhookEndpoint           webhook.Endpoint
+	workers                   int
+	kindWorkers               arrayFlags
+	queueConfig               = k8sCoreV1.DefaultQueueConfig()
+	remoteConfig              string
+	dynamicConfig             string
+	configFile                string
 )
 
 func init() {
@@ -43,14 +76,328 @@ func init() {
 	flag.Var(&dockerUrl, "dockerurl", "The address of the Harbor server.")
 	flag.Var(&dockerAdmin, "dockeradmin", "The username of the Harbor's account")
 	flag.Var(&dockerPassword, "dockerpwd", "The password of the Harbor's password")
+	flag.BoolVar(&dryRun, "dry-run", false, "Log the changes with their diffs against the live objects instead of persisting them.")
+	flag.BoolVar(&installCRDs, "install-crds", false, "Create or upgrade the CustomResourceDefinitions of the RedisOperator, the MysqlOperator, the MysqlDatabase, the MysqlUser and the WebAppOperator at startup.")
+	flag.IntVar(&webhookPort, "webhook-port", 0, "The port of the validating and the defaulting webhooks of the RedisOperator, the MysqlOperator and the WebAppOperator, and the conversion webhooks of the RedisOperator and the MysqlOperator, 0 disables them.")
+	flag.StringVar(&webhookCertDir, "webhook-cert-dir", "/tmp/k8s-webhook-server/serving-certs", "The directory holding the ca.crt, the tls.crt and the tls.key of the webhooks.")
+	flag.StringVar(&webhookSelfSignedHosts, "webhook-self-signed-hosts", "", "The comma separated hosts or IPs to generate the self-signed CA and certificate for into -webhook-cert-dir if they were missing, for the local tests.")
+	flag.StringVar(&webhookConfiguration, "webhook-configuration", "", "The name of the ValidatingWebhookConfiguration and the MutatingWebhookConfiguration which were created or updated with the ca.crt at startup, together with the conversion webhooks of the CustomResourceDefinitions, empty leaves them alone.")
+	flag.StringVar(&webhookEndpoint.URL, "webhook-url", "", "The URL which the apiserver calls the webhooks on, e.g. https://192.168.1.10:9443 for the local tests.")
+	flag.StringVar(&webhookEndpoint.Service, "webhook-service", "", "The namespace/name of the Service in front of the webhooks.")
+	flag.IntVar(&workers, "workers", 10, "The number of the workers of each kind which has no -kind-workers.")
+	flag.Var(&kindWorkers, "kind-workers", "The number of the workers of a kind in the form of Kind=N, e.g. RedisOperator=20.")
+	flag.IntVar(&queueConfig.PriorityWorkers, "priority-workers", queueConfig.PriorityWorkers, "The number of the workers of the priority lane of each kind, which serves the deletions and the failovers.")
+	flag.DurationVar(&queueConfig.BaseDelay, "rate-limiter-base-delay", queueConfig.BaseDelay, "The first back-off of a failed sync, which doubles on each failure.")
+	flag.DurationVar(&queueConfig.MaxDelay, "rate-limiter-max-delay", queueConfig.MaxDelay, "The max back-off of a failed sync.")
+	flag.Float64Var(&queueConfig.QPS, "rate-limiter-qps", queueConfig.QPS, "The overall rate of the requeued syncs of each kind.")
+	flag.IntVar(&queueConfig.Burst, "rate-limiter-burst", queueConfig.Burst, "The bucket size of -rate-limiter-qps.")
+	flag.StringVar(&remoteConfig, "remote-config", "", "The YAML file of the list of the custom resources which were reconciled by the reconcilers over gRPC, empty disables them.")
+	flag.StringVar(&dynamicConfig, "dynamic-config", "", "The YAML file of the list of the custom resources whose children were rendered from the templates, empty disables them.")
+	flag.StringVar(&configFile, "config", "", "The YAML file of the enabled operators, their workers and namespaces, the Harbor hubs, the load balancer and the leader election, which overrides the matching flags. The namespaces and the load balancer were reloaded on SIGHUP.")
+}
+
+// operators were the compiled-in operators which could be enabled by the -config file
+var operators = []struct {
+	kind string
+	new  func(agentName string, cfg *rest.Config, stopCh <-chan struct{}, hubs []harbor.Config) k8sCoreV1.Option
+}{
+	{mysql.OperatorKindName, func(agentName string, cfg *rest.Config, stopCh <-chan struct{}, _ []harbor.Config) k8sCoreV1.Option {
+		return mysql.NewOption(agentName, cfg, stopCh)
+	}},
+	{mysqlDatabase.OperatorKindName, func(agentName string, cfg *rest.Config, stopCh <-chan struct{}, _ []harbor.Config) k8sCoreV1.Option {
+		return mysqlDatabase.NewOption(agentName, cfg, stopCh)
+	}},
+	{mysqlUser.OperatorKindName, func(agentName string, cfg *rest.Config, stopCh <-chan struct{}, _ []harbor.Config) k8sCoreV1.Option {
+		return mysqlUser.NewOption(agentName, cfg, stopCh)
+	}},
+	{redis.OperatorKindName, func(agentName string, cfg *rest.Config, stopCh <-chan struct{}, _ []harbor.Config) k8sCoreV1.Option {
+		return redis.NewOption(agentName, cfg, stopCh)
+	}},
+	{webApp.OperatorKindName, func(agentName string, cfg *rest.Config, stopCh <-chan struct{}, _ []harbor.Config) k8sCoreV1.Option {
+		return webApp.NewOption(agentName, cfg, stopCh)
+	}},
+	{helixsaga.OperatorKindName, helixsaga.NewOption},
+}
+
+func operatorKinds() []string {
+	res := make([]string, 0, len(operators))
+	for _, o := range operators {
+		res = append(res, o.kind)
+	}
+	return res
+}
+
+// loadConfig reads the -config file, the settings which were left out of it fall back to the flags
+func loadConfig() (*config.Config, error) {
+	c := &config.Config{}
+	if configFile != "" {
+		var err error
+		if c, err = config.Load(configFile, operatorKinds()); err != nil {
+			return nil, err
+		}
+	}
+	if c.Workers == 0 {
+		c.Workers = workers
+	}
+	c.DryRun = c.DryRun || dryRun
+	if len(c.Harbor) == 0 {
+		for k, url := range dockerUrl {
+			h := config.Harbor{URL: url}
+			if len(dockerAdmin) >= k+1 {
+				h.Admin.Value = dockerAdmin[k]
+			}
+			if len(dockerPassword) >= k+1 {
+				h.Password.Value = dockerPassword[k]
+			}
+			c.Harbor = append(c.Harbor, h)
+		}
+	}
+	if c.LoadBalancer.IsZero() {
+		c.LoadBalancer.ConfigFile = serviceloadbalancerConfig
+	}
+	return c, nil
+}
+
+// applyConfig applies the non-structural parts of c, nothing was applied if the load balancer config was invalid.
+// It returns the annotations of the load balancer which were applied.
+func applyConfig(c *config.Config, namespaces *k8sCoreV1.NamespaceFilter) (*serviceloadbalancer.Annotations, error) {
+	annotations, err := c.LoadBalancer.Resolve()
+	if err != nil {
+		return nil, err
+	}
+	lb := k8sCoreV1.LoadBalancerAnnotations(*annotations)
+	k8sCoreV1.SetLoadBalancerAnnotations(&lb)
+	namespaces.Set(c.Namespaces...)
+	return annotations, nil
+}
+
+// reloadOnSignal applies the non-structural parts of the -config file on each SIGHUP,
+// the structural changes from running were logged since they need a restart.
+// The HelixSaga reads serviceloadbalancer.Get without a lock, so it kept the annotations of the startup.
+// reload must be registered by the caller before, otherwise a SIGHUP in between would terminate the process.
+func reloadOnSignal(running *config.Config, helixSagaAnnotations *serviceloadbalancer.Annotations, namespaces *k8sCoreV1.NamespaceFilter, reload, stopCh <-chan struct{}) {
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-reload:
+		}
+		next, err := loadConfig()
+		var annotations *serviceloadbalancer.Annotations
+		if err == nil {
+			annotations, err = applyConfig(next, namespaces)
+		}
+		if err != nil {
+			klog.Errorf("Error reloading the config %s, the previous one was kept: %s", configFile, err.Error())
+			continue
+		}
+		fields := running.RestartRequired(next)
+		if running.Enabled(helixsaga.OperatorKindName) && !reflect.DeepEqual(annotations, helixSagaAnnotations) {
+			fields = append(fields, "loadBalancer of the "+helixsaga.OperatorKindName)
+		}
+		if len(fields) > 0 {
+			klog.Warningf("the changes of %s in the config %s were not applied until the restart", strings.Join(fields, ", "), configFile)
+		}
+		klog.Infof("Reloaded the config %s, namespaces:%v", configFile, namespaces.List())
+	}
+}
+
+// controllerOptions builds the queue configs of the controller from the flags and the workers of the operators of c
+func controllerOptions(c *config.Config, namespaces *k8sCoreV1.NamespaceFilter) ([]k8sCoreV1.ControllerOption, error) {
+	res := []k8sCoreV1.ControllerOption{k8sCoreV1.WithQueueConfig(queueConfig), k8sCoreV1.WithNamespaceFilter(namespaces)}
+	for _, v := range kindWorkers {
+		s := strings.SplitN(v, "=", 2)
+		if len(s) != 2 {
+			return nil, fmt.Errorf("invalid -kind-workers %q, expected Kind=N", v)
+		}
+		n, err := strconv.Atoi(s[1])
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid -kind-workers %q, expected a positive number of workers", v)
+		}
+		res = append(res, k8sCoreV1.WithKindQueueConfig(s[0], k8sCoreV1.QueueConfig{Workers: n}))
+	}
+	for _, o := range c.Operators {
+		if o.Workers > 0 {
+			res = append(res, k8sCoreV1.WithKindQueueConfig(o.Kind, k8sCoreV1.QueueConfig{Workers: o.Workers}))
+		}
+	}
+	return res, nil
+}
+
+// runLeaderElection calls run once the Lease of le was acquired, and exits once the Lease was lost.
+// The Lease was released when stopCh was closed so that the next replica takes over at once.
+func runLeaderElection(le config.LeaderElection, k8sClientSet kubernetes.Interface, stopCh <-chan struct{}, run func(stopCh <-chan struct{})) error {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+	lock, err := resourcelock.New(resourcelock.LeasesResourceLock, le.Namespace, le.Name, k8sClientSet.CoreV1(), k8sClientSet.CoordinationV1(), resourcelock.ResourceLockConfig{
+		Identity: hostname + "_" + string(uuid.NewUUID()),
+	})
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   le.LeaseDuration.Duration,
+		RenewDeadline:   le.RenewDeadline.Duration,
+		RetryPeriod:     le.RetryPeriod.Duration,
+		ReleaseOnCancel: true,
+		Name:            le.Name,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				run(ctx.Done())
+			},
+			OnStoppedLeading: func() {
+				select {
+				case <-stopCh:
+					klog.Infof("Released the lease %s/%s", le.Namespace, le.Name)
+				default:
+					klog.Fatalf("Lost the lease %s/%s", le.Namespace, le.Name)
+				}
+			},
+		},
+	})
+	return nil
+}
+
+// remoteOptions returns the RemoteOptions of the -remote-config file
+func remoteOptions(agentName string, cfg *rest.Config, stopCh <-chan struct{}) ([]k8sCoreV1.Option, error) {
+	if remoteConfig == "" {
+		return nil, nil
+	}
+	data, err := ioutil.ReadFile(remoteConfig)
+	if err != nil {
+		return nil, err
+	}
+	configs := make([]k8sCoreV1.RemoteConfig, 0)
+	if err = yaml.UnmarshalStrict(data, &configs); err != nil {
+		return nil, fmt.Errorf("invalid -remote-config %s: %v", remoteConfig, err)
+	}
+	client, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]k8sCoreV1.Option, 0, len(configs))
+	for _, c := range configs {
+		opt, err := k8sCoreV1.NewRemoteOption(agentName, c, client, stopCh)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -remote-config %s: %v", remoteConfig, err)
+		}
+		res = append(res, opt)
+	}
+	return res, nil
+}
+
+// dynamicOptions returns the DynamicOptions of the -dynamic-config file
+func dynamicOptions(agentName string, cfg *rest.Config, stopCh <-chan struct{}) ([]k8sCoreV1.Option, error) {
+	if dynamicConfig == "" {
+		return nil, nil
+	}
+	configs, err := k8sCoreV1.ReadDynamicConfigs(dynamicConfig)
+	if err != nil {
+		return nil, err
+	}
+	client, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]k8sCoreV1.Option, 0, len(configs))
+	for _, c := range configs {
+		opt, err := k8sCoreV1.NewDynamicOption(agentName, c, client, stopCh)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, opt)
+	}
+	return res, nil
+}
+
+// installCustomResourceDefinitions creates or upgrades the CustomResourceDefinitions before the informers list them
+func installCustomResourceDefinitions(cfg *rest.Config) error {
+	client, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+	return crd.Install(context.Background(), client, crd.Definitions()...)
+}
+
+// runWebhooks serves the validating, the defaulting and the conversion webhooks in the background,
+// and registers them if -webhook-configuration was set
+func runWebhooks(cfg *rest.Config, k8sClientSet kubernetes.Interface, opts k8sCoreV1.Options, stopCh <-chan struct{}) error {
+	var caBundle []byte
+	var err error
+	if webhookSelfSignedHosts != "" {
+		if caBundle, err = webhook.EnsureSelfSignedCerts(webhookCertDir, strings.Split(webhookSelfSignedHosts, ",")); err != nil {
+			return err
+		}
+	} else if webhookConfiguration != "" {
+		if caBundle, err = ioutil.ReadFile(filepath.Join(webhookCertDir, webhook.CAFile)); err != nil {
+			return err
+		}
+	}
+	validations := webhook.Validations(opts, crd.Definitions()...)
+	server := webhook.NewServer(webhookPort, webhookCertDir)
+	defaultings := webhook.Defaultings(opts, crd.Definitions()...)
+	conversions := webhook.Conversions(crd.Definitions()...)
+	server.Register(validations...)
+	server.RegisterDefaultings(defaultings...)
+	server.RegisterConversions(conversions...)
+	go func() {
+		if err := server.Run(stopCh); err != nil {
+			klog.Fatalf("Error serving the webhooks: %s", err.Error())
+		}
+	}()
+	if webhookConfiguration == "" {
+		return nil
+	}
+	if err = webhook.ConfigureValidations(context.Background(), k8sClientSet, webhookConfiguration, webhookEndpoint, caBundle, validations...); err != nil {
+		return err
+	}
+	if err = webhook.ConfigureDefaultings(context.Background(), k8sClientSet, webhookConfiguration, webhookEndpoint, caBundle, defaultings...); err != nil {
+		return err
+	}
+	client, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+	return webhook.ConfigureConversions(context.Background(), client, webhookEndpoint, caBundle, conversions...)
 }
 
 func main() {
 	klog.InitFlags(nil)
 	flag.Parse()
 
+	c, err := loadConfig()
+	if err != nil {
+		klog.Fatalf("Error loading the config: %s", err.Error())
+	}
+	// The dry run decides the event sink of the operator, so it was not reloaded
+	k8sCoreV1.SetDryRun(c.DryRun)
+	namespaces := k8sCoreV1.NewNamespaceFilter()
+	annotations, err := applyConfig(c, namespaces)
+	if err != nil {
+		klog.Fatalf("Error applying the config: %s", err.Error())
+	}
+	// The HelixSaga reads the annotations by serviceloadbalancer.Get, they were set before its syncs started
+	*serviceloadbalancer.Get() = *annotations
+	dockerHub, err := c.HarborConfigs()
+	if err != nil {
+		klog.Fatalf("Error resolving the harbor credentials: %s", err.Error())
+	}
+
 	// set up signals so we handle the first shutdown signal gracefully
 	stopCh := signals.SetupSignalHandler()
+	if configFile != "" {
+		reload := signals.SetupReloadHandler(stopCh)
+		go reloadOnSignal(c, annotations, namespaces, reload, stopCh)
+	}
 
 	cfg, err := clientcmd.BuildConfigFromFlags(masterurl, kubeconfig)
 	if err != nil {
@@ -62,36 +409,61 @@ func main() {
 		klog.Fatalf("Error building kubernetes clientSet: %s", err.Error())
 	}
 
-	serviceloadbalancer.Init(serviceloadbalancerConfig)
-
-	dockerHub := make([]harbor.Config, 0)
-	for k, url := range dockerUrl {
-		var admin, password string
-		if len(dockerAdmin) >= k+1 {
-			admin = dockerAdmin[k]
-		}
-		if len(dockerPassword) >= k+1 {
-			password = dockerPassword[k]
+	if installCRDs {
+		if c.DryRun {
+			klog.Info("the CustomResourceDefinitions were not installed in the dry run")
+		} else if err = installCustomResourceDefinitions(cfg); err != nil {
+			klog.Fatalf("Error installing the CustomResourceDefinitions: %s", err.Error())
 		}
-		dockerHub = append(dockerHub, harbor.Config{
-			Url:      url,
-			Admin:    admin,
-			Password: password,
-		})
 	}
 
 	controllerName := "multiplex-controller"
 	opts := k8sCoreV1.NewOptions()
-	mysqlOpt := mysql.NewOption(controllerName, cfg, stopCh)
-	redisOpt := redis.NewOption(controllerName, cfg, stopCh)
-	helixSagaOpt := helixsaga.NewOption(controllerName, cfg, stopCh, dockerHub)
-	if err := opts.Add(mysqlOpt, redisOpt, helixSagaOpt); err != nil {
+	for _, o := range operators {
+		if !c.Enabled(o.kind) {
+			klog.Infof("the operator %s was disabled by the config", o.kind)
+			continue
+		}
+		if err := opts.Add(o.new(controllerName, cfg, stopCh, dockerHub)); err != nil {
+			klog.Fatal(err)
+		}
+	}
+	remoteOpts, err := remoteOptions(controllerName, cfg, stopCh)
+	if err != nil {
+		klog.Fatal(err)
+	}
+	if err := opts.Add(remoteOpts...); err != nil {
+		klog.Fatal(err)
+	}
+	dynamicOpts, err := dynamicOptions(controllerName, cfg, stopCh)
+	if err != nil {
+		klog.Fatal(err)
+	}
+	if err := opts.Add(dynamicOpts...); err != nil {
 		klog.Fatal(err)
 	}
 
 	operator := k8sCoreV1.NewKubernetesOperator(k8sClientSet, stopCh, controllerName, opts)
-	kc := k8sCoreV1.NewKubernetesController(operator)
-	if err = kc.Run(10, stopCh); err != nil {
-		klog.Fatalf("Error running multiplex-controller: %s", err.Error())
+	controllerOpts, err := controllerOptions(c, namespaces)
+	if err != nil {
+		klog.Fatal(err)
+	}
+	kc := k8sCoreV1.NewKubernetesController(operator, controllerOpts...)
+	if webhookPort > 0 {
+		if err = runWebhooks(cfg, k8sClientSet, opts, stopCh); err != nil {
+			klog.Fatalf("Error running the webhooks: %s", err.Error())
+		}
+	}
+	run := func(stopCh <-chan struct{}) {
+		if err := kc.Run(c.Workers, stopCh); err != nil {
+			klog.Fatalf("Error running multiplex-controller: %s", err.Error())
+		}
+	}
+	if !c.LeaderElection.Enabled {
+		run(stopCh)
+		return
+	}
+	if err = runLeaderElection(c.LeaderElection, k8sClientSet, stopCh, run); err != nil {
+		klog.Fatalf("Error running the leader election: %s", err.Error())
 	}
 }
@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// AdoptAnnotation opts a Foo in adopting the existing children which have no controller, with the value "true".
+	// It was meant for migrating the hand-made StatefulSets and Services under the operator.
+	AdoptAnnotation = "nevercase.io/adopt"
+
+	// SuccessAdopted is used as part of the Event 'reason' when a Foo adopts an existing child
+	SuccessAdopted = "Adopted"
+	// MessageResourceAdopted is the message used for an Event fired when a Foo adopts an existing child
+	MessageResourceAdopted = "Resource %q was adopted by Foo"
+)
+
+// Owner is the custom resource which controls the children
+type Owner interface {
+	runtime.Object
+	metav1.Object
+}
+
+// claim adds the controller ownerRef of owner to child and returns true if child should be updated to be adopted.
+// The child which was controlled by owner was left as is, the child of another controller was never taken
+// and the child without a controller was adopted only if owner was annotated with AdoptAnnotation.
+func claim(owner Owner, gvk schema.GroupVersionKind, kind string, child metav1.Object) (bool, error) {
+	if metav1.IsControlledBy(child, owner) {
+		return false, nil
+	}
+	if metav1.GetControllerOf(child) != nil || owner.GetAnnotations()[AdoptAnnotation] != "true" {
+		return false, NewResourceExistsError(kind, child.GetName())
+	}
+	child.SetOwnerReferences(append(child.GetOwnerReferences(), *metav1.NewControllerRef(owner, gvk)))
+	return true, nil
+}
+
+// ClaimStatefulSet returns ss once it was controlled by owner, the orphan was adopted by an update.
+// A ResourceExistsError was returned if ss couldn't be claimed, so that it wouldn't be overwritten.
+func ClaimStatefulSet(s KubernetesStatefulSet, owner Owner, gvk schema.GroupVersionKind, ss *appsv1.StatefulSet, recorder record.EventRecorder) (*appsv1.StatefulSet, error) {
+	c := ss.DeepCopy()
+	adopted, err := claim(owner, gvk, "StatefulSet", c)
+	if err != nil || !adopted {
+		return ss, err
+	}
+	if c, err = s.Update(ss.Namespace, c); err != nil {
+		return nil, err
+	}
+	recordAdoption(recorder, owner, "StatefulSet", ss.Name)
+	return c, nil
+}
+
+// ClaimDeployment is the same as ClaimStatefulSet for the Deployment
+func ClaimDeployment(s KubernetesDeployment, owner Owner, gvk schema.GroupVersionKind, d *appsv1.Deployment, recorder record.EventRecorder) (*appsv1.Deployment, error) {
+	c := d.DeepCopy()
+	adopted, err := claim(owner, gvk, "Deployment", c)
+	if err != nil || !adopted {
+		return d, err
+	}
+	if c, err = s.Update(d.Namespace, c); err != nil {
+		return nil, err
+	}
+	recordAdoption(recorder, owner, "Deployment", d.Name)
+	return c, nil
+}
+
+// ClaimService is the same as ClaimStatefulSet for the Service, it returns true if svc was adopted.
+// The adopted Service was just updated, the changes of its spec should wait for the next sync
+// which would be triggered by the update.
+func ClaimService(s KubernetesService, owner Owner, gvk schema.GroupVersionKind, svc *corev1.Service, recorder record.EventRecorder) (bool, error) {
+	c := svc.DeepCopy()
+	adopted, err := claim(owner, gvk, "Service", c)
+	if err != nil || !adopted {
+		return false, err
+	}
+	if _, err = s.Update(svc.Namespace, c); err != nil {
+		return false, err
+	}
+	recordAdoption(recorder, owner, "Service", svc.Name)
+	return true, nil
+}
+
+func recordAdoption(recorder record.EventRecorder, owner Owner, kind, name string) {
+	klog.InfoS("Adopted", "kind", kind, "namespace", owner.GetNamespace(), "name", name, "owner", owner.GetName())
+	if recorder != nil {
+		recorder.Event(owner, corev1.EventTypeNormal, SuccessAdopted, fmt.Sprintf(MessageResourceAdopted, kind+"/"+name))
+	}
+}
@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestClaim(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Memcached"}
+	newOwner := func(name string, annotations map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   metav1.NamespaceDefault,
+			UID:         types.UID("uid-" + name),
+			Annotations: annotations,
+		}}
+	}
+	owner := newOwner("cache", nil)
+	adopting := newOwner("cache", map[string]string{AdoptAnnotation: "true"})
+	other := newOwner("other", nil)
+	newChild := func(controller *corev1.ConfigMap) *corev1.Service {
+		svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: metav1.NamespaceDefault}}
+		if controller != nil {
+			svc.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(controller, gvk)}
+		}
+		return svc
+	}
+
+	tests := []struct {
+		name        string
+		owner       *corev1.ConfigMap
+		child       *corev1.Service
+		wantAdopted bool
+		wantErr     bool
+	}{
+		{name: "leaves the child of the owner", owner: owner, child: newChild(owner)},
+		{name: "refuses the orphan without the annotation", owner: owner, child: newChild(nil), wantErr: true},
+		{name: "adopts the orphan with the annotation", owner: adopting, child: newChild(nil), wantAdopted: true},
+		{name: "never takes the child of another controller", owner: adopting, child: newChild(other), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adopted, err := claim(tt.owner, gvk, "Service", tt.child)
+			var existsErr *ResourceExistsError
+			if (err != nil) != tt.wantErr || (err != nil && !errors.As(err, &existsErr)) {
+				t.Fatalf("claim() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if adopted != tt.wantAdopted {
+				t.Errorf("claim() = %v, want %v", adopted, tt.wantAdopted)
+			}
+			if !tt.wantErr && !metav1.IsControlledBy(tt.child, tt.owner) {
+				t.Errorf("ownerReferences = %v, want the child controlled by the owner", tt.child.OwnerReferences)
+			}
+		})
+	}
+}
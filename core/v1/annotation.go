@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/env"
+)
+
+const (
+	// PausedAnnotation stops the sync of a Foo with the value "true", so that its children could be patched by hand
+	PausedAnnotation = "nevercase.io/paused"
+	// ProtectDeleteAnnotation blocks the deletion of a Foo with the value "true" by the finalizer of the same name,
+	// the Foo was deleted once the annotation was removed.
+	ProtectDeleteAnnotation = "nevercase.io/protect-delete"
+	// ProtectDeleteFinalizer holds the deletion of a Foo which was annotated with ProtectDeleteAnnotation
+	ProtectDeleteFinalizer = ProtectDeleteAnnotation
+
+	// SuccessPaused is used as part of the Event 'reason' when the sync of a Foo was skipped
+	SuccessPaused = "Paused"
+	// MessagePaused is the message used for an Event fired when the sync of a Foo was skipped
+	MessagePaused = "Sync was paused by the annotation %s"
+	// ErrDeleteProtected is used as part of the Event 'reason' when a Foo was deleted with ProtectDeleteAnnotation
+	ErrDeleteProtected = "ErrDeleteProtected"
+	// MessageDeleteProtected is the message used for an Event fired when the deletion of a Foo was held
+	MessageDeleteProtected = "Deletion was blocked until the annotation %s was removed"
+)
+
+// IsPaused reports whether the sync of obj was paused by PausedAnnotation
+func IsPaused(obj Owner) bool {
+	return obj.GetAnnotations()[PausedAnnotation] == "true"
+}
+
+// IsDeleteProtected reports whether the deletion of obj was blocked by ProtectDeleteAnnotation
+func IsDeleteProtected(obj Owner) bool {
+	return obj.GetAnnotations()[ProtectDeleteAnnotation] == "true"
+}
+
+// syncProtectDelete keeps ProtectDeleteFinalizer in line with ProtectDeleteAnnotation, it reports whether obj was patched.
+// The finalizer couldn't be added to obj which was being deleted, nor to obj whose Option wasn't a PatchableOption.
+func syncProtectDelete(ctx context.Context, opt Option, obj Owner) (bool, error) {
+	po, ok := opt.(PatchableOption)
+	if !ok {
+		return false, nil
+	}
+	has := HasFinalizer(obj, ProtectDeleteFinalizer)
+	protected := IsDeleteProtected(obj)
+	if has == protected || (protected && obj.GetDeletionTimestamp() != nil) {
+		return false, nil
+	}
+	c := obj.DeepCopyObject().(Owner)
+	if protected {
+		AddFinalizer(c, ProtectDeleteFinalizer)
+	} else {
+		RemoveFinalizer(c, ProtectDeleteFinalizer)
+	}
+	return true, patchFinalizers(ctx, opt.KindName(), po, c)
+}
+
+// patchFinalizers writes the finalizers of obj with a merge patch on the resourceVersion of obj
+// through the client of its Option.
+func patchFinalizers(ctx context.Context, kind string, po PatchableOption, obj Owner) error {
+	if SuppressedByDryRun(kind, obj) {
+		return nil
+	}
+	data, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"finalizers":      obj.GetFinalizers(),
+			"resourceVersion": obj.GetResourceVersion(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	return po.PatchObject(ctx, obj, types.MergePatchType, data)
+}
@@ -0,0 +1,130 @@
+package v1_test
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	k8sTesting "github.com/nevercase/k8s-controller-custom-resource/core/v1/testing"
+)
+
+var (
+	testOwnerResource = k8sCoreV1.DynamicResource{Group: "example.com", Version: "v1", Kind: "Memcached", Resource: "memcacheds"}
+	testConfigMaps    = k8sCoreV1.DynamicResource{Version: "v1", Kind: "ConfigMap", Resource: "configmaps"}
+)
+
+// startAnnotationFixture starts the Fixture with the DynamicOption of the Memcached which renders a ConfigMap,
+// the fake dynamic client was seeded with owner.
+func startAnnotationFixture(t *testing.T, owner *unstructured.Unstructured) (*k8sTesting.Fixture, *dynamicFake.FakeDynamicClient) {
+	t.Helper()
+	client := dynamicFake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		testOwnerResource.GroupVersionResource(): "MemcachedList",
+		testConfigMaps.GroupVersionResource():    "ConfigMapList",
+	}, owner)
+	f := k8sTesting.NewFixture(t)
+	opt, err := k8sCoreV1.NewDynamicOption(k8sTesting.AgentName, k8sCoreV1.DynamicConfig{
+		Resource: testOwnerResource,
+		Children: []k8sCoreV1.DynamicResource{testConfigMaps},
+		Template: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: {{ .metadata.name }}-config\n",
+	}, client, f.StopCh())
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Start(opt)
+	return f, client
+}
+
+// testOwnerFinalizers returns the finalizers of owner in the fake dynamic client
+func testOwnerFinalizers(t *testing.T, f *k8sTesting.Fixture, client *dynamicFake.FakeDynamicClient, owner *unstructured.Unstructured) []string {
+	t.Helper()
+	u, err := client.Resource(testOwnerResource.GroupVersionResource()).Namespace(owner.GetNamespace()).Get(f.Context(), owner.GetName(), metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u.GetFinalizers()
+}
+
+func TestPausedAnnotation(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantChild   bool
+		wantReasons []string
+	}{
+		{
+			name:        "skips the sync of the paused owner",
+			annotations: map[string]string{k8sCoreV1.PausedAnnotation: "true"},
+			wantReasons: []string{k8sCoreV1.SuccessPaused},
+		},
+		{
+			name:        "syncs the owner once the annotation was not true",
+			annotations: map[string]string{k8sCoreV1.PausedAnnotation: "false"},
+			wantChild:   true,
+			wantReasons: []string{k8sCoreV1.SuccessSynced},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner := newTestOwner(tt.annotations)
+			f, client := startAnnotationFixture(t, owner)
+			f.ExpectSync(owner, k8sTesting.SyncCase{WantReasons: tt.wantReasons})
+
+			l, err := client.Resource(testConfigMaps.GroupVersionResource()).Namespace(k8sTesting.Namespace).List(f.Context(), metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := len(l.Items) == 1; got != tt.wantChild {
+				t.Errorf("ConfigMaps = %v, want the rendered child %v", l.Items, tt.wantChild)
+			}
+		})
+	}
+}
+
+func TestProtectDeleteAnnotation(t *testing.T) {
+	protected := map[string]string{k8sCoreV1.ProtectDeleteAnnotation: "true"}
+	deleting := func(annotations map[string]string) *unstructured.Unstructured {
+		u := newTestOwner(annotations)
+		u.SetFinalizers([]string{k8sCoreV1.ProtectDeleteFinalizer})
+		now := metav1.Now()
+		u.SetDeletionTimestamp(&now)
+		return u
+	}
+	tests := []struct {
+		name           string
+		owner          *unstructured.Unstructured
+		wantFinalizers []string
+		wantReasons    []string
+	}{
+		{
+			name:           "adds the finalizer to the protected owner",
+			owner:          newTestOwner(protected),
+			wantFinalizers: []string{k8sCoreV1.ProtectDeleteFinalizer},
+		},
+		{
+			name:           "holds the deletion of the protected owner",
+			owner:          deleting(protected),
+			wantFinalizers: []string{k8sCoreV1.ProtectDeleteFinalizer},
+			wantReasons:    []string{k8sCoreV1.ErrDeleteProtected},
+		},
+		{
+			name:  "removes the finalizer once the annotation was removed",
+			owner: deleting(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, client := startAnnotationFixture(t, tt.owner)
+			f.ExpectSync(tt.owner, k8sTesting.SyncCase{WantReasons: tt.wantReasons})
+
+			if got := testOwnerFinalizers(t, f, client, tt.owner); !reflect.DeepEqual(got, tt.wantFinalizers) && len(got)+len(tt.wantFinalizers) > 0 {
+				t.Errorf("finalizers = %v, want %v", got, tt.wantFinalizers)
+			}
+		})
+	}
+}
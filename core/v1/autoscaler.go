@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/env"
+)
+
+// ApplyHorizontalPodAutoscaler is the same as ApplyIngress for the HorizontalPodAutoscaler,
+// only the spec and the labels of the existing one were updated.
+func ApplyHorizontalPodAutoscaler(ctx context.Context, client kubernetes.Interface, d *autoscalingv2beta2.HorizontalPodAutoscaler) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	hpas := client.AutoscalingV2beta2().HorizontalPodAutoscalers(d.Namespace)
+	current, err := hpas.Get(ctx, d.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		if _, err = hpas.Create(ctx, d, metav1.CreateOptions{DryRun: DryRunOptions()}); err != nil {
+			return err
+		}
+		logDryRun("create", "HorizontalPodAutoscaler", d.Namespace, d.Name, nil, d)
+		return nil
+	}
+	if !sameController(current, d) {
+		return NewResourceExistsError("HorizontalPodAutoscaler", d.Name)
+	}
+	if equality.Semantic.DeepEqual(current.Spec, d.Spec) && equality.Semantic.DeepEqual(current.Labels, d.Labels) {
+		return nil
+	}
+	hpa := current.DeepCopy()
+	hpa.Labels, hpa.Spec = d.Labels, d.Spec
+	if _, err = hpas.Update(ctx, hpa, metav1.UpdateOptions{DryRun: DryRunOptions()}); err != nil {
+		return err
+	}
+	logDryRun("update", "HorizontalPodAutoscaler", d.Namespace, d.Name, current, hpa)
+	return nil
+}
+
+// DeleteHorizontalPodAutoscaler deletes the HorizontalPodAutoscaler of name if it was controlled by owner
+func DeleteHorizontalPodAutoscaler(ctx context.Context, client kubernetes.Interface, owner Owner, name string) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	hpas := client.AutoscalingV2beta2().HorizontalPodAutoscalers(owner.GetNamespace())
+	current, err := hpas.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !metav1.IsControlledBy(current, owner) {
+		return nil
+	}
+	if err = hpas.Delete(ctx, name, metav1.DeleteOptions{DryRun: DryRunOptions()}); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	logDryRun("delete", "HorizontalPodAutoscaler", owner.GetNamespace(), name, nil, nil)
+	return nil
+}
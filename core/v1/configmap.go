@@ -9,6 +9,7 @@ import (
 	coreV1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	kubeInformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
@@ -22,6 +23,7 @@ type KubernetesConfigMap interface {
 	Update(nameSpace string, d *coreV1.ConfigMap) (*coreV1.ConfigMap, error)
 	Delete(nameSpace, specDeploymentName string) error
 	List(nameSpace, filterName string) (sl *coreV1.ConfigMapList, err error)
+	WithContext(ctx context.Context) KubernetesConfigMap
 }
 
 func NewKubernetesConfigMap(kubeClientSet kubernetes.Interface, kubeInformerFactory kubeInformers.SharedInformerFactory) KubernetesConfigMap {
@@ -30,6 +32,7 @@ func NewKubernetesConfigMap(kubeClientSet kubernetes.Interface, kubeInformerFact
 		kubeClientSet:         kubeClientSet,
 		configMapLister:       kubeInformerFactory.Core().V1().ConfigMaps().Lister(),
 		executionTimeoutInSec: timeout,
+		ctx:                   context.Background(),
 	}
 }
 
@@ -37,6 +40,14 @@ type kubernetesConfigMap struct {
 	kubeClientSet         kubernetes.Interface
 	configMapLister       coreListersV1.ConfigMapLister
 	executionTimeoutInSec int64
+	ctx                   context.Context
+}
+
+// WithContext returns a copy of the KubernetesConfigMap whose requests were bound to ctx
+func (kcm *kubernetesConfigMap) WithContext(ctx context.Context) KubernetesConfigMap {
+	c := *kcm
+	c.ctx = ctx
+	return &c
 }
 
 func (kcm *kubernetesConfigMap) Get(nameSpace, specDeploymentName string) (d *coreV1.ConfigMap, err error) {
@@ -55,23 +66,27 @@ func (kcm *kubernetesConfigMap) Get(nameSpace, specDeploymentName string) (d *co
 }
 
 func (kcm *kubernetesConfigMap) Create(nameSpace, specDeploymentName string, d *coreV1.ConfigMap) (*coreV1.ConfigMap, error) {
-	createOpt := metav1.CreateOptions{}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(kcm.executionTimeoutInSec))
+	createOpt := metav1.CreateOptions{DryRun: DryRunOptions()}
+	ctx, cancel := context.WithTimeout(kcm.ctx, time.Second*time.Duration(kcm.executionTimeoutInSec))
 	configMap, err := kcm.kubeClientSet.CoreV1().ConfigMaps(nameSpace).Create(ctx, d, createOpt)
 	cancel()
 	if err != nil {
 		klog.V(2).Info(err)
+	} else {
+		logDryRun("create", "ConfigMap", nameSpace, d.Name, nil, configMap)
 	}
 	return configMap, err
 }
 
 func (kcm *kubernetesConfigMap) Update(nameSpace string, d *coreV1.ConfigMap) (*coreV1.ConfigMap, error) {
-	updateOpt := metav1.UpdateOptions{}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(kcm.executionTimeoutInSec))
+	updateOpt := metav1.UpdateOptions{DryRun: DryRunOptions()}
+	ctx, cancel := context.WithTimeout(kcm.ctx, time.Second*time.Duration(kcm.executionTimeoutInSec))
 	configMap, err := kcm.kubeClientSet.CoreV1().ConfigMaps(nameSpace).Update(ctx, d, updateOpt)
 	cancel()
 	if err != nil {
 		klog.V(2).Info(err)
+	} else {
+		logDryRun("update", "ConfigMap", nameSpace, d.Name, kcm.live(nameSpace, d.Name), configMap)
 	}
 	return configMap, err
 }
@@ -84,9 +99,10 @@ func (kcm *kubernetesConfigMap) Delete(nameSpace, specDeploymentName string) err
 		return nil
 	}
 	opts := metav1.DeleteOptions{
+		DryRun: DryRunOptions(),
 		//GracePeriodSeconds: int64ToPointer(30),
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(kcm.executionTimeoutInSec))
+	ctx, cancel := context.WithTimeout(kcm.ctx, time.Second*time.Duration(kcm.executionTimeoutInSec))
 	configMapName := fmt.Sprintf(ConfigMapTemplate, specDeploymentName)
 	err = kcm.kubeClientSet.CoreV1().ConfigMaps(nameSpace).Delete(ctx, configMapName, opts)
 	cancel()
@@ -94,6 +110,7 @@ func (kcm *kubernetesConfigMap) Delete(nameSpace, specDeploymentName string) err
 		klog.V(2).Info(err)
 		return err
 	}
+	logDryRun("delete", "ConfigMap", nameSpace, configMapName, nil, nil)
 	return nil
 }
 
@@ -101,7 +118,7 @@ func (kcm *kubernetesConfigMap) List(nameSpace, filterName string) (sl *coreV1.C
 	opts := metav1.ListOptions{
 		LabelSelector: filterName,
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(kcm.executionTimeoutInSec))
+	ctx, cancel := context.WithTimeout(kcm.ctx, time.Second*time.Duration(kcm.executionTimeoutInSec))
 	sl, err = kcm.kubeClientSet.CoreV1().ConfigMaps(nameSpace).List(ctx, opts)
 	cancel()
 	if err != nil {
@@ -109,3 +126,15 @@ func (kcm *kubernetesConfigMap) List(nameSpace, filterName string) (sl *coreV1.C
 	}
 	return sl, err
 }
+
+// live returns the ConfigMap in the store, or nil if it doesn't exist
+func (kcm *kubernetesConfigMap) live(nameSpace, name string) runtime.Object {
+	if !IsDryRun() {
+		return nil
+	}
+	obj, err := kcm.configMapLister.ConfigMaps(nameSpace).Get(name)
+	if err != nil {
+		return nil
+	}
+	return obj
+}
@@ -10,6 +10,13 @@ const (
 	PVNameTemplate          = "%s"
 	PVCNameTemplate         = "%s"
 	ContainerNameTemplate   = "%s"
+	JobNameTemplate         = "%s"
+	SecretNameTemplate      = "%s"
+
+	ReadWriteServiceNameTemplate = "%s-rw"
+	ReadOnlyServiceNameTemplate  = "%s-ro"
+	HeadlessServiceNameTemplate  = "%s-headless"
+	ConnectionSecretNameTemplate = "%s-connection"
 
 	MasterName = "master"
 	SlaveName  = "slave"
@@ -22,12 +29,49 @@ const (
 	LabelController = "controller"
 	LabelRole       = "role"
 	LabelName       = "name"
+	// LabelAccess marks the pod which accepts writes with AccessReadWrite and the replicas with AccessReadOnly
+	LabelAccess = "access"
+
+	AccessReadWrite = "rw"
+	AccessReadOnly  = "ro"
+)
+
+// The keys of the connection Secret, they were valid names of the environment variables for `envFrom`
+const (
+	ConnectionUsername = "USERNAME"
+	ConnectionPassword = "PASSWORD"
+	ConnectionRWHost   = "RW_HOST"
+	ConnectionRWPort   = "RW_PORT"
+	ConnectionRWURI    = "RW_URI"
+	ConnectionROHost   = "RO_HOST"
+	ConnectionROPort   = "RO_PORT"
+	ConnectionROURI    = "RO_URI"
 )
 
 func GetServiceName(name string) string {
 	return fmt.Sprintf(ServiceNameTemplate, name)
 }
 
+// GetReadWriteServiceName returns the name of the Service which always points to the current primary
+func GetReadWriteServiceName(name string) string {
+	return fmt.Sprintf(ReadWriteServiceNameTemplate, name)
+}
+
+// GetReadOnlyServiceName returns the name of the Service which load-balances the ready replicas
+func GetReadOnlyServiceName(name string) string {
+	return fmt.Sprintf(ReadOnlyServiceNameTemplate, name)
+}
+
+// GetHeadlessServiceName returns the name of the headless Service which gives each pod a DNS record
+func GetHeadlessServiceName(name string) string {
+	return fmt.Sprintf(HeadlessServiceNameTemplate, name)
+}
+
+// GetConnectionSecretName returns the name of the Secret which holds the endpoints and the credentials
+func GetConnectionSecretName(name string) string {
+	return fmt.Sprintf(ConnectionSecretNameTemplate, name)
+}
+
 func GetStatefulSetName(name string) string {
 	return fmt.Sprintf(StatefulSetNameTemplate, name)
 }
@@ -1,18 +1,24 @@
 package v1
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"sync"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 	appslistersv1 "k8s.io/client-go/listers/apps/v1"
+	batchlistersv1 "k8s.io/client-go/listers/batch/v1"
 	corelistersv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -37,20 +43,31 @@ const (
 
 type KubernetesControllerV1 interface {
 	Run(threadiness int, stopCh <-chan struct{}) error
-	RunWorker()
-	ProcessNextWorkItem() bool
-	SyncHandler(t task) error
+	SyncHandler(ctx context.Context, t Task) (Result, error)
 	EnqueueFoo(obj interface{})
+	EnqueueStatus(obj interface{})
 	HandleObject(obj interface{})
 }
 
-func NewKubernetesController(operator KubernetesOperator) KubernetesControllerV1 {
+// NewKubernetesController returns the controller which syncs each Option on its own work queue,
+// the queues were configured by WithQueueConfig and WithKindQueueConfig, and the namespaces by WithNamespaceFilter.
+func NewKubernetesController(operator KubernetesOperator, opts ...ControllerOption) KubernetesControllerV1 {
+	cc := &controllerConfig{kinds: make(map[string]QueueConfig)}
+	for _, o := range opts {
+		o(cc)
+	}
+	shared := cc.queue.withDefaults(DefaultQueueConfig())
 
 	kubeInformerFactory := operator.InformerFactory()
 	deploymentInformer := kubeInformerFactory.Apps().V1().Deployments()
 	statefulSetInformer := kubeInformerFactory.Apps().V1().StatefulSets()
 	serviceInformer := kubeInformerFactory.Core().V1().Services()
 	pvcInformer := kubeInformerFactory.Core().V1().PersistentVolumeClaims()
+	jobInformer := kubeInformerFactory.Batch().V1().Jobs()
+	podInformer := kubeInformerFactory.Core().V1().Pods()
+	// The Secrets and the ConfigMaps were read through the listers of the KubernetesResource
+	secretInformer := kubeInformerFactory.Core().V1().Secrets()
+	configMapInformer := kubeInformerFactory.Core().V1().ConfigMaps()
 
 	var kc = &kubernetesController{
 		deploymentsLister:   deploymentInformer.Lister(),
@@ -61,11 +78,20 @@ func NewKubernetesController(operator KubernetesOperator) KubernetesControllerV1
 		pvcSynced:           pvcInformer.Informer().HasSynced,
 		servicesLister:      serviceInformer.Lister(),
 		servicesSynced:      serviceInformer.Informer().HasSynced,
+		jobsLister:          jobInformer.Lister(),
+		jobsSynced:          jobInformer.Informer().HasSynced,
+		podsSynced:          podInformer.Informer().HasSynced,
+		secretsSynced:       secretInformer.Informer().HasSynced,
+		configMapsSynced:    configMapInformer.Informer().HasSynced,
 
 		operator: operator,
 
-		workqueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), operator.AgentName()),
-		recorder:  operator.Recorder(),
+		queues:     make(map[reflect.Type]*optionQueue),
+		recorder:   operator.Recorder(),
+		namespaces: cc.namespaces,
+	}
+	for t, opt := range operator.Options().List() {
+		kc.queues[t] = newOptionQueue(opt.KindName(), cc.kinds[opt.KindName()].withDefaults(shared))
 	}
 	klog.Info("Setting up event handlers")
 	// Set up an event handler for when Operator resources change
@@ -74,10 +100,10 @@ func NewKubernetesController(operator KubernetesOperator) KubernetesControllerV1
 		m[crdType].Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: kc.EnqueueFoo,
 			UpdateFunc: func(old, new interface{}) {
-				if reflect.TypeOf(old) != reflect.TypeOf(new) {
+				if ObjectType(old) != ObjectType(new) {
 					return
 				}
-				if match := m[reflect.TypeOf(new)].CompareResourceVersion(old, new); match {
+				if match := m[ObjectType(new)].CompareResourceVersion(old, new); match {
 					return
 				}
 				kc.EnqueueFoo(new)
@@ -100,9 +126,13 @@ func NewKubernetesController(operator KubernetesOperator) KubernetesControllerV1
 				// Two different versions of the same Deployment will always have different RVs.
 				return
 			}
+			if deploymentStatusChanged(oldDepl, newDepl) {
+				kc.EnqueueStatus(newDepl)
+				return
+			}
 			kc.HandleObject(new)
 		},
-		DeleteFunc: kc.HandleObject,
+		DeleteFunc: kc.handleDeletedObject,
 	})
 	statefulSetInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: kc.HandleObject,
@@ -114,9 +144,13 @@ func NewKubernetesController(operator KubernetesOperator) KubernetesControllerV1
 				// Two different versions of the same Deployment will always have different RVs.
 				return
 			}
+			if statefulSetStatusChanged(oldDepl, newDepl) {
+				kc.EnqueueStatus(newDepl)
+				return
+			}
 			kc.HandleObject(new)
 		},
-		DeleteFunc: kc.HandleObject,
+		DeleteFunc: kc.handleDeletedObject,
 	})
 	serviceInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: kc.HandleObject,
@@ -130,7 +164,7 @@ func NewKubernetesController(operator KubernetesOperator) KubernetesControllerV1
 			}
 			kc.HandleObject(new)
 		},
-		DeleteFunc: kc.HandleObject,
+		DeleteFunc: kc.handleDeletedObject,
 	})
 	pvcInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: kc.HandleObject,
@@ -144,14 +178,67 @@ func NewKubernetesController(operator KubernetesOperator) KubernetesControllerV1
 			}
 			kc.HandleObject(new)
 		},
-		DeleteFunc: kc.HandleObject,
+		DeleteFunc: kc.handleDeletedObject,
+	})
+	jobInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: kc.HandleObject,
+		UpdateFunc: func(old, new interface{}) {
+			newJob := new.(*batchv1.Job)
+			oldJob := old.(*batchv1.Job)
+			if newJob.ResourceVersion == oldJob.ResourceVersion {
+				// Periodic resync will send update events for all known Jobs.
+				// Two different versions of the same Job will always have different RVs.
+				return
+			}
+			kc.HandleObject(new)
+		},
+		DeleteFunc: kc.handleDeletedObject,
+	})
+	// The pods only refresh the status of their StatefulSets' owners, such as the ready replicas during a rollout
+	podInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: kc.handlePod,
+		UpdateFunc: func(old, new interface{}) {
+			newPod := new.(*corev1.Pod)
+			oldPod := old.(*corev1.Pod)
+			if newPod.ResourceVersion == oldPod.ResourceVersion {
+				return
+			}
+			kc.handlePod(new)
+		},
+		DeleteFunc: kc.handlePod,
 	})
 	return kc
 }
 
-type task struct {
+// Task was the item of the workqueue. The task of status was keyed by the child, such as a StatefulSet,
+// whose owner only needs the status to be refreshed by Option.SyncObjectStatus.
+// The owner was the type of the Option whose queue holds the task, the priority tells the lane.
+type Task struct {
 	key        string
 	objectType reflect.Type
+	owner      reflect.Type
+	// ownerKey is the namespace/name of the owner of the child of the status task
+	ownerKey string
+	status   bool
+	priority bool
+}
+
+// NewTask returns the Task which syncs the Foo obj, the Foo was looked up from the informer of its Option by the key
+// once the Task was processed. The Task goes on the routine lane, SyncHandler syncs it at once without the work queue.
+func NewTask(obj interface{}) (Task, error) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return Task{}, err
+	}
+	return Task{key: key, objectType: ObjectType(obj), owner: ObjectType(obj)}, nil
+}
+
+// lockKey returns the namespace/name of the resource of the Option which t syncs
+func (t Task) lockKey() string {
+	if t.status {
+		return t.ownerKey
+	}
+	return t.key
 }
 
 type kubernetesController struct {
@@ -166,30 +253,43 @@ type kubernetesController struct {
 	pvcSynced           cache.InformerSynced
 	servicesLister      corelistersv1.ServiceLister
 	servicesSynced      cache.InformerSynced
+	jobsLister          batchlistersv1.JobLister
+	jobsSynced          cache.InformerSynced
+	podsSynced          cache.InformerSynced
+	secretsSynced       cache.InformerSynced
+	configMapsSynced    cache.InformerSynced
 
 	operator KubernetesOperator
 
-	// workqueue is a rate limited work queue. This is used to queue work to be
+	// queues are the rate limited work queues of the Options. This is used to queue work to be
 	// processed instead of performing it as soon as a change happens. This
 	// means we can ensure we only process a fixed amount of resources at a
 	// time, and makes it easy to ensure we are never processing the same item
-	// simultaneously in two different workers.
-	workqueue workqueue.RateLimitingInterface
+	// simultaneously in two different workers. Each Option has its own queue
+	// so a flood of one kind couldn't starve the others.
+	queues map[reflect.Type]*optionQueue
 	// recorder is an event recorder for recording Event resources to the
 	// Kubernetes API.
 	recorder record.EventRecorder
+	// namespaces restricts the Foos which were enqueued, nil allows all of them
+	namespaces *NamespaceFilter
 }
 
 // Run will set up the event handlers for types we are interested in, as well
 // as syncing informer caches and starting workers. It will block until stopCh
-// is closed, at which point it will shutdown the workqueue and wait for
-// workers to finish processing their current work items.
+// is closed, at which point it will cancel the in-flight work items, shutdown
+// the workqueue and wait for workers to return.
 func (kc *kubernetesController) Run(threadiness int, stopCh <-chan struct{}) error {
 	defer utilruntime.HandleCrash()
-	defer kc.workqueue.ShutDown()
+	defer kc.shutDownQueues()
+
+	ctx, cancel := contextForChannel(stopCh)
+	defer cancel()
 
 	// Start the informer factories to begin populating the informer caches
 	klog.Info("Starting Foo controller")
+	// The informers which were added by NewKubernetesController, such as the pods, were started here
+	kc.operator.InformerFactory().Start(stopCh)
 
 	// Wait for the caches to be synced before starting workers
 	klog.Info("Waiting for informer caches to sync")
@@ -197,6 +297,10 @@ func (kc *kubernetesController) Run(threadiness int, stopCh <-chan struct{}) err
 	cacheSyncs = append(cacheSyncs, kc.deploymentsSynced)
 	cacheSyncs = append(cacheSyncs, kc.statefulSetSynced)
 	cacheSyncs = append(cacheSyncs, kc.servicesSynced)
+	cacheSyncs = append(cacheSyncs, kc.jobsSynced)
+	cacheSyncs = append(cacheSyncs, kc.podsSynced)
+	cacheSyncs = append(cacheSyncs, kc.secretsSynced)
+	cacheSyncs = append(cacheSyncs, kc.configMapsSynced)
 	for k, v := range kc.operator.Options().List() {
 		klog.Infof("HasSynced k:%v v:%v\n", k, v.Informer().HasSynced)
 		cacheSyncs = append(cacheSyncs, v.Informer().HasSynced)
@@ -208,30 +312,72 @@ func (kc *kubernetesController) Run(threadiness int, stopCh <-chan struct{}) err
 		return fmt.Errorf("failed to wait for caches to sync")
 	}
 	klog.Info("Starting workers")
-	// Launch two workers to process Operator resources
-	for i := 0; i < threadiness; i++ {
-		go wait.Until(kc.RunWorker, time.Second, stopCh)
+	// Launch the workers of each lane of each queue, threadiness was the number of the workers
+	// of the routine lane of the queue which has no Workers of its own.
+	var wg sync.WaitGroup
+	for _, q := range kc.queues {
+		workers := q.config.Workers
+		if workers <= 0 {
+			workers = threadiness
+		}
+		klog.Infof("Starting %d workers and %d priority workers of %s", workers, q.config.PriorityWorkers, q.kindName)
+		for _, lane := range []struct {
+			queue   workqueue.RateLimitingInterface
+			workers int
+		}{{q.routine, workers}, {q.priority, q.config.PriorityWorkers}} {
+			for i := 0; i < lane.workers; i++ {
+				wg.Add(1)
+				go func(q *optionQueue, lane workqueue.RateLimitingInterface) {
+					defer wg.Done()
+					wait.UntilWithContext(ctx, func(ctx context.Context) {
+						kc.runWorker(ctx, q, lane)
+					}, time.Second)
+				}(q, lane.queue)
+			}
+		}
 	}
 
 	klog.Info("Started workers")
 	<-stopCh
 	klog.Info("Shutting down workers")
+	cancel()
+	kc.shutDownQueues()
+	wg.Wait()
 
 	return nil
 }
 
+func (kc *kubernetesController) shutDownQueues() {
+	for _, q := range kc.queues {
+		q.shutDown()
+	}
+}
+
+// contextForChannel returns the context which was cancelled once stopCh was closed
+func contextForChannel(stopCh <-chan struct{}) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-stopCh:
+		case <-ctx.Done():
+		}
+		cancel()
+	}()
+	return ctx, cancel
+}
+
 // runWorker is a long-running function that will continually call the
 // processNextWorkItem function in order to read and process a message on the
-// workqueue.
-func (kc *kubernetesController) RunWorker() {
-	for kc.ProcessNextWorkItem() {
+// lane of the queue.
+func (kc *kubernetesController) runWorker(ctx context.Context, q *optionQueue, lane workqueue.RateLimitingInterface) {
+	for kc.processNextWorkItem(ctx, q, lane) {
 	}
 }
 
-// processNextWorkItem will read a single work item off the workqueue and
+// processNextWorkItem will read a single work item off the lane and
 // attempt to process it, by calling the syncHandler.
-func (kc *kubernetesController) ProcessNextWorkItem() bool {
-	obj, shutdown := kc.workqueue.Get()
+func (kc *kubernetesController) processNextWorkItem(ctx context.Context, q *optionQueue, lane workqueue.RateLimitingInterface) bool {
+	obj, shutdown := lane.Get()
 
 	if shutdown {
 		return false
@@ -245,8 +391,8 @@ func (kc *kubernetesController) ProcessNextWorkItem() bool {
 		// not call Forget if a transient error occurs, instead the item is
 		// put back on the workqueue and attempted again after a back-off
 		// period.
-		defer kc.workqueue.Done(obj)
-		var t task
+		defer lane.Done(obj)
+		var t Task
 		//var key string
 		var ok bool
 		// We expect strings to come off the workqueue. These are of the
@@ -254,30 +400,57 @@ func (kc *kubernetesController) ProcessNextWorkItem() bool {
 		// workqueue means the items in the informer cache may actually be
 		// more up to date that when the item was initially put onto the
 		// workqueue.
-		if t, ok = obj.(task); !ok {
+		if t, ok = obj.(Task); !ok {
 			// As the item in the workqueue is actually invalid, we call
 			// Forget here else we'd go into a loop of attempting to
 			// process a work item that is invalid.
-			kc.workqueue.Forget(obj)
+			lane.Forget(obj)
 			utilruntime.HandleError(fmt.Errorf("expected string in workqueue but got %#v", obj))
 			return nil
 		}
 
-		//t := task{
+		//t := Task{
 		//	key:        key,
 		//	objectType: reflect.TypeOf(obj),
 		//}
 
+		// The other lane might be processing the same resource
+		q.locks.lock(t.lockKey())
+		defer q.locks.unlock(t.lockKey())
+
 		// Run the syncHandler, passing it the namespace/name string of the
 		// Operator resource to be synced.
-		if err := kc.SyncHandler(t); err != nil {
+		var result Result
+		var err error
+		if t.status {
+			err = kc.syncStatus(ctx, t)
+		} else {
+			result, err = kc.SyncHandler(ctx, t)
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				// The controller was being stopped, the item would be synced again by the
+				// resync of the informers once the controller was started again.
+				lane.Forget(obj)
+				klog.Infof("stopped syncing '%s': %s", t.key, err.Error())
+				return nil
+			}
 			// Put the item back on the workqueue to handle any transient errors.
-			kc.workqueue.AddRateLimited(t)
+			lane.AddRateLimited(t)
 			return fmt.Errorf("error syncing '%s': %s, requeuing", t.key, err.Error())
 		}
+		if result.RequeueAfter <= 0 && result.Requeue {
+			// The item was requeued with the back-off as if the sync failed
+			lane.AddRateLimited(t)
+			return nil
+		}
 		// Finally, if no error occurs we Forget this item so it does not
-		// get queued again until another change happens.
-		kc.workqueue.Forget(obj)
+		// get queued again until another change happens, unless the sync
+		// asked for being checked again after a while.
+		lane.Forget(obj)
+		if result.RequeueAfter > 0 {
+			lane.AddAfter(t, result.RequeueAfter)
+		}
 		//klog.Infof("Successfully synced '%s'", key)
 		return nil
 	}(obj)
@@ -292,15 +465,16 @@ func (kc *kubernetesController) ProcessNextWorkItem() bool {
 
 // syncHandler compares the actual state with the desired, and attempts to
 // converge the two. It then updates the Status block of the Operator resource
-// with the current status of the resource.
-func (kc *kubernetesController) SyncHandler(t task) error {
+// with the current status of the resource. The Result tells when the Operator
+// resource should be synced again.
+func (kc *kubernetesController) SyncHandler(ctx context.Context, t Task) (Result, error) {
 	klog.Info("t:", t)
 
 	// Convert the namespace/name string into a distinct namespace and name
 	namespace, name, err := cache.SplitMetaNamespaceKey(t.key)
 	if err != nil {
 		utilruntime.HandleError(fmt.Errorf("invalid resource key: %s", t.key))
-		return nil
+		return Result{}, nil
 	}
 
 	// Get the Operator resource with this namespace/name
@@ -311,37 +485,231 @@ func (kc *kubernetesController) SyncHandler(t task) error {
 		// processing.
 		if errors.IsNotFound(err) {
 			utilruntime.HandleError(fmt.Errorf("err: operator '%s' in work queue no longer exists", t.key))
-			return nil
+			return Result{}, nil
+		}
+		return Result{}, err
+	}
+
+	opt := kc.operator.Options().Get(ObjectType(foo))
+	ks := kc.operator.Resource().WithContext(ctx)
+	if object, ok := foo.(Owner); ok {
+		// The annotations were honored for every Option before the sync
+		if skip, err := kc.syncAnnotations(ctx, opt, object, ks); err != nil || skip {
+			return Result{}, err
 		}
-		return err
 	}
 
 	// Create the Deployment of master with MasterSpec
-	err = kc.operator.Options().Get(reflect.TypeOf(foo)).SyncHandleObject(foo, kc.operator.Resource(), kc.operator.Recorder())
+	result, err := opt.SyncHandleObject(ctx, foo, ks, kc.operator.Recorder())
 	if err != nil {
-		return err
+		if ctx.Err() == nil {
+			kc.recordSyncError(foo, err, kc.numRequeues(t))
+		}
+		return Result{}, err
 	}
 
-	return nil
+	return result, nil
+}
+
+// numRequeues returns how many times t has been requeued by its lane for the failures
+func (kc *kubernetesController) numRequeues(t Task) int {
+	q, ok := kc.queues[t.owner]
+	if !ok {
+		return 0
+	}
+	return q.lane(t.priority).NumRequeues(t)
+}
+
+// syncAnnotations applies ProtectDeleteAnnotation and PausedAnnotation of foo, it reports whether the sync should be skipped.
+// The sync was skipped after the finalizer was patched too, since the patch would bring foo back.
+func (kc *kubernetesController) syncAnnotations(ctx context.Context, opt Option, foo Owner, ks KubernetesResource) (bool, error) {
+	if patched, err := syncProtectDelete(ctx, opt, foo); err != nil || patched {
+		return true, err
+	}
+	if foo.GetDeletionTimestamp() != nil && HasFinalizer(foo, ProtectDeleteFinalizer) {
+		kc.operator.Recorder().Eventf(foo, corev1.EventTypeWarning, ErrDeleteProtected, MessageDeleteProtected, ProtectDeleteAnnotation)
+		return true, nil
+	}
+	paused := IsPaused(foo)
+	if po, ok := opt.(PausableOption); ok {
+		if err := po.SyncPausedObject(ctx, foo, paused, ks, kc.operator.Recorder()); err != nil {
+			return true, err
+		}
+	}
+	if paused {
+		kc.operator.Recorder().Eventf(foo, corev1.EventTypeNormal, SuccessPaused, MessagePaused, PausedAnnotation)
+	}
+	return paused, nil
+}
+
+// recordSyncError records the Warning Event on the Foo which explains why the sync failed,
+// retries was the number of the times which the Foo has been requeued for the failure.
+func (kc *kubernetesController) recordSyncError(foo interface{}, err error, retries int) {
+	obj, ok := foo.(runtime.Object)
+	if !ok {
+		return
+	}
+	kc.operator.Recorder().Eventf(obj, corev1.EventTypeWarning, SyncErrorReason(err), MessageSyncFailed, retries, err)
+}
+
+// statusSyncDelay coalesces the bursts of the status changes, e.g. the pods of a StatefulSet becoming ready one by one
+const statusSyncDelay = time.Second
+
+// EnqueueStatus puts the task of status of the child on the work queue of the Option of its owner,
+// the owner itself was looked up once the task was processed.
+// The task was deduplicated by the work queue until it was processed.
+func (kc *kubernetesController) EnqueueStatus(obj interface{}) {
+	object, ok := obj.(metav1.Object)
+	if !ok {
+		return
+	}
+	ownerRef := metav1.GetControllerOf(object)
+	if ownerRef == nil || !kc.namespaces.Allows(object.GetNamespace()) {
+		return
+	}
+	opt, err := kc.operator.Options().GetWithKindName(ownerRef.Kind)
+	if err != nil {
+		return
+	}
+	q, ok := kc.queues[opt.GetReflectType()]
+	if !ok {
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	ownerKey := ownerRef.Name
+	if ns := object.GetNamespace(); ns != "" {
+		ownerKey = ns + "/" + ownerRef.Name
+	}
+	q.routine.AddAfter(Task{key: key, objectType: reflect.TypeOf(obj), owner: opt.GetReflectType(), ownerKey: ownerKey, status: true}, statusSyncDelay)
+}
+
+// handlePod enqueues the task of status of the StatefulSet which controls the pod
+func (kc *kubernetesController) handlePod(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		if pod, ok = tombstone.Obj.(*corev1.Pod); !ok {
+			return
+		}
+	}
+	ownerRef := metav1.GetControllerOf(pod)
+	if ownerRef == nil || ownerRef.Kind != "StatefulSet" {
+		return
+	}
+	ss, err := kc.statefulSetInformer.StatefulSets(pod.Namespace).Get(ownerRef.Name)
+	if err != nil || metav1.GetControllerOf(ss) == nil {
+		return
+	}
+	kc.EnqueueStatus(ss)
+}
+
+// syncStatus calls SyncObjectStatus of the Option of the owner of the child in the task.
+// The child which was gone or wasn't controlled by any of the Options was skipped.
+func (kc *kubernetesController) syncStatus(ctx context.Context, t Task) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(t.key)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("invalid resource key: %s", t.key))
+		return nil
+	}
+	var child metav1.Object
+	switch t.objectType {
+	case reflect.TypeOf(&appsv1.StatefulSet{}):
+		ss, err := kc.statefulSetInformer.StatefulSets(namespace).Get(name)
+		if err != nil {
+			return ignoreNotFound(err)
+		}
+		child = ss
+	case reflect.TypeOf(&appsv1.Deployment{}):
+		d, err := kc.deploymentsLister.Deployments(namespace).Get(name)
+		if err != nil {
+			return ignoreNotFound(err)
+		}
+		child = d
+	default:
+		utilruntime.HandleError(fmt.Errorf("unexpected status task %s of %v", t.key, t.objectType))
+		return nil
+	}
+	ownerRef := metav1.GetControllerOf(child)
+	if ownerRef == nil {
+		return nil
+	}
+	opt, err := kc.operator.Options().GetWithKindName(ownerRef.Kind)
+	if err != nil {
+		return nil
+	}
+	return opt.SyncObjectStatus(ctx, child, kc.operator.Resource().WithContext(ctx), kc.operator.Recorder())
+}
+
+// ignoreNotFound drops the error of the child which was gone, its owner would be synced by the deletion
+func ignoreNotFound(err error) error {
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// statefulSetStatusChanged reports whether the status was the only change of the StatefulSet,
+// which requires the status of its owner to be refreshed rather than a sync
+func statefulSetStatusChanged(old, new *appsv1.StatefulSet) bool {
+	o, n := old.DeepCopy(), new.DeepCopy()
+	o.Status, n.Status = appsv1.StatefulSetStatus{}, appsv1.StatefulSetStatus{}
+	o.ResourceVersion, n.ResourceVersion = "", ""
+	o.ManagedFields, n.ManagedFields = nil, nil
+	return equality.Semantic.DeepEqual(o, n)
+}
+
+// deploymentStatusChanged is the same as statefulSetStatusChanged for the Deployment
+func deploymentStatusChanged(old, new *appsv1.Deployment) bool {
+	o, n := old.DeepCopy(), new.DeepCopy()
+	o.Status, n.Status = appsv1.DeploymentStatus{}, appsv1.DeploymentStatus{}
+	o.ResourceVersion, n.ResourceVersion = "", ""
+	o.ManagedFields, n.ManagedFields = nil, nil
+	return equality.Semantic.DeepEqual(o, n)
 }
 
 // enqueueFoo takes a Operator resource and converts it into a namespace/name
 // string which is then put onto the work queue. This method should *not* be
 // passed resources of any type other than Foo.
 func (kc *kubernetesController) EnqueueFoo(obj interface{}) {
-	var key string
-	var err error
+	kc.enqueueFoo(obj, false)
+}
+
+// enqueueFoo puts the Foo on the priority lane if priority was true, if the Foo was being deleted,
+// or if its Option tells so by the PriorityOption. Otherwise the Foo goes on the routine lane.
+func (kc *kubernetesController) enqueueFoo(obj interface{}, priority bool) {
 	klog.Info("EnqueueFoo:", obj)
-	if key, err = cache.MetaNamespaceKeyFunc(obj); err != nil {
+	t, err := NewTask(obj)
+	if err != nil {
 		utilruntime.HandleError(err)
 		return
 	}
-	t := task{
-		key:        key,
-		objectType: reflect.TypeOf(obj),
+	q, ok := kc.queues[ObjectType(obj)]
+	if !ok {
+		utilruntime.HandleError(fmt.Errorf("no work queue of %v", ObjectType(obj)))
+		return
+	}
+	if object, ok := obj.(metav1.Object); ok {
+		if !kc.namespaces.Allows(object.GetNamespace()) {
+			klog.V(4).Infof("ignoring %s of the namespace which was filtered out", t.key)
+			return
+		}
+		if object.GetDeletionTimestamp() != nil {
+			priority = true
+		}
+	}
+	if po, ok := kc.operator.Options().Get(ObjectType(obj)).(PriorityOption); ok && !priority {
+		priority = po.Priority(obj)
 	}
-	klog.Info("EnqueueFoo workqueue key:", t.key)
-	kc.workqueue.Add(t)
+	t.priority = priority
+	klog.Info("EnqueueFoo workqueue key:", t.key, " priority:", t.priority)
+	q.lane(priority).Add(t)
 }
 
 // handleObject will take any resource implementing metav1.Object and attempt
@@ -350,6 +718,16 @@ func (kc *kubernetesController) EnqueueFoo(obj interface{}) {
 // It then enqueues that Operator resource to be processed. If the object does not
 // have an appropriate OwnerReference, it will simply be skipped.
 func (kc *kubernetesController) HandleObject(obj interface{}) {
+	kc.handleObject(obj, false)
+}
+
+// handleDeletedObject enqueues the owner of the deleted child on the priority lane,
+// since the child should be recreated before the routine resyncs.
+func (kc *kubernetesController) handleDeletedObject(obj interface{}) {
+	kc.handleObject(obj, true)
+}
+
+func (kc *kubernetesController) handleObject(obj interface{}, priority bool) {
 	var object metav1.Object
 	var ok bool
 	if object, ok = obj.(metav1.Object); !ok {
@@ -386,7 +764,7 @@ func (kc *kubernetesController) HandleObject(obj interface{}) {
 			return
 		}
 
-		kc.EnqueueFoo(foo)
+		kc.enqueueFoo(foo, priority)
 		return
 	}
 }
@@ -0,0 +1,69 @@
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// The defaults below were the same as the ones of the apiserver for the children,
+// so that the defaulted specs were deployed as before.
+
+// DefaultReplicas sets *replicas to 1 unless it was specified
+func DefaultReplicas(replicas **int32) {
+	if *replicas == nil {
+		one := int32(1)
+		*replicas = &one
+	}
+}
+
+// DefaultContainerPorts returns the container port of port unless any was specified,
+// and sets the protocol of the specified ones to TCP
+func DefaultContainerPorts(ports []corev1.ContainerPort, port int32) []corev1.ContainerPort {
+	if len(ports) == 0 {
+		return []corev1.ContainerPort{{ContainerPort: port, Protocol: corev1.ProtocolTCP}}
+	}
+	for i := range ports {
+		if ports[i].Protocol == "" {
+			ports[i].Protocol = corev1.ProtocolTCP
+		}
+	}
+	return ports
+}
+
+// DefaultServicePorts sets the protocol of ports to TCP and the target port to the port.
+// No port was added, the empty ports mean no Service of the role.
+func DefaultServicePorts(ports []corev1.ServicePort) {
+	for i := range ports {
+		if ports[i].Protocol == "" {
+			ports[i].Protocol = corev1.ProtocolTCP
+		}
+		if ports[i].TargetPort.Type == intstr.Int && ports[i].TargetPort.IntVal == 0 {
+			ports[i].TargetPort = intstr.FromInt(int(ports[i].Port))
+		}
+	}
+}
+
+// DefaultServiceType sets *st to ClusterIP unless it was specified
+func DefaultServiceType(st *corev1.ServiceType) {
+	*st = GetServiceType(*st)
+}
+
+// DefaultImagePullPolicy sets *policy to Always unless it was specified, which was what the children used to pull with
+func DefaultImagePullPolicy(policy *corev1.PullPolicy) {
+	if *policy == "" {
+		*policy = corev1.PullAlways
+	}
+}
+
+// DefaultResources copies the limits into the requests which were not specified
+func DefaultResources(r *corev1.ResourceRequirements) {
+	for name, q := range r.Limits {
+		if _, ok := r.Requests[name]; ok {
+			continue
+		}
+		if r.Requests == nil {
+			r.Requests = corev1.ResourceList{}
+		}
+		r.Requests[name] = q.DeepCopy()
+	}
+}
@@ -9,6 +9,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	kubeinformers "k8s.io/client-go/informers"
@@ -24,6 +25,7 @@ type KubernetesDeployment interface {
 	Delete(nameSpace, specName string) error
 	List(nameSpace, filterName string) (dl *appsv1.DeploymentList, err error)
 	Patch(nameSpace string, name string, pt types.PatchType, data []byte, subResources ...string) (*appsv1.Deployment, error)
+	WithContext(ctx context.Context) KubernetesDeployment
 }
 
 func NewKubernetesDeployment(kubeClientSet kubernetes.Interface, kubeInformerFactory kubeinformers.SharedInformerFactory) KubernetesDeployment {
@@ -32,6 +34,7 @@ func NewKubernetesDeployment(kubeClientSet kubernetes.Interface, kubeInformerFac
 		kubeClientSet:         kubeClientSet,
 		deploymentsLister:     kubeInformerFactory.Apps().V1().Deployments().Lister(),
 		executionTimeoutInSec: timeout,
+		ctx:                   context.Background(),
 	}
 }
 
@@ -39,6 +42,14 @@ type kubernetesDeployment struct {
 	kubeClientSet         kubernetes.Interface
 	deploymentsLister     appslistersv1.DeploymentLister
 	executionTimeoutInSec int64
+	ctx                   context.Context
+}
+
+// WithContext returns a copy of the KubernetesDeployment whose requests were bound to ctx
+func (kd *kubernetesDeployment) WithContext(ctx context.Context) KubernetesDeployment {
+	c := *kd
+	c.ctx = ctx
+	return &c
 }
 
 func (kd *kubernetesDeployment) Get(nameSpace, specName string) (d *appsv1.Deployment, err error) {
@@ -57,23 +68,27 @@ func (kd *kubernetesDeployment) Get(nameSpace, specName string) (d *appsv1.Deplo
 }
 
 func (kd *kubernetesDeployment) Create(nameSpace string, d *appsv1.Deployment) (*appsv1.Deployment, error) {
-	createOpt := metav1.CreateOptions{}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(kd.executionTimeoutInSec))
+	createOpt := metav1.CreateOptions{DryRun: DryRunOptions()}
+	ctx, cancel := context.WithTimeout(kd.ctx, time.Second*time.Duration(kd.executionTimeoutInSec))
 	deployment, err := kd.kubeClientSet.AppsV1().Deployments(nameSpace).Create(ctx, d, createOpt)
 	cancel()
 	if err != nil {
 		klog.V(2).Info(err)
+	} else {
+		logDryRun("create", "Deployment", nameSpace, d.Name, nil, deployment)
 	}
 	return deployment, err
 }
 
 func (kd *kubernetesDeployment) Update(nameSpace string, d *appsv1.Deployment) (*appsv1.Deployment, error) {
-	updateOpt := metav1.UpdateOptions{}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(kd.executionTimeoutInSec))
+	updateOpt := metav1.UpdateOptions{DryRun: DryRunOptions()}
+	ctx, cancel := context.WithTimeout(kd.ctx, time.Second*time.Duration(kd.executionTimeoutInSec))
 	deployment, err := kd.kubeClientSet.AppsV1().Deployments(nameSpace).Update(ctx, d, updateOpt)
 	cancel()
 	if err != nil {
 		klog.V(2).Info(err)
+	} else {
+		logDryRun("update", "Deployment", nameSpace, d.Name, kd.live(nameSpace, d.Name), deployment)
 	}
 	return deployment, err
 }
@@ -86,9 +101,10 @@ func (kd *kubernetesDeployment) Delete(nameSpace, specName string) error {
 		return nil
 	}
 	opts := metav1.DeleteOptions{
+		DryRun: DryRunOptions(),
 		//GracePeriodSeconds: int64ToPointer(30),
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(kd.executionTimeoutInSec))
+	ctx, cancel := context.WithTimeout(kd.ctx, time.Second*time.Duration(kd.executionTimeoutInSec))
 	name := fmt.Sprintf(DeploymentNameTemplate, specName)
 	err = kd.kubeClientSet.AppsV1().Deployments(nameSpace).Delete(ctx, name, opts)
 	cancel()
@@ -96,6 +112,7 @@ func (kd *kubernetesDeployment) Delete(nameSpace, specName string) error {
 		klog.V(2).Info(err)
 		return err
 	}
+	logDryRun("delete", "Deployment", nameSpace, name, nil, nil)
 	return nil
 }
 
@@ -103,7 +120,7 @@ func (kd *kubernetesDeployment) List(nameSpace, filterName string) (dl *appsv1.D
 	opts := metav1.ListOptions{
 		LabelSelector: filterName,
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(kd.executionTimeoutInSec))
+	ctx, cancel := context.WithTimeout(kd.ctx, time.Second*time.Duration(kd.executionTimeoutInSec))
 	dl, err = kd.kubeClientSet.AppsV1().Deployments(nameSpace).List(ctx, opts)
 	cancel()
 	if err != nil {
@@ -113,12 +130,26 @@ func (kd *kubernetesDeployment) List(nameSpace, filterName string) (dl *appsv1.D
 }
 
 func (kd *kubernetesDeployment) Patch(nameSpace string, name string, pt types.PatchType, data []byte, subResources ...string) (*appsv1.Deployment, error) {
-	opts := metav1.PatchOptions{}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(kd.executionTimeoutInSec))
+	opts := metav1.PatchOptions{DryRun: DryRunOptions()}
+	ctx, cancel := context.WithTimeout(kd.ctx, time.Second*time.Duration(kd.executionTimeoutInSec))
 	dl, err := kd.kubeClientSet.AppsV1().Deployments(nameSpace).Patch(ctx, name, pt, data, opts, subResources...)
 	cancel()
 	if err != nil {
 		klog.V(2).Info(err)
+	} else {
+		logDryRun("patch", "Deployment", nameSpace, name, kd.live(nameSpace, name), dl)
 	}
 	return dl, err
 }
+
+// live returns the Deployment in the store, or nil if it doesn't exist
+func (kd *kubernetesDeployment) live(nameSpace, name string) runtime.Object {
+	if !IsDryRun() {
+		return nil
+	}
+	obj, err := kd.deploymentsLister.Deployments(nameSpace).Get(name)
+	if err != nil {
+		return nil
+	}
+	return obj
+}
@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"encoding/json"
+	"sync/atomic"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/klog/v2"
+)
+
+// dryRun was set by SetDryRun before the operator was created
+var dryRun int32
+
+// SetDryRun makes every mutating request of the KubernetesResource a server-side dry run.
+// The changes were logged with their diffs against the live objects instead of being persisted,
+// and the events were logged only. It must be called before NewKubernetesOperator.
+func SetDryRun(enabled bool) {
+	var v int32
+	if enabled {
+		v = 1
+	}
+	atomic.StoreInt32(&dryRun, v)
+}
+
+// IsDryRun reports whether the dry run was enabled by SetDryRun
+func IsDryRun() bool {
+	return atomic.LoadInt32(&dryRun) == 1
+}
+
+// DryRunOptions returns the DryRun of the CreateOptions, UpdateOptions, PatchOptions and DeleteOptions
+func DryRunOptions() []string {
+	if IsDryRun() {
+		return []string{metav1.DryRunAll}
+	}
+	return nil
+}
+
+// SuppressedByDryRun reports whether the update of the custom resource obj should be skipped,
+// the skipped update was logged. It was used for the status of the custom resources, which
+// would trigger another sync otherwise.
+func SuppressedByDryRun(kind string, obj metav1.Object) bool {
+	if !IsDryRun() {
+		return false
+	}
+	klog.InfoS("Dry run suppressed the update", "kind", kind, "namespace", obj.GetNamespace(), "name", obj.GetName())
+	return true
+}
+
+// logDryRun logs the change which would be made by the verb, live was nil if the object didn't exist
+// and desired was nil if the object would be deleted
+func logDryRun(verb, kind, nameSpace, name string, live, desired runtime.Object) {
+	if !IsDryRun() {
+		return
+	}
+	diff, err := dryRunDiff(live, desired)
+	if err != nil {
+		klog.ErrorS(err, "Dry run couldn't compute the diff", "verb", verb, "kind", kind, "namespace", nameSpace, "name", name)
+		return
+	}
+	klog.InfoS("Dry run", "verb", verb, "kind", kind, "namespace", nameSpace, "name", name, "diff", diff)
+}
+
+// dryRunDiff returns the strategic merge patch which turns live into desired, or desired itself if live was nil.
+// The fields which were maintained by the api server and the status were left out, the data of the Secrets was redacted.
+func dryRunDiff(live, desired runtime.Object) (string, error) {
+	if desired == nil {
+		return "", nil
+	}
+	patch, err := json.Marshal(desired)
+	if err != nil {
+		return "", err
+	}
+	if live != nil {
+		original, err := json.Marshal(live)
+		if err != nil {
+			return "", err
+		}
+		if patch, err = strategicpatch.CreateTwoWayMergePatch(original, patch, desired); err != nil {
+			return "", err
+		}
+	}
+	m := make(map[string]interface{})
+	if err = json.Unmarshal(patch, &m); err != nil {
+		return "", err
+	}
+	delete(m, "status")
+	if meta, ok := m["metadata"].(map[string]interface{}); ok {
+		for _, k := range []string{"resourceVersion", "generation", "managedFields", "creationTimestamp", "uid"} {
+			delete(meta, k)
+		}
+		if len(meta) == 0 {
+			delete(m, "metadata")
+		}
+	}
+	if _, ok := desired.(*corev1.Secret); ok {
+		for _, field := range []string{"data", "stringData"} {
+			if data, ok := m[field].(map[string]interface{}); ok {
+				for k := range data {
+					data[k] = "<redacted>"
+				}
+			}
+		}
+	}
+	if len(m) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(m)
+	return string(b), err
+}
@@ -0,0 +1,73 @@
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDryRunDiff(t *testing.T) {
+	live := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default", ResourceVersion: "3", UID: "cache-uid"},
+		Data:       map[string]string{"image": "memcached:1.5", "replicas": "1"},
+	}
+	desired := live.DeepCopy()
+	desired.Data["image"] = "memcached:1.6"
+
+	diff, err := dryRunDiff(live, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"data":{"image":"memcached:1.6"}}`; diff != want {
+		t.Errorf("dryRunDiff() = %s, want %s", diff, want)
+	}
+	if diff, err = dryRunDiff(live, live.DeepCopy()); err != nil || diff != "" {
+		t.Errorf("dryRunDiff() = %q, %v, want no diff of the same object", diff, err)
+	}
+	if diff, err = dryRunDiff(live, nil); err != nil || diff != "" {
+		t.Errorf("dryRunDiff() = %q, %v, want no diff of the deletion", diff, err)
+	}
+
+	// The Secret which would be created was logged without its data and the server fields
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "cache-connection", UID: "secret-uid"},
+		Data:       map[string][]byte{"PASSWORD": []byte("generated")},
+		StringData: map[string]string{"USERNAME": "app"},
+	}
+	if diff, err = dryRunDiff(nil, secret); err != nil {
+		t.Fatal(err)
+	}
+	m := struct {
+		Metadata   map[string]interface{} `json:"metadata"`
+		Data       map[string]string      `json:"data"`
+		StringData map[string]string      `json:"stringData"`
+	}{}
+	if err = json.Unmarshal([]byte(diff), &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Data["PASSWORD"] != "<redacted>" || m.StringData["USERNAME"] != "<redacted>" {
+		t.Errorf("dryRunDiff() = %s, want the data of the Secret redacted", diff)
+	}
+	if _, ok := m.Metadata["uid"]; ok || m.Metadata["name"] != "cache-connection" {
+		t.Errorf("dryRunDiff() = %s, want the metadata without the server fields", diff)
+	}
+}
+
+func TestSetDryRun(t *testing.T) {
+	defer SetDryRun(IsDryRun())
+	obj := &metav1.ObjectMeta{Name: "cache", Namespace: "default"}
+
+	SetDryRun(false)
+	if DryRunOptions() != nil || SuppressedByDryRun("Memcached", obj) {
+		t.Error("the requests were dry run before it was enabled")
+	}
+	SetDryRun(true)
+	if got := DryRunOptions(); len(got) != 1 || got[0] != metav1.DryRunAll {
+		t.Errorf("DryRunOptions() = %v, want %s", got, metav1.DryRunAll)
+	}
+	if !SuppressedByDryRun("Memcached", obj) {
+		t.Error("the update of the status was not suppressed")
+	}
+}
@@ -0,0 +1,232 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"text/template"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// MessageResourceSyncedDynamic is the message used for an Event fired when a Foo was synced from its template
+	MessageResourceSyncedDynamic = "%s synced successfully from %d manifests"
+
+	// ConditionRendered is the type of the condition of the DynamicOption in the status of the object,
+	// which turns False with the reason ErrInvalidSpec once the template failed with the object
+	ConditionRendered = "Rendered"
+	// ReasonRendered is the reason of the condition once the children were rendered and applied
+	ReasonRendered = "Rendered"
+)
+
+// documentSeparator splits the rendered template into the manifests of the children
+var documentSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+
+// DynamicConfig configures the DynamicOption of the custom resource of Resource, whose children were rendered from
+// Template. The children were of the kinds of Children, the other kinds were refused.
+type DynamicConfig struct {
+	Resource DynamicResource   `json:"resource"`
+	Children []DynamicResource `json:"children,omitempty"`
+	// Template is the text/template of the manifests of the children separated by "---", which was executed
+	// with the object, e.g. {{ .metadata.name }}. The missing fields fail the template, the optional ones
+	// should be read with index, e.g. {{ index .spec "replicas" | default 1 }}.
+	Template string `json:"template"`
+}
+
+// Validate returns the problem of c which the DynamicOption couldn't work with
+func (c DynamicConfig) Validate() error {
+	if err := validateResources(append([]DynamicResource{c.Resource}, c.Children...)...); err != nil {
+		return err
+	}
+	if strings.TrimSpace(c.Template) == "" {
+		return fmt.Errorf("the template of %s must be specified", c.Resource.Kind)
+	}
+	_, err := c.parse()
+	return err
+}
+
+func (c DynamicConfig) parse() (*template.Template, error) {
+	t, err := template.New(c.Resource.Kind).Option("missingkey=error").Funcs(templateFuncs).Parse(c.Template)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template of %s: %v", c.Resource.Kind, err)
+	}
+	return t, nil
+}
+
+// ReadDynamicConfigs reads the YAML list of the DynamicConfigs in the file of path
+func ReadDynamicConfigs(path string) ([]DynamicConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	configs := make([]DynamicConfig, 0)
+	if err = yaml.UnmarshalStrict(data, &configs); err != nil {
+		return nil, fmt.Errorf("invalid dynamic config %s: %v", path, err)
+	}
+	for _, c := range configs {
+		if err = c.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid dynamic config %s: %v", path, err)
+		}
+	}
+	return configs, nil
+}
+
+// templateFuncs were available in the Template besides the builtin ones
+var templateFuncs = template.FuncMap{
+	// default returns def if v was missing or empty, e.g. {{ index .spec "replicas" | default 1 }}
+	"default": func(def, v interface{}) interface{} {
+		if v == nil || v == "" {
+			return def
+		}
+		return v
+	},
+	// toJson renders v in the JSON, which was valid YAML in the flow style, e.g. env: {{ .spec.env | toJson }}
+	"toJson": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+	"quote": func(v interface{}) string {
+		return fmt.Sprintf("%q", fmt.Sprint(v))
+	},
+}
+
+// DynamicOption is the Option of a custom resource which was declared in the config rather than compiled in.
+// It was watched with the dynamic client, and its children were rendered from the template of the config
+// with the object, so that a StatefulSet and a Service could be derived from the spec without any code.
+// Only the condition ConditionRendered of the status of the object was maintained.
+type DynamicOption struct {
+	unstructuredOption
+	template *template.Template
+}
+
+// NewDynamicOption returns the DynamicOption of config
+func NewDynamicOption(agentName string, config DynamicConfig, client dynamic.Interface, stopCh <-chan struct{}) (*DynamicOption, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	t, err := config.parse()
+	if err != nil {
+		return nil, err
+	}
+	return &DynamicOption{
+		unstructuredOption: newUnstructuredOption(agentName, config.Resource, config.Children, client, stopCh),
+		template:           t,
+	}, nil
+}
+
+// SyncHandleObject applies the children which were rendered from the template with obj,
+// and deletes the children which were no longer rendered.
+// The object being deleted was left to the garbage collector together with its children.
+func (opt *DynamicOption) SyncHandleObject(ctx context.Context, obj interface{}, ks KubernetesResource, recorder record.EventRecorder) (Result, error) {
+	foo, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return Result{}, fmt.Errorf("%s: unexpected object %T", opt.KindName(), obj)
+	}
+	if foo.GetDeletionTimestamp() != nil {
+		return Result{}, nil
+	}
+	desired, err := opt.render(foo)
+	if err != nil {
+		// The object was not synced again until it was changed, since the template fails the same way
+		recorder.Event(foo, corev1.EventTypeWarning, ErrInvalidSpec, err.Error())
+		return Result{}, opt.updateRenderedCondition(ctx, foo, err)
+	}
+	current, err := opt.listChildren(ctx, foo)
+	if err != nil {
+		return Result{}, err
+	}
+	if err = opt.applyChildren(ctx, foo, current, desired, recorder); err != nil {
+		return Result{}, err
+	}
+	if err = opt.updateRenderedCondition(ctx, foo, nil); err != nil {
+		return Result{}, err
+	}
+	recorder.Eventf(foo, corev1.EventTypeNormal, SuccessSynced, MessageResourceSyncedDynamic, opt.KindName(), len(desired))
+	return Result{}, nil
+}
+
+// updateRenderedCondition sets ConditionRendered in the status of foo by renderErr, the rest of the status was kept
+func (opt *DynamicOption) updateRenderedCondition(ctx context.Context, foo *unstructured.Unstructured, renderErr error) error {
+	status, _, err := unstructured.NestedMap(foo.Object, "status")
+	if err != nil {
+		return err
+	}
+	if status == nil {
+		status = make(map[string]interface{})
+	}
+	conditions := struct {
+		Conditions []metav1.Condition `json:"conditions,omitempty"`
+	}{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(status, &conditions); err != nil {
+		return fmt.Errorf("invalid conditions of %s %s/%s: %v", opt.KindName(), foo.GetNamespace(), foo.GetName(), err)
+	}
+	condition := metav1.Condition{
+		Type:               ConditionRendered,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: foo.GetGeneration(),
+		Reason:             ReasonRendered,
+		Message:            "the children were rendered from the template",
+	}
+	if renderErr != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ErrInvalidSpec
+		condition.Message = renderErr.Error()
+	}
+	meta.SetStatusCondition(&conditions.Conditions, condition)
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&conditions)
+	if err != nil {
+		return err
+	}
+	status["conditions"] = content["conditions"]
+	return opt.updateStatus(ctx, foo, status)
+}
+
+// SyncObjectStatus does nothing since the status of the object was not derived from the children
+func (opt *DynamicOption) SyncObjectStatus(ctx context.Context, obj interface{}, ks KubernetesResource, recorder record.EventRecorder) error {
+	return nil
+}
+
+// render executes the template with foo, the empty manifests were skipped
+func (opt *DynamicOption) render(foo *unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	buf := &bytes.Buffer{}
+	if err := opt.template.Execute(buf, foo.UnstructuredContent()); err != nil {
+		return nil, fmt.Errorf("rendering the template of %s %s/%s: %v", opt.KindName(), foo.GetNamespace(), foo.GetName(), err)
+	}
+	res := make([]*unstructured.Unstructured, 0)
+	for i, doc := range documentSeparator.Split(buf.String(), -1) {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		data, err := yaml.YAMLToJSON([]byte(doc))
+		if err != nil {
+			return nil, fmt.Errorf("invalid manifest %d of %s %s/%s: %v", i, opt.KindName(), foo.GetNamespace(), foo.GetName(), err)
+		}
+		// The integers were decoded into int64 as the live objects were, so that they compare equal
+		child := map[string]interface{}{}
+		if err = utiljson.Unmarshal(data, &child); err != nil {
+			return nil, fmt.Errorf("invalid manifest %d of %s %s/%s: %v", i, opt.KindName(), foo.GetNamespace(), foo.GetName(), err)
+		}
+		if len(child) == 0 {
+			continue
+		}
+		u := &unstructured.Unstructured{Object: child}
+		if u.GetKind() == "" || u.GetName() == "" {
+			return nil, fmt.Errorf("the manifest %d of %s %s/%s has no kind or name", i, opt.KindName(), foo.GetNamespace(), foo.GetName())
+		}
+		res = append(res, u)
+	}
+	return res, nil
+}
@@ -0,0 +1,176 @@
+package v1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/record"
+)
+
+var (
+	testMemcached  = DynamicResource{Group: "example.com", Version: "v1", Kind: "Memcached", Resource: "memcacheds"}
+	testConfigMaps = DynamicResource{Version: "v1", Kind: "ConfigMap", Resource: "configmaps"}
+)
+
+const testTemplate = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .metadata.name }}-config
+data:
+  image: {{ .spec.image | quote }}
+  replicas: {{ index .spec "replicas" | default 1 | quote }}
+---
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .metadata.name }}-env
+data:
+  env: {{ index .spec "env" | default "production" | toJson | quote }}
+`
+
+func newTestMemcached(spec map[string]interface{}) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	u.SetGroupVersionKind(testMemcached.GroupVersionKind())
+	u.SetNamespace("default")
+	u.SetName("cache")
+	u.SetUID("cache-uid")
+	u.SetGeneration(2)
+	return u
+}
+
+// newTestDynamicOption returns the DynamicOption of testTemplate whose fake client was seeded with objects
+func newTestDynamicOption(t *testing.T, objects ...runtime.Object) (*DynamicOption, *dynamicFake.FakeDynamicClient) {
+	t.Helper()
+	client := dynamicFake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		testMemcached.GroupVersionResource():  "MemcachedList",
+		testConfigMaps.GroupVersionResource(): "ConfigMapList",
+	}, objects...)
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+	opt, err := NewDynamicOption("test", DynamicConfig{
+		Resource: testMemcached,
+		Children: []DynamicResource{testConfigMaps},
+		Template: testTemplate,
+	}, client, stopCh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return opt, client
+}
+
+func TestDynamicOptionRender(t *testing.T) {
+	opt, _ := newTestDynamicOption(t)
+	children, err := opt.render(newTestMemcached(map[string]interface{}{"image": "memcached:1.6", "replicas": int64(3)}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(children) != 2 || children[0].GetName() != "cache-config" || children[1].GetName() != "cache-env" {
+		t.Fatalf("unexpected children %v", children)
+	}
+	if replicas, _, _ := unstructured.NestedString(children[0].Object, "data", "replicas"); replicas != "3" {
+		t.Errorf("replicas = %q, want 3", replicas)
+	}
+	if env, _, _ := unstructured.NestedString(children[1].Object, "data", "env"); env != `"production"` {
+		t.Errorf("env = %q, want the default in JSON", env)
+	}
+}
+
+func TestDynamicOptionRenderMissingKey(t *testing.T) {
+	opt, _ := newTestDynamicOption(t)
+	_, err := opt.render(newTestMemcached(map[string]interface{}{"replicas": int64(3)}))
+	if err == nil || !strings.Contains(err.Error(), `"image"`) {
+		t.Errorf("expected the missing image failing the template, got %v", err)
+	}
+}
+
+func TestDynamicConfigValidate(t *testing.T) {
+	config := DynamicConfig{Resource: testMemcached, Children: []DynamicResource{testConfigMaps}, Template: "{{ .metadata.name "}
+	if err := config.Validate(); err == nil {
+		t.Error("expected the unclosed action being refused")
+	}
+	config.Template = "  "
+	if err := config.Validate(); err == nil {
+		t.Error("expected the empty template being refused")
+	}
+}
+
+func TestDynamicOptionSync(t *testing.T) {
+	foo := newTestMemcached(map[string]interface{}{"image": "memcached:1.6"})
+	// The live child holds the stale image and the field which was set by someone else
+	live := &unstructured.Unstructured{Object: map[string]interface{}{
+		"data": map[string]interface{}{"image": "memcached:1.5", "extra": "kept"},
+	}}
+	live.SetGroupVersionKind(testConfigMaps.GroupVersionKind())
+	live.SetNamespace("default")
+	live.SetName("cache-config")
+	live.SetLabels(map[string]string{LabelApp: testMemcached.Kind, LabelController: "cache"})
+	live.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(foo, testMemcached.GroupVersionKind())})
+	stale := live.DeepCopy()
+	stale.SetName("cache-stale")
+	opt, client := newTestDynamicOption(t, foo, live, stale)
+
+	recorder := record.NewFakeRecorder(10)
+	if _, err := opt.SyncHandleObject(context.Background(), foo, nil, recorder); err != nil {
+		t.Fatal(err)
+	}
+	cms := client.Resource(testConfigMaps.GroupVersionResource()).Namespace("default")
+	cm, err := cms.Get(context.Background(), "cache-config", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _, _ := unstructured.NestedStringMap(cm.Object, "data")
+	if data["image"] != "memcached:1.6" || data["replicas"] != "1" || data["extra"] != "kept" {
+		t.Errorf("data = %v, want the rendered fields merged into the live ones", data)
+	}
+	if _, err = cms.Get(context.Background(), "cache-env", metav1.GetOptions{}); err != nil {
+		t.Errorf("the rendered ConfigMap was not created: %v", err)
+	}
+	if _, err = cms.Get(context.Background(), "cache-stale", metav1.GetOptions{}); !errors.IsNotFound(err) {
+		t.Errorf("the ConfigMap which was no longer rendered was not deleted: %v", err)
+	}
+	foo = testRenderedCondition(t, client, metav1.ConditionTrue, ReasonRendered)
+
+	// The image was removed from the spec
+	unstructured.RemoveNestedField(foo.Object, "spec", "image")
+	if _, err = opt.SyncHandleObject(context.Background(), foo, nil, recorder); err != nil {
+		t.Fatalf("expected the template failure not being retried, got %v", err)
+	}
+	testRenderedCondition(t, client, metav1.ConditionFalse, ErrInvalidSpec)
+	events := make([]string, 0)
+	for len(recorder.Events) > 0 {
+		events = append(events, <-recorder.Events)
+	}
+	if last := events[len(events)-1]; !strings.HasPrefix(last, "Warning "+ErrInvalidSpec) {
+		t.Errorf("events = %v, want the template failure at last", events)
+	}
+}
+
+// testRenderedCondition checks ConditionRendered of the live Memcached and returns it
+func testRenderedCondition(t *testing.T, client *dynamicFake.FakeDynamicClient, status metav1.ConditionStatus, reason string) *unstructured.Unstructured {
+	t.Helper()
+	foo, err := client.Resource(testMemcached.GroupVersionResource()).Namespace("default").Get(context.Background(), "cache", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	conditions := struct {
+		Conditions []metav1.Condition `json:"conditions"`
+	}{}
+	content, _, _ := unstructured.NestedMap(foo.Object, "status")
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(content, &conditions); err != nil {
+		t.Fatal(err)
+	}
+	c := meta.FindStatusCondition(conditions.Conditions, ConditionRendered)
+	if c == nil || c.Status != status || c.Reason != reason || c.ObservedGeneration != foo.GetGeneration() {
+		t.Fatalf("condition %s = %+v, want %s with the reason %s", ConditionRendered, c, status, reason)
+	}
+	return foo
+}
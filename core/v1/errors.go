@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	// ErrImagePull is used as part of the Event 'reason' when a Foo fails
+	// to sync due to a pod which couldn't pull its image.
+	ErrImagePull = "ErrImagePull"
+	// ErrQuotaExceeded is used as part of the Event 'reason' when a Foo fails
+	// to sync due to a ResourceQuota of the namespace.
+	ErrQuotaExceeded = "ErrQuotaExceeded"
+	// ErrConflict is used as part of the Event 'reason' when a Foo fails
+	// to sync due to an object which was modified by someone else.
+	ErrConflict = "ErrConflict"
+	// ErrInvalidSpec is used as part of the Event 'reason' when a Foo was not synced
+	// since its spec was invalid, e.g. it was stored before the schema required the fields.
+	ErrInvalidSpec = "ErrInvalidSpec"
+	// ErrSyncFailed is used as part of the Event 'reason' when a Foo fails
+	// to sync due to any other error.
+	ErrSyncFailed = "ErrSyncFailed"
+
+	// MessageSyncFailed is the message used for the Warning Events which were
+	// fired when a Foo fails to sync, with the number of the retries.
+	MessageSyncFailed = "Sync failed (retry %d): %v"
+)
+
+// ResourceExistsError reports a child which already exists and is not controlled by the Foo
+type ResourceExistsError struct {
+	Kind string
+	Name string
+}
+
+func (e *ResourceExistsError) Error() string {
+	return fmt.Sprintf(MessageResourceExists, e.Kind+"/"+e.Name)
+}
+
+// NewResourceExistsError returns the ResourceExistsError of the child of kind with name
+func NewResourceExistsError(kind, name string) error {
+	return &ResourceExistsError{Kind: kind, Name: name}
+}
+
+// ImagePullError reports a container which couldn't pull its image
+type ImagePullError struct {
+	Pod       string
+	Container string
+	Image     string
+	Reason    string
+	Message   string
+}
+
+func (e *ImagePullError) Error() string {
+	return fmt.Sprintf("container %s of pod %s couldn't pull image %q: %s %s", e.Container, e.Pod, e.Image, e.Reason, e.Message)
+}
+
+// SyncErrorReason returns the reason of the Warning Event which explains why the sync failed with err
+func SyncErrorReason(err error) string {
+	var pullErr *ImagePullError
+	var existsErr *ResourceExistsError
+	switch {
+	case errors.As(err, &pullErr):
+		return ErrImagePull
+	case errors.As(err, &existsErr), apierrors.IsAlreadyExists(err):
+		return ErrResourceExists
+	case apierrors.IsForbidden(err) && strings.Contains(err.Error(), "exceeded quota"):
+		return ErrQuotaExceeded
+	case apierrors.IsConflict(err):
+		return ErrConflict
+	default:
+		return ErrSyncFailed
+	}
+}
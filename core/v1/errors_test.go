@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestSyncErrorReason(t *testing.T) {
+	statefulSets := schema.GroupResource{Group: "apps", Resource: "statefulsets"}
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "reports the image which couldn't be pulled",
+			err:  fmt.Errorf("waiting for the rollout: %w", &ImagePullError{Pod: "cn1-master-0", Container: "redis", Image: "redis:x", Reason: "ErrImagePull"}),
+			want: ErrImagePull,
+		},
+		{
+			name: "reports the child of someone else",
+			err:  fmt.Errorf("syncing: %w", NewResourceExistsError("StatefulSet", "cn1-master")),
+			want: ErrResourceExists,
+		},
+		{
+			name: "reports the child which was created concurrently",
+			err:  apierrors.NewAlreadyExists(statefulSets, "cn1-master"),
+			want: ErrResourceExists,
+		},
+		{
+			name: "reports the quota of the namespace",
+			err:  apierrors.NewForbidden(statefulSets, "cn1-master", errors.New("exceeded quota: compute, requested: cpu=2")),
+			want: ErrQuotaExceeded,
+		},
+		{
+			name: "falls back on the other forbidden errors",
+			err:  apierrors.NewForbidden(statefulSets, "cn1-master", errors.New("not allowed")),
+			want: ErrSyncFailed,
+		},
+		{
+			name: "reports the conflict",
+			err:  apierrors.NewConflict(statefulSets, "cn1-master", errors.New("the object has been modified")),
+			want: ErrConflict,
+		},
+		{
+			name: "falls back on any other error",
+			err:  errors.New("connection refused"),
+			want: ErrSyncFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SyncErrorReason(tt.err); got != tt.want {
+				t.Errorf("SyncErrorReason() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HasFinalizer reports whether the finalizer was set on the object
+func HasFinalizer(o metav1.Object, finalizer string) bool {
+	for _, f := range o.GetFinalizers() {
+		if f == finalizer {
+			return true
+		}
+	}
+	return false
+}
+
+// AddFinalizer sets the finalizer on the object if it was absent
+func AddFinalizer(o metav1.Object, finalizer string) {
+	if HasFinalizer(o, finalizer) {
+		return
+	}
+	o.SetFinalizers(append(o.GetFinalizers(), finalizer))
+}
+
+// RemoveFinalizer removes the finalizer from the object
+func RemoveFinalizer(o metav1.Object, finalizer string) {
+	res := make([]string, 0)
+	for _, f := range o.GetFinalizers() {
+		if f != finalizer {
+			res = append(res, f)
+		}
+	}
+	o.SetFinalizers(res)
+}
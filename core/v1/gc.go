@@ -0,0 +1,154 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/env"
+)
+
+const (
+	// RetainVolumesAnnotation keeps the PersistentVolumeClaims of the StatefulSets which were collected by
+	// CollectOrphans with the value "true", so that the data could be moved under the renamed children by hand.
+	RetainVolumesAnnotation = "nevercase.io/retain-volumes"
+
+	// SuccessCollected is used as part of the Event 'reason' when an orphaned child of a Foo was deleted
+	SuccessCollected = "Collected"
+	// MessageResourceCollected is the message used for an Event fired when an orphaned child of a Foo was deleted
+	MessageResourceCollected = "Resource %q was no longer desired by Foo and was deleted"
+	// SuccessRetained is used as part of the Event 'reason' when a volume of a collected child was kept
+	SuccessRetained = "Retained"
+	// MessageVolumeRetained is the message used for an Event fired when a volume of a collected child was kept
+	MessageVolumeRetained = "PersistentVolumeClaim %q of the deleted StatefulSet %q was retained"
+)
+
+// Children are the names of the children which a Foo desires by their kinds
+type Children struct {
+	StatefulSets []string
+	Services     []string
+	ConfigMaps   []string
+}
+
+// CollectOrphans deletes the StatefulSets, the Services and the ConfigMaps which were selected by selector
+// and controlled by owner but were not in desired, e.g. the ones of the old name after the spec was renamed.
+// The PersistentVolumeClaims of the volumeClaimTemplates of the deleted StatefulSets were deleted as well
+// unless owner was annotated with RetainVolumesAnnotation, the hostPath volumes were always left on the nodes.
+func CollectOrphans(ks KubernetesResource, owner Owner, selector map[string]string, desired Children, recorder record.EventRecorder) error {
+	filter := labels.SelectorFromSet(selector).String()
+	ns := owner.GetNamespace()
+
+	ssl, err := ks.StatefulSet().List(ns, filter)
+	if err != nil {
+		return err
+	}
+	want := sets.NewString(desired.StatefulSets...)
+	for i := range ssl.Items {
+		ss := &ssl.Items[i]
+		if want.Has(ss.Name) || !metav1.IsControlledBy(ss, owner) {
+			continue
+		}
+		if err = ks.StatefulSet().Delete(ns, ss.Name); err != nil {
+			return err
+		}
+		recordCollection(recorder, owner, "StatefulSet", ss.Name)
+		if err = collectVolumes(ks, owner, ss, recorder); err != nil {
+			return err
+		}
+	}
+
+	sl, err := ks.Service().List(ns, filter)
+	if err != nil {
+		return err
+	}
+	want = sets.NewString(desired.Services...)
+	for i := range sl.Items {
+		svc := &sl.Items[i]
+		if want.Has(svc.Name) || !metav1.IsControlledBy(svc, owner) {
+			continue
+		}
+		if err = ks.Service().Delete(ns, svc.Name); err != nil {
+			return err
+		}
+		recordCollection(recorder, owner, "Service", svc.Name)
+	}
+
+	cml, err := ks.ConfigMap().List(ns, filter)
+	if err != nil {
+		return err
+	}
+	want = sets.NewString(desired.ConfigMaps...)
+	for i := range cml.Items {
+		cm := &cml.Items[i]
+		if want.Has(cm.Name) || !metav1.IsControlledBy(cm, owner) {
+			continue
+		}
+		if err = ks.ConfigMap().Delete(ns, cm.Name); err != nil {
+			return err
+		}
+		recordCollection(recorder, owner, "ConfigMap", cm.Name)
+	}
+	return nil
+}
+
+// collectVolumes deletes the PersistentVolumeClaims of the volumeClaimTemplates of ss,
+// whose names were <template>-<statefulset>-<ordinal>
+func collectVolumes(ks KubernetesResource, owner Owner, ss *appsv1.StatefulSet, recorder record.EventRecorder) error {
+	if len(ss.Spec.VolumeClaimTemplates) == 0 || ss.Spec.Selector == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ks.Context(), time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	pvcs := ks.ClientSet().CoreV1().PersistentVolumeClaims(ss.Namespace)
+	list, err := pvcs.List(ctx, metav1.ListOptions{LabelSelector: labels.SelectorFromSet(ss.Spec.Selector.MatchLabels).String()})
+	if err != nil {
+		return err
+	}
+	retain := owner.GetAnnotations()[RetainVolumesAnnotation] == "true"
+	for _, pvc := range list.Items {
+		if !claimedBy(pvc.Name, ss) {
+			continue
+		}
+		if retain {
+			klog.InfoS("Retained", "kind", "PersistentVolumeClaim", "namespace", pvc.Namespace, "name", pvc.Name, "statefulSet", ss.Name)
+			if recorder != nil {
+				recorder.Event(owner, corev1.EventTypeNormal, SuccessRetained, fmt.Sprintf(MessageVolumeRetained, pvc.Name, ss.Name))
+			}
+			continue
+		}
+		err = pvcs.Delete(ctx, pvc.Name, metav1.DeleteOptions{DryRun: DryRunOptions()})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		logDryRun("delete", "PersistentVolumeClaim", pvc.Namespace, pvc.Name, nil, nil)
+		recordCollection(recorder, owner, "PersistentVolumeClaim", pvc.Name)
+	}
+	return nil
+}
+
+// claimedBy reports whether the PersistentVolumeClaim of name was created from a volumeClaimTemplate of ss
+func claimedBy(name string, ss *appsv1.StatefulSet) bool {
+	for _, t := range ss.Spec.VolumeClaimTemplates {
+		if strings.HasPrefix(name, fmt.Sprintf("%s-%s-", t.Name, ss.Name)) {
+			return true
+		}
+	}
+	return false
+}
+
+func recordCollection(recorder record.EventRecorder, owner Owner, kind, name string) {
+	klog.InfoS("Collected", "kind", kind, "namespace", owner.GetNamespace(), "name", name, "owner", owner.GetName())
+	if recorder != nil {
+		recorder.Event(owner, corev1.EventTypeNormal, SuccessCollected, fmt.Sprintf(MessageResourceCollected, kind+"/"+name))
+	}
+}
@@ -0,0 +1,132 @@
+package v1_test
+
+import (
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	k8sTesting "github.com/nevercase/k8s-controller-custom-resource/core/v1/testing"
+)
+
+var testOwnerKind = schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Memcached"}
+
+func newTestOwner(annotations map[string]string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(testOwnerKind)
+	meta := k8sTesting.ObjectMeta("cache")
+	u.SetNamespace(meta.Namespace)
+	u.SetName(meta.Name)
+	u.SetUID(meta.UID)
+	u.SetAnnotations(annotations)
+	return u
+}
+
+// testChildMeta returns the ObjectMeta of the child of name, which was selected by the labels of the owner
+// and controlled by owner unless it was nil
+func testChildMeta(owner *unstructured.Unstructured, name string) metav1.ObjectMeta {
+	meta := metav1.ObjectMeta{
+		Name:      name,
+		Namespace: k8sTesting.Namespace,
+		Labels:    map[string]string{k8sCoreV1.LabelApp: testOwnerKind.Kind},
+	}
+	if owner != nil {
+		meta.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(owner, testOwnerKind)}
+	}
+	return meta
+}
+
+func TestCollectOrphans(t *testing.T) {
+	owner := newTestOwner(nil)
+	objects := func(owner *unstructured.Unstructured) []runtime.Object {
+		renamed := &appsv1.StatefulSet{
+			ObjectMeta: testChildMeta(owner, "cn0-master"),
+			Spec: appsv1.StatefulSetSpec{
+				Selector:             &metav1.LabelSelector{MatchLabels: map[string]string{"statefulset": "cn0-master"}},
+				VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "data"}}},
+			},
+		}
+		pvc := func(name string) *corev1.PersistentVolumeClaim {
+			return &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: k8sTesting.Namespace,
+				Labels:    renamed.Spec.Selector.MatchLabels,
+			}}
+		}
+		return []runtime.Object{
+			&appsv1.StatefulSet{ObjectMeta: testChildMeta(owner, "cn1-master")},
+			renamed,
+			// The StatefulSet which was not controlled by the owner was never collected
+			&appsv1.StatefulSet{ObjectMeta: testChildMeta(nil, "cn0-slave")},
+			&corev1.Service{ObjectMeta: testChildMeta(owner, "cn1-master")},
+			&corev1.Service{ObjectMeta: testChildMeta(owner, "cn0-master")},
+			&corev1.ConfigMap{ObjectMeta: testChildMeta(owner, "cn0-master-config")},
+			pvc("data-cn0-master-0"),
+			// The claim of another volumeClaimTemplate which matches the selector
+			pvc("logs-cn0-master-0"),
+		}
+	}
+	desired := k8sCoreV1.Children{StatefulSets: []string{"cn1-master"}, Services: []string{"cn1-master"}}
+	selector := map[string]string{k8sCoreV1.LabelApp: testOwnerKind.Kind}
+
+	tests := []struct {
+		name        string
+		owner       *unstructured.Unstructured
+		wantPVC     bool
+		wantReasons []string
+	}{
+		{
+			name:  "deletes the orphans and their volumes",
+			owner: owner,
+			wantReasons: []string{
+				k8sCoreV1.SuccessCollected, k8sCoreV1.SuccessCollected, k8sCoreV1.SuccessCollected, k8sCoreV1.SuccessCollected,
+			},
+		},
+		{
+			name:    "retains the volumes with the annotation",
+			owner:   newTestOwner(map[string]string{k8sCoreV1.RetainVolumesAnnotation: "true"}),
+			wantPVC: true,
+			wantReasons: []string{
+				k8sCoreV1.SuccessCollected, k8sCoreV1.SuccessRetained, k8sCoreV1.SuccessCollected, k8sCoreV1.SuccessCollected,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := k8sTesting.NewFixture(t, objects(tt.owner)...)
+			f.Start()
+			f.WaitForCache()
+
+			if err := k8sCoreV1.CollectOrphans(f.Resource(), tt.owner, selector, desired, f.Recorder); err != nil {
+				t.Fatalf("CollectOrphans() error = %v", err)
+			}
+			if got, want := f.StatefulSetNames(k8sTesting.Namespace), []string{"cn0-slave", "cn1-master"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("StatefulSets = %v, want %v", got, want)
+			}
+			if got, want := f.ServiceNames(k8sTesting.Namespace), []string{"cn1-master"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("Services = %v, want %v", got, want)
+			}
+			if got := f.ConfigMapNames(k8sTesting.Namespace); len(got) != 0 {
+				t.Errorf("ConfigMaps = %v, want none", got)
+			}
+			pvcs := f.KubeClientSet.CoreV1().PersistentVolumeClaims(k8sTesting.Namespace)
+			_, err := pvcs.Get(f.Context(), "data-cn0-master-0", metav1.GetOptions{})
+			if exists := !errors.IsNotFound(err); exists != tt.wantPVC {
+				t.Errorf("PersistentVolumeClaim exists = %v, want %v", exists, tt.wantPVC)
+			}
+			if _, err = pvcs.Get(f.Context(), "logs-cn0-master-0", metav1.GetOptions{}); err != nil {
+				t.Errorf("the claim of another template was deleted: %v", err)
+			}
+			if got := f.Recorder.Reasons(); !reflect.DeepEqual(got, tt.wantReasons) {
+				t.Errorf("event reasons = %v, want %v", got, tt.wantReasons)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/env"
+)
+
+// ApplyIngress creates the Ingress, or updates the spec, the labels and the annotations of the existing one.
+// The Ingress which was controlled by another owner than the one of d was never overwritten.
+// The Ingresses were not watched, so that they were read from the apiserver.
+func ApplyIngress(ctx context.Context, client kubernetes.Interface, d *networkingv1.Ingress) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	ingresses := client.NetworkingV1().Ingresses(d.Namespace)
+	current, err := ingresses.Get(ctx, d.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		if _, err = ingresses.Create(ctx, d, metav1.CreateOptions{DryRun: DryRunOptions()}); err != nil {
+			return err
+		}
+		logDryRun("create", "Ingress", d.Namespace, d.Name, nil, d)
+		return nil
+	}
+	if !sameController(current, d) {
+		return NewResourceExistsError("Ingress", d.Name)
+	}
+	if equality.Semantic.DeepEqual(current.Spec, d.Spec) &&
+		equality.Semantic.DeepEqual(current.Labels, d.Labels) &&
+		equality.Semantic.DeepEqual(current.Annotations, d.Annotations) {
+		return nil
+	}
+	ingress := current.DeepCopy()
+	ingress.Labels, ingress.Annotations, ingress.Spec = d.Labels, d.Annotations, d.Spec
+	if _, err = ingresses.Update(ctx, ingress, metav1.UpdateOptions{DryRun: DryRunOptions()}); err != nil {
+		return err
+	}
+	logDryRun("update", "Ingress", d.Namespace, d.Name, current, ingress)
+	return nil
+}
+
+// DeleteIngress deletes the Ingress of name if it was controlled by owner
+func DeleteIngress(ctx context.Context, client kubernetes.Interface, owner Owner, name string) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	ingresses := client.NetworkingV1().Ingresses(owner.GetNamespace())
+	current, err := ingresses.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !metav1.IsControlledBy(current, owner) {
+		return nil
+	}
+	if err = ingresses.Delete(ctx, name, metav1.DeleteOptions{DryRun: DryRunOptions()}); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	logDryRun("delete", "Ingress", owner.GetNamespace(), name, nil, nil)
+	return nil
+}
+
+// sameController reports whether current was controlled by the controller of desired,
+// or by nothing if desired had no controller
+func sameController(current, desired metav1.Object) bool {
+	c, d := metav1.GetControllerOf(current), metav1.GetControllerOf(desired)
+	if c == nil || d == nil {
+		return c == nil && d == nil
+	}
+	return c.UID == d.UID
+}
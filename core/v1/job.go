@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/env"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	batchlistersv1 "k8s.io/client-go/listers/batch/v1"
+	"k8s.io/klog/v2"
+)
+
+type KubernetesJob interface {
+	Get(nameSpace, specName string) (j *batchv1.Job, err error)
+	Create(nameSpace string, j *batchv1.Job) (*batchv1.Job, error)
+	Delete(nameSpace, specName string) error
+	List(nameSpace, filterName string) (jl *batchv1.JobList, err error)
+	WithContext(ctx context.Context) KubernetesJob
+}
+
+func NewKubernetesJob(kubeClientSet kubernetes.Interface, kubeInformerFactory kubeinformers.SharedInformerFactory) KubernetesJob {
+	timeout, _ := env.GetExecutionTimeoutDuration()
+	return &kubernetesJob{
+		kubeClientSet:         kubeClientSet,
+		jobLister:             kubeInformerFactory.Batch().V1().Jobs().Lister(),
+		executionTimeoutInSec: timeout,
+		ctx:                   context.Background(),
+	}
+}
+
+type kubernetesJob struct {
+	kubeClientSet         kubernetes.Interface
+	jobLister             batchlistersv1.JobLister
+	executionTimeoutInSec int64
+	ctx                   context.Context
+}
+
+// WithContext returns a copy of the KubernetesJob whose requests were bound to ctx
+func (kj *kubernetesJob) WithContext(ctx context.Context) KubernetesJob {
+	c := *kj
+	c.ctx = ctx
+	return &c
+}
+
+func (kj *kubernetesJob) Get(nameSpace, specName string) (j *batchv1.Job, err error) {
+	if specName == "" {
+		// We choose to absorb the error here as the worker would requeue the
+		// resource otherwise. Instead, the next time the resource is updated
+		// the resource will be queued again.
+		utilruntime.HandleError(fmt.Errorf("%s: name must be specified", specName))
+		return j, fmt.Errorf("%s: name must be specified", specName)
+	}
+	// Get the job with the name specified in spec
+	job, err := kj.jobLister.Jobs(nameSpace).Get(fmt.Sprintf(JobNameTemplate, specName))
+	return job, err
+}
+
+func (kj *kubernetesJob) Create(nameSpace string, j *batchv1.Job) (*batchv1.Job, error) {
+	createOpt := metav1.CreateOptions{DryRun: DryRunOptions()}
+	ctx, cancel := context.WithTimeout(kj.ctx, time.Second*time.Duration(kj.executionTimeoutInSec))
+	job, err := kj.kubeClientSet.BatchV1().Jobs(nameSpace).Create(ctx, j, createOpt)
+	cancel()
+	if err != nil {
+		klog.V(2).Info(err)
+	} else {
+		logDryRun("create", "Job", nameSpace, j.Name, nil, job)
+	}
+	return job, err
+}
+
+func (kj *kubernetesJob) Delete(nameSpace, specName string) error {
+	// Get the job with the name specified in spec
+	_, err := kj.Get(nameSpace, specName)
+	// If the resource doesn't exist, we'll return nil
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	// The pods of the job should be removed together with it
+	propagation := metav1.DeletePropagationBackground
+	opts := metav1.DeleteOptions{
+		DryRun:            DryRunOptions(),
+		PropagationPolicy: &propagation,
+	}
+	ctx, cancel := context.WithTimeout(kj.ctx, time.Second*time.Duration(kj.executionTimeoutInSec))
+	err = kj.kubeClientSet.BatchV1().Jobs(nameSpace).Delete(ctx, fmt.Sprintf(JobNameTemplate, specName), opts)
+	cancel()
+	if err != nil {
+		klog.V(2).Info(err)
+		return err
+	}
+	logDryRun("delete", "Job", nameSpace, fmt.Sprintf(JobNameTemplate, specName), nil, nil)
+	return nil
+}
+
+func (kj *kubernetesJob) List(nameSpace, filterName string) (jl *batchv1.JobList, err error) {
+	opts := metav1.ListOptions{
+		LabelSelector: filterName,
+	}
+	ctx, cancel := context.WithTimeout(kj.ctx, time.Second*time.Duration(kj.executionTimeoutInSec))
+	jl, err = kj.kubeClientSet.BatchV1().Jobs(nameSpace).List(ctx, opts)
+	cancel()
+	if err != nil {
+		klog.V(2).Info(err)
+	}
+	return jl, err
+}
@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"sync/atomic"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// LoadBalancerAnnotations were added to the Services of the type LoadBalancer,
+// together with either WhiteListOn or WhiteListOff
+type LoadBalancerAnnotations struct {
+	Annotations  map[string]string
+	WhiteListOn  map[string]string
+	WhiteListOff map[string]string
+}
+
+// loadBalancerAnnotations holds the *LoadBalancerAnnotations which were swapped as a whole by SetLoadBalancerAnnotations,
+// so that the syncs which were running never saw the half-written ones
+var loadBalancerAnnotations atomic.Value
+
+// SetLoadBalancerAnnotations replaces the annotations of the Services of the type LoadBalancer,
+// the Services were updated by their next syncs. a mustn't be modified afterwards.
+func SetLoadBalancerAnnotations(a *LoadBalancerAnnotations) {
+	loadBalancerAnnotations.Store(a)
+}
+
+// LoadBalancerAnnotation returns the annotations of the Service of svcType, which were empty unless it was a LoadBalancer
+func LoadBalancerAnnotation(svcType corev1.ServiceType, whiteListOn bool) map[string]string {
+	res := make(map[string]string)
+	a, ok := loadBalancerAnnotations.Load().(*LoadBalancerAnnotations)
+	if !ok || a == nil || svcType != corev1.ServiceTypeLoadBalancer {
+		return res
+	}
+	whiteList := a.WhiteListOff
+	if whiteListOn {
+		whiteList = a.WhiteListOn
+	}
+	for _, m := range []map[string]string{a.Annotations, whiteList} {
+		for k, v := range m {
+			res[k] = v
+		}
+	}
+	return res
+}
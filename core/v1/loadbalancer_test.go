@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestLoadBalancerAnnotation(t *testing.T) {
+	defer SetLoadBalancerAnnotations(nil)
+	if got := LoadBalancerAnnotation(corev1.ServiceTypeLoadBalancer, true); len(got) != 0 {
+		t.Errorf("LoadBalancerAnnotation() before SetLoadBalancerAnnotations = %v", got)
+	}
+	SetLoadBalancerAnnotations(&LoadBalancerAnnotations{
+		Annotations:  map[string]string{"lb": "internal"},
+		WhiteListOn:  map[string]string{"acl": "on"},
+		WhiteListOff: map[string]string{"acl": "off"},
+	})
+	tests := []struct {
+		svcType     corev1.ServiceType
+		whiteListOn bool
+		want        map[string]string
+	}{
+		{corev1.ServiceTypeLoadBalancer, true, map[string]string{"lb": "internal", "acl": "on"}},
+		{corev1.ServiceTypeLoadBalancer, false, map[string]string{"lb": "internal", "acl": "off"}},
+		{corev1.ServiceTypeClusterIP, true, map[string]string{}},
+	}
+	for _, tt := range tests {
+		if got := LoadBalancerAnnotation(tt.svcType, tt.whiteListOn); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("LoadBalancerAnnotation(%s, %v) = %v, want %v", tt.svcType, tt.whiteListOn, got, tt.want)
+		}
+	}
+}
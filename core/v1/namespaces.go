@@ -0,0 +1,58 @@
+package v1
+
+import (
+	"sort"
+	"sync"
+)
+
+// NamespaceFilter restricts the controller to the Foos of a set of namespaces, the empty set allows all of them.
+// The set could be replaced while the controller was running, e.g. when the config was reloaded,
+// the Foos which were already queued were still processed.
+type NamespaceFilter struct {
+	mu         sync.RWMutex
+	namespaces map[string]struct{}
+}
+
+// NewNamespaceFilter returns the NamespaceFilter of namespaces
+func NewNamespaceFilter(namespaces ...string) *NamespaceFilter {
+	f := &NamespaceFilter{}
+	f.Set(namespaces...)
+	return f
+}
+
+// Set replaces the namespaces of f
+func (f *NamespaceFilter) Set(namespaces ...string) {
+	m := make(map[string]struct{}, len(namespaces))
+	for _, ns := range namespaces {
+		m[ns] = struct{}{}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.namespaces = m
+}
+
+// List returns the sorted namespaces of f
+func (f *NamespaceFilter) List() []string {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	res := make([]string, 0, len(f.namespaces))
+	for ns := range f.namespaces {
+		res = append(res, ns)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// Allows returns true if f was nil or empty, or if it contains namespace
+func (f *NamespaceFilter) Allows(namespace string) bool {
+	if f == nil {
+		return true
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if len(f.namespaces) == 0 {
+		return true
+	}
+	_, ok := f.namespaces[namespace]
+	return ok
+}
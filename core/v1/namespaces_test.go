@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNamespaceFilter(t *testing.T) {
+	var unset *NamespaceFilter
+	if !unset.Allows("default") {
+		t.Error("the nil filter refused a namespace")
+	}
+	f := NewNamespaceFilter()
+	if !f.Allows("default") {
+		t.Error("the empty filter refused a namespace")
+	}
+
+	f.Set("team-b", "team-a")
+	if got, want := f.List(), []string{"team-a", "team-b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("List() = %v, want %v", got, want)
+	}
+	if !f.Allows("team-a") || f.Allows("default") {
+		t.Errorf("the filter of %v allowed the wrong namespaces", f.List())
+	}
+
+	// The namespaces were replaced rather than added
+	f.Set("default")
+	if f.Allows("team-a") || !f.Allows("default") {
+		t.Errorf("the filter of %v kept the namespaces which were replaced", f.List())
+	}
+}
@@ -4,8 +4,6 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/labels"
-	"k8s.io/apimachinery/pkg/selection"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -20,33 +18,47 @@ type KubernetesOperator interface {
 	Resource() KubernetesResource
 	AgentName() string
 	Options() Options
-	Watch()
+}
+
+// OperatorOption configures the operator which was built by NewKubernetesOperator
+type OperatorOption func(*kubernetesOperator)
+
+// WithRecorder replaces the EventRecorder which records the events to the api server, e.g. with a fake one in the tests
+func WithRecorder(recorder record.EventRecorder) OperatorOption {
+	return func(ko *kubernetesOperator) {
+		ko.recorder = recorder
+	}
 }
 
 func NewKubernetesOperator(kubeClientset kubernetes.Interface,
 	stopCh <-chan struct{},
 	agentName string,
-	opts Options) KubernetesOperator {
-
-	//utilruntime.Must(err)
-	klog.V(4).Info("Creating event broadcaster")
-	eventBroadcaster := record.NewBroadcaster()
-	eventBroadcaster.StartLogging(klog.Infof)
-	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClientset.CoreV1().Events("")})
-	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: agentName})
+	opts Options,
+	operatorOpts ...OperatorOption) KubernetesOperator {
 
 	kubeInformerFactory := kubeinformers.NewSharedInformerFactory(kubeClientset, time.Second*30)
 
 	var ko = &kubernetesOperator{
 		kubeClientSet:       kubeClientset,
 		kubeInformerFactory: kubeInformerFactory,
-		recorder:            recorder,
 		kubernetesResource:  NewKubernetesResource(kubeClientset, kubeInformerFactory),
 		agentName:           agentName,
 		options:             opts,
 	}
+	for _, o := range operatorOpts {
+		o(ko)
+	}
 
-	//ko.Watch()
+	if ko.recorder == nil {
+		//utilruntime.Must(err)
+		klog.V(4).Info("Creating event broadcaster")
+		eventBroadcaster := record.NewBroadcaster()
+		eventBroadcaster.StartLogging(klog.Infof)
+		if !IsDryRun() {
+			eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClientset.CoreV1().Events("")})
+		}
+		ko.recorder = eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: agentName})
+	}
 
 	// notice that there is no need to run Start methods in a separate goroutine. (i.e. go kubeInformerFactory.Start(stopCh)
 	// Start method is non-blocking and runs all registered informers in a dedicated goroutine
@@ -83,34 +95,3 @@ func (ko *kubernetesOperator) AgentName() string {
 func (ko *kubernetesOperator) Options() Options {
 	return ko.options
 }
-
-func (ko *kubernetesOperator) Watch() {
-	for _, opt := range ko.Options().List() {
-		go ko.OptionWatch(opt)
-	}
-}
-
-func (ko *kubernetesOperator) OptionWatch(opt Option) {
-	defer ko.OptionWatch(opt)
-	req, err := labels.NewRequirement(LabelApp, selection.Equals, []string{opt.KindName()})
-	if err != nil {
-		klog.Fatal(err)
-	}
-	res, err := ko.kubernetesResource.StatefulSet().Watch("", req.String())
-	if err != nil {
-		klog.Fatal(err)
-	}
-	for {
-		select {
-		case e, isClosed := <-res.ResultChan():
-			klog.Infof("watch kindName:%v StatefulSet ========= e:", opt.KindName(), e)
-			if !isClosed {
-				klog.Infof("watch closed kindName:%v StatefulSet ========= e:", opt.KindName(), e)
-				return
-			}
-			if err := opt.WriteWatchChan(e, ko.kubernetesResource, ko.recorder); err != nil {
-				klog.V(2).Info(err)
-			}
-		}
-	}
-}
@@ -1,16 +1,17 @@
 package v1
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"sync"
-	"time"
 
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
-	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
-	"k8s.io/klog/v2"
 )
 
 const (
@@ -19,8 +20,6 @@ const (
 	ErrOptionExists = "ErrOptionExists"
 
 	ErrOptionKindDoesNotExisted = "ErrOptionKindDoesNotExisted"
-
-	ErrOptionWriteWatchChanTimeout = "ErrOptionWriteWatchChanTimeout"
 )
 
 type Options interface {
@@ -79,12 +78,40 @@ type Option interface {
 	KindName() string
 	AgentName() string
 	Informer() cache.SharedIndexInformer
-	SyncHandleObject(obj interface{}, ks KubernetesResource, recorder record.EventRecorder) error
+	// SyncHandleObject syncs obj with ks, which was bound to ctx, the sync should stop once ctx was done.
+	// The Result tells when obj should be synced again, it was ignored if the sync failed.
+	SyncHandleObject(ctx context.Context, obj interface{}, ks KubernetesResource, recorder record.EventRecorder) (Result, error)
 	CompareResourceVersion(old, new interface{}) bool
 	Get(nameSpace, ownerRefName string) (obj interface{}, err error)
-	SyncObjectStatus(obj interface{}, ks KubernetesResource, recorder record.EventRecorder) error
-	WriteWatchChan(e watch.Event, ks KubernetesResource, recorder record.EventRecorder) (err error)
-	Watch()
+	SyncObjectStatus(ctx context.Context, obj interface{}, ks KubernetesResource, recorder record.EventRecorder) error
+}
+
+// PausableOption is implemented by the Option which reflects the paused state in the status of the objects,
+// SyncPausedObject was called before each sync of obj with whether it was paused.
+type PausableOption interface {
+	SyncPausedObject(ctx context.Context, obj interface{}, paused bool, ks KubernetesResource, recorder record.EventRecorder) error
+}
+
+// PatchableOption is implemented by the Option which could patch its objects, e.g. with the finalizer
+// of ProtectDeleteAnnotation. The objects of the Options which couldn't be patched were not protected.
+type PatchableOption interface {
+	PatchObject(ctx context.Context, obj interface{}, pt types.PatchType, data []byte) error
+}
+
+// ValidatingOption is implemented by the Option whose objects were validated by the admission webhook
+type ValidatingOption interface {
+	// New returns the empty object which the AdmissionRequest was decoded into
+	New() runtime.Object
+	ValidateCreate(obj runtime.Object) field.ErrorList
+	ValidateUpdate(old, obj runtime.Object) field.ErrorList
+}
+
+// DefaultingOption is implemented by the Option whose objects were defaulted by the admission webhook
+type DefaultingOption interface {
+	// New returns the empty object which the AdmissionRequest was decoded into
+	New() runtime.Object
+	// Default fills the unspecified fields of obj in place
+	Default(obj runtime.Object) error
 }
 
 type option struct {
@@ -98,8 +125,6 @@ type option struct {
 	getFunc                    func(informer interface{}, nameSpace, ownerRefName string) (obj interface{}, err error)
 	syncFunc                   func(obj interface{}, agentClientSet interface{}, ks KubernetesResource, opt record.EventRecorder) error
 	syncStatusFunc             func(obj interface{}, agentClientSet interface{}, ks KubernetesResource, recorder record.EventRecorder) error
-
-	watchChan chan OptionWatch
 }
 
 func NewOption(operator interface{},
@@ -126,9 +151,7 @@ func NewOption(operator interface{},
 		getFunc:                    getFunc,
 		syncFunc:                   syncFunc,
 		syncStatusFunc:             syncStatusFunc,
-		watchChan:                  make(chan OptionWatch, 4096),
 	}
-	//go opt.Watch()
 	return opt
 }
 
@@ -148,8 +171,9 @@ func (opt *option) Informer() cache.SharedIndexInformer {
 	return opt.informer
 }
 
-func (opt *option) SyncHandleObject(obj interface{}, ks KubernetesResource, recorder record.EventRecorder) error {
-	return opt.syncFunc(obj, opt.agentClientSet, ks, recorder)
+// SyncHandleObject never requeues obj, since the syncFunc returns the error only
+func (opt *option) SyncHandleObject(ctx context.Context, obj interface{}, ks KubernetesResource, recorder record.EventRecorder) (Result, error) {
+	return Result{}, opt.syncFunc(obj, opt.agentClientSet, ks.WithContext(ctx), recorder)
 }
 
 func (opt *option) CompareResourceVersion(old, new interface{}) bool {
@@ -160,38 +184,6 @@ func (opt *option) Get(nameSpace, ownerRefName string) (obj interface{}, err err
 	return opt.getFunc(opt.agent, nameSpace, ownerRefName)
 }
 
-func (opt *option) SyncObjectStatus(obj interface{}, ks KubernetesResource, recorder record.EventRecorder) error {
-	return opt.syncStatusFunc(obj, opt.agentClientSet, ks, recorder)
-}
-
-type OptionWatch struct {
-	Resource KubernetesResource
-	Recorder record.EventRecorder
-	Event    watch.Event
-}
-
-func (opt *option) WriteWatchChan(e watch.Event, ks KubernetesResource, recorder record.EventRecorder) (err error) {
-	after := time.After(time.Millisecond * 500)
-	select {
-	case <-after:
-		return fmt.Errorf("%s kind:%v", ErrOptionWriteWatchChanTimeout, opt.kindName)
-	case opt.watchChan <- OptionWatch{Event: e, Resource: ks, Recorder: recorder}:
-		klog.Info("WriteWatchChan +++++++")
-	}
-	return nil
-}
-
-func (opt *option) Watch() {
-	for {
-		select {
-		case ow, isClosed := <-opt.watchChan:
-			klog.Info("Receive WatchChan ----------- e:", ow)
-			if !isClosed {
-				return
-			}
-			if err := opt.syncStatusFunc(ow.Event.Object, opt.agentClientSet, ow.Resource, ow.Recorder); err != nil {
-				klog.V(2).Info(err)
-			}
-		}
-	}
+func (opt *option) SyncObjectStatus(ctx context.Context, obj interface{}, ks KubernetesResource, recorder record.EventRecorder) error {
+	return opt.syncStatusFunc(obj, opt.agentClientSet, ks.WithContext(ctx), recorder)
 }
@@ -1 +1,108 @@
 package v1
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	kubeinformers "k8s.io/client-go/informers"
+	corelistersv1 "k8s.io/client-go/listers/core/v1"
+
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/env"
+)
+
+// KubernetesPod reads the pods from the lister of the shared informer, the pods were never written by the operators
+// but the labels of EnsurePodLabel. The returned pods were shared with the informer and must not be modified.
+type KubernetesPod interface {
+	Get(nameSpace, name string) (*corev1.Pod, error)
+	List(nameSpace string, selector map[string]string) ([]*corev1.Pod, error)
+}
+
+func NewKubernetesPod(kubeInformerFactory kubeinformers.SharedInformerFactory) KubernetesPod {
+	return &kubernetesPod{podLister: kubeInformerFactory.Core().V1().Pods().Lister()}
+}
+
+type kubernetesPod struct {
+	podLister corelistersv1.PodLister
+}
+
+func (kp *kubernetesPod) Get(nameSpace, name string) (*corev1.Pod, error) {
+	return kp.podLister.Pods(nameSpace).Get(name)
+}
+
+func (kp *kubernetesPod) List(nameSpace string, selector map[string]string) ([]*corev1.Pod, error) {
+	return kp.podLister.Pods(nameSpace).List(labels.SelectorFromSet(selector))
+}
+
+// EnsurePodLabel sets the label key of each pod which was selected by selector to the value returned by fn.
+// It was used for the labels which change at runtime (such as LabelAccess after a failover),
+// since the pod template couldn't be changed without restarting the pods.
+// The pods were listed from the informer, and only those whose label differs were patched.
+func EnsurePodLabel(ks KubernetesResource, nameSpace string, selector map[string]string, key string, fn func(pod *corev1.Pod) string) error {
+	pods, err := ks.Pod().List(nameSpace, selector)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ks.Context(), time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	kubeClientSet := ks.ClientSet()
+	for _, pod := range pods {
+		value := fn(pod)
+		if value == "" || pod.Labels[key] == value {
+			continue
+		}
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"labels": map[string]string{key: value},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		patched, err := kubeClientSet.CoreV1().Pods(nameSpace).Patch(ctx, pod.Name, types.MergePatchType, patch, metav1.PatchOptions{DryRun: DryRunOptions()})
+		if err != nil {
+			return err
+		}
+		logDryRun("patch", "Pod", nameSpace, pod.Name, pod, patched)
+	}
+	return nil
+}
+
+// imagePullReasons are the waiting reasons of the containers which couldn't pull their images
+var imagePullReasons = map[string]bool{
+	"ErrImagePull":      true,
+	"ImagePullBackOff":  true,
+	"InvalidImageName":  true,
+	"ErrImageNeverPull": true,
+}
+
+// CheckImagePull returns the ImagePullError of the first container of the pods selected by selector
+// which couldn't pull its image, so that the Foo was requeued and the reason was recorded on it.
+func CheckImagePull(ks KubernetesResource, nameSpace string, selector map[string]string) error {
+	pods, err := ks.Pod().List(nameSpace, selector)
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods {
+		// The statuses were copied, appending to the ones of the pod in the informer would write into its backing array
+		statuses := make([]corev1.ContainerStatus, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+		statuses = append(statuses, pod.Status.InitContainerStatuses...)
+		statuses = append(statuses, pod.Status.ContainerStatuses...)
+		for _, cs := range statuses {
+			if cs.State.Waiting != nil && imagePullReasons[cs.State.Waiting.Reason] {
+				return &ImagePullError{
+					Pod:       pod.Name,
+					Container: cs.Name,
+					Image:     cs.Image,
+					Reason:    cs.State.Waiting.Reason,
+					Message:   cs.State.Waiting.Message,
+				}
+			}
+		}
+	}
+	return nil
+}
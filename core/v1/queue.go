@@ -0,0 +1,175 @@
+package v1
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// QueueConfig configures the work queue of an Option, the zero fields were filled from the controller's QueueConfig
+// and then from DefaultQueueConfig.
+type QueueConfig struct {
+	// Workers is the number of the workers of the routine lane, it falls back to the threadiness of Run
+	Workers int
+	// PriorityWorkers is the number of the workers of the priority lane
+	PriorityWorkers int
+	// BaseDelay is the first back-off of a failed item, which doubles on each failure
+	BaseDelay time.Duration
+	// MaxDelay caps the back-off of a failed item
+	MaxDelay time.Duration
+	// QPS is the overall rate of the requeued items of the queue
+	QPS float64
+	// Burst is the bucket size of QPS
+	Burst int
+}
+
+// DefaultQueueConfig returns the parameters of workqueue.DefaultControllerRateLimiter
+func DefaultQueueConfig() QueueConfig {
+	return QueueConfig{
+		PriorityWorkers: 1,
+		BaseDelay:       time.Millisecond * 5,
+		MaxDelay:        time.Second * 1000,
+		QPS:             10,
+		Burst:           100,
+	}
+}
+
+// withDefaults fills the zero fields of c with d
+func (c QueueConfig) withDefaults(d QueueConfig) QueueConfig {
+	if c.Workers <= 0 {
+		c.Workers = d.Workers
+	}
+	if c.PriorityWorkers <= 0 {
+		c.PriorityWorkers = d.PriorityWorkers
+	}
+	if c.BaseDelay <= 0 {
+		c.BaseDelay = d.BaseDelay
+	}
+	if c.MaxDelay <= 0 {
+		c.MaxDelay = d.MaxDelay
+	}
+	if c.QPS <= 0 {
+		c.QPS = d.QPS
+	}
+	if c.Burst <= 0 {
+		c.Burst = d.Burst
+	}
+	return c
+}
+
+// RateLimiter returns the per-item exponential back-off together with the overall token bucket,
+// which was what workqueue.DefaultControllerRateLimiter builds with the fixed parameters.
+func (c QueueConfig) RateLimiter() workqueue.RateLimiter {
+	return workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(c.BaseDelay, c.MaxDelay),
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(c.QPS), c.Burst)},
+	)
+}
+
+// ControllerOption configures the controller which was built by NewKubernetesController
+type ControllerOption func(*controllerConfig)
+
+type controllerConfig struct {
+	queue      QueueConfig
+	kinds      map[string]QueueConfig
+	namespaces *NamespaceFilter
+}
+
+// WithQueueConfig sets the QueueConfig shared by the Options which have no QueueConfig of their own
+func WithQueueConfig(c QueueConfig) ControllerOption {
+	return func(cc *controllerConfig) {
+		cc.queue = c
+	}
+}
+
+// WithKindQueueConfig sets the QueueConfig of the Option of kindName, e.g. to give it more workers
+func WithKindQueueConfig(kindName string, c QueueConfig) ControllerOption {
+	return func(cc *controllerConfig) {
+		cc.kinds[kindName] = c
+	}
+}
+
+// WithNamespaceFilter restricts the controller to the Foos of the namespaces of f,
+// f could be updated later without restarting the controller
+func WithNamespaceFilter(f *NamespaceFilter) ControllerOption {
+	return func(cc *controllerConfig) {
+		cc.namespaces = f
+	}
+}
+
+// PriorityOption could be implemented by an Option to put obj on the priority lane of its queue,
+// e.g. while a failover was in progress. The deletions always go on the priority lane.
+type PriorityOption interface {
+	Priority(obj interface{}) bool
+}
+
+// optionQueue is the work queue of an Option. The priority lane has its own workers,
+// so the deletions and the failovers don't wait behind the routine resyncs of the same kind.
+type optionQueue struct {
+	kindName string
+	config   QueueConfig
+	routine  workqueue.RateLimitingInterface
+	priority workqueue.RateLimitingInterface
+	locks    keyLocks
+}
+
+func newOptionQueue(kindName string, config QueueConfig) *optionQueue {
+	return &optionQueue{
+		kindName: kindName,
+		config:   config,
+		routine:  workqueue.NewNamedRateLimitingQueue(config.RateLimiter(), kindName),
+		priority: workqueue.NewNamedRateLimitingQueue(config.RateLimiter(), kindName+"-priority"),
+		locks:    keyLocks{locks: make(map[string]*keyLock)},
+	}
+}
+
+// lane returns the priority lane if priority was true, otherwise the routine lane
+func (q *optionQueue) lane(priority bool) workqueue.RateLimitingInterface {
+	if priority {
+		return q.priority
+	}
+	return q.routine
+}
+
+func (q *optionQueue) shutDown() {
+	q.routine.ShutDown()
+	q.priority.ShutDown()
+}
+
+// keyLocks keeps the same resource from being processed by both lanes at the same time,
+// which the work queue guarantees only within a lane. The locks were keyed on the namespace/name of the
+// resource of the Option, so that its sync and the status tasks of its children were serialized too.
+type keyLocks struct {
+	mu    sync.Mutex
+	locks map[string]*keyLock
+}
+
+type keyLock struct {
+	sync.Mutex
+	refs int
+}
+
+func (l *keyLocks) lock(key string) {
+	l.mu.Lock()
+	kl, ok := l.locks[key]
+	if !ok {
+		kl = &keyLock{}
+		l.locks[key] = kl
+	}
+	kl.refs++
+	l.mu.Unlock()
+	kl.Lock()
+}
+
+func (l *keyLocks) unlock(key string) {
+	l.mu.Lock()
+	kl := l.locks[key]
+	kl.refs--
+	if kl.refs == 0 {
+		delete(l.locks, key)
+	}
+	l.mu.Unlock()
+	kl.Unlock()
+}
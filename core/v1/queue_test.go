@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+func TestKeyLocks(t *testing.T) {
+	sync := Task{key: "default/example", priority: true}
+	status := Task{key: "default/example-master", objectType: reflect.TypeOf(&appsv1.StatefulSet{}), ownerKey: "default/example", status: true}
+	if sync.lockKey() != status.lockKey() {
+		t.Fatalf("the status task locked %q rather than the owner %q", status.lockKey(), sync.lockKey())
+	}
+
+	l := keyLocks{locks: make(map[string]*keyLock)}
+	l.lock(sync.lockKey())
+	locked := make(chan struct{})
+	go func() {
+		l.lock(status.lockKey())
+		close(locked)
+	}()
+	select {
+	case <-locked:
+		t.Fatal("the status task was processed together with the sync of its owner")
+	case <-time.After(50 * time.Millisecond):
+	}
+	l.unlock(sync.lockKey())
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatal("the status task was not processed once the sync of its owner finished")
+	}
+	l.unlock(status.lockKey())
+	if len(l.locks) != 0 {
+		t.Errorf("the released locks were kept: %v", l.locks)
+	}
+}
+
+func TestQueueConfigWithDefaults(t *testing.T) {
+	d := DefaultQueueConfig()
+	d.Workers = 2
+	got := QueueConfig{PriorityWorkers: 3, MaxDelay: time.Minute, QPS: -1}.withDefaults(d)
+	want := QueueConfig{Workers: 2, PriorityWorkers: 3, BaseDelay: d.BaseDelay, MaxDelay: time.Minute, QPS: d.QPS, Burst: d.Burst}
+	if got != want {
+		t.Errorf("withDefaults() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,392 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+)
+
+// Reconciler reconciles the custom resource T, it was the typed replacement of the syncFunc of NewOption.
+// The KubernetesResource and the EventRecorder of the operator could be got from the ctx
+// with ResourceFromContext and RecorderFromContext, the requests of the KubernetesResource were bound to the ctx
+// which was cancelled once the controller was stopped.
+type Reconciler[T any] interface {
+	// Reconcile drives the children of obj towards its spec, the Result tells when obj should be reconciled again
+	Reconcile(ctx context.Context, obj *T) (Result, error)
+	// Finalize releases what obj holds once it was being deleted,
+	// it was called only if obj carries finalizers, since obj was gone at once otherwise.
+	Finalize(ctx context.Context, obj *T) error
+}
+
+// StatusReconciler could be implemented by a Reconciler to refresh the status of obj when its child changed
+type StatusReconciler[T any] interface {
+	ReconcileStatus(ctx context.Context, obj *T, child metav1.Object) error
+}
+
+// Object constrains PT to the pointer of T, which was the form of the generated types
+type Object[T any] interface {
+	*T
+	runtime.Object
+	metav1.Object
+}
+
+// PauseReconciler is implemented by the Reconciler which reflects PausedAnnotation in the status of T,
+// ReconcilePaused should do nothing if the status was up to date.
+type PauseReconciler[T any] interface {
+	ReconcilePaused(ctx context.Context, obj *T, paused bool) error
+}
+
+// PriorityReconciler is implemented by the Reconciler which puts obj ahead of the routine resyncs,
+// e.g. while obj was failing over.
+type PriorityReconciler[T any] interface {
+	Priority(obj *T) bool
+}
+
+// ValidatingReconciler is implemented by the Reconciler whose objects were validated by the admission webhook,
+// others were the objects of T in the namespace of obj except obj itself, which were listed from the informer.
+type ValidatingReconciler[T any] interface {
+	ValidateCreate(obj *T, others []*T) field.ErrorList
+	ValidateUpdate(old, obj *T, others []*T) field.ErrorList
+}
+
+// DefaultingReconciler is implemented by the Reconciler whose objects were defaulted by the admission webhook,
+// Default fills the unspecified fields of obj in place.
+type DefaultingReconciler[T any] interface {
+	Default(obj *T)
+}
+
+// Client is the subset of the typed client of a generated clientset which was required by the informer
+// and the finalizer of ProtectDeleteAnnotation, e.g. the RedisOperatorInterface returned by clientSet.NevercaseV1().RedisOperators
+type Client[T any, L runtime.Object] interface {
+	List(ctx context.Context, opts metav1.ListOptions) (L, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*T, error)
+}
+
+type contextKey int
+
+const (
+	resourceContextKey contextKey = iota
+	recorderContextKey
+)
+
+// WithResource returns a copy of ctx which carries the KubernetesResource and the EventRecorder of the operator
+func WithResource(ctx context.Context, ks KubernetesResource, recorder record.EventRecorder) context.Context {
+	ctx = context.WithValue(ctx, resourceContextKey, ks)
+	return context.WithValue(ctx, recorderContextKey, recorder)
+}
+
+// ResourceFromContext returns the KubernetesResource which was set by WithResource
+func ResourceFromContext(ctx context.Context) KubernetesResource {
+	ks, _ := ctx.Value(resourceContextKey).(KubernetesResource)
+	return ks
+}
+
+// RecorderFromContext returns the EventRecorder which was set by WithResource
+func RecorderFromContext(ctx context.Context) record.EventRecorder {
+	recorder, _ := ctx.Value(recorderContextKey).(record.EventRecorder)
+	return recorder
+}
+
+// Builder builds the Option of the custom resource T with a Reconciler.
+// The kind name was derived from T, the informer and the lister were derived from the typed client.
+type Builder[T any, PT Object[T]] struct {
+	kindName    string
+	agentName   string
+	addToScheme func(*runtime.Scheme) error
+	resync      time.Duration
+	listWatch   *cache.ListWatch
+	patch       func(ctx context.Context, nameSpace, name string, pt types.PatchType, data []byte) error
+	reconciler  Reconciler[T]
+}
+
+// NewBuilder returns the Builder of T whose objects were listed and watched with client, such as
+//
+//	NewBuilder[redisOperatorV1.RedisOperator, *redisOperatorV1.RedisOperatorList](clientSet.NevercaseV1().RedisOperators)
+func NewBuilder[T any, L runtime.Object, PT Object[T], C Client[T, L]](client func(nameSpace string) C) *Builder[T, PT] {
+	return &Builder[T, PT]{
+		kindName: reflect.TypeOf((*T)(nil)).Elem().Name(),
+		resync:   time.Second * 30,
+		listWatch: &cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				return client(metav1.NamespaceAll).List(context.TODO(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				return client(metav1.NamespaceAll).Watch(context.TODO(), options)
+			},
+		},
+		patch: func(ctx context.Context, nameSpace, name string, pt types.PatchType, data []byte) error {
+			_, err := client(nameSpace).Patch(ctx, name, pt, data, metav1.PatchOptions{})
+			return err
+		},
+	}
+}
+
+// AgentName sets the name of the controller
+func (b *Builder[T, PT]) AgentName(agentName string) *Builder[T, PT] {
+	b.agentName = agentName
+	return b
+}
+
+// Scheme sets the AddToScheme of the generated clientset, which was required by the EventRecorder
+func (b *Builder[T, PT]) Scheme(addToScheme func(*runtime.Scheme) error) *Builder[T, PT] {
+	b.addToScheme = addToScheme
+	return b
+}
+
+// Resync sets the resync period of the informer, it defaults to 30 seconds
+func (b *Builder[T, PT]) Resync(resync time.Duration) *Builder[T, PT] {
+	b.resync = resync
+	return b
+}
+
+// Reconciler sets the Reconciler of T
+func (b *Builder[T, PT]) Reconciler(reconciler Reconciler[T]) *Builder[T, PT] {
+	b.reconciler = reconciler
+	return b
+}
+
+// Build starts the informer of T and returns the Option
+func (b *Builder[T, PT]) Build(stopCh <-chan struct{}) Option {
+	if b.addToScheme != nil {
+		utilruntime.Must(b.addToScheme(scheme.Scheme))
+	}
+	if b.reconciler == nil {
+		klog.Fatalf("%s: the Reconciler must be specified", b.kindName)
+	}
+	informer := cache.NewSharedIndexInformer(b.listWatch, PT(new(T)), b.resync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	go informer.Run(stopCh)
+	opt := &typedOption[T, PT]{
+		kindName:   b.kindName,
+		agentName:  b.agentName,
+		informer:   informer,
+		patch:      b.patch,
+		reconciler: b.reconciler,
+	}
+	// Only the Options of the ValidatingReconciler and the DefaultingReconciler were the ValidatingOption
+	// and the DefaultingOption, which the webhooks were registered for
+	vr, validating := b.reconciler.(ValidatingReconciler[T])
+	dr, defaulting := b.reconciler.(DefaultingReconciler[T])
+	switch {
+	case validating && defaulting:
+		return &admissionOption[T, PT]{validatingOption: &validatingOption[T, PT]{typedOption: opt, validator: vr}, defaulter: dr}
+	case validating:
+		return &validatingOption[T, PT]{typedOption: opt, validator: vr}
+	case defaulting:
+		return &defaultingOption[T, PT]{typedOption: opt, defaulter: dr}
+	}
+	return opt
+}
+
+// typedOption adapts a Reconciler to the Option
+type typedOption[T any, PT Object[T]] struct {
+	kindName   string
+	agentName  string
+	informer   cache.SharedIndexInformer
+	patch      func(ctx context.Context, nameSpace, name string, pt types.PatchType, data []byte) error
+	reconciler Reconciler[T]
+}
+
+func (opt *typedOption[T, PT]) GetReflectType() reflect.Type {
+	return reflect.TypeOf((*T)(nil))
+}
+
+func (opt *typedOption[T, PT]) KindName() string {
+	return opt.kindName
+}
+
+func (opt *typedOption[T, PT]) AgentName() string {
+	return opt.agentName
+}
+
+func (opt *typedOption[T, PT]) Informer() cache.SharedIndexInformer {
+	return opt.informer
+}
+
+func (opt *typedOption[T, PT]) SyncHandleObject(ctx context.Context, obj interface{}, ks KubernetesResource, recorder record.EventRecorder) (Result, error) {
+	foo, ok := obj.(PT)
+	if !ok {
+		return Result{}, fmt.Errorf("%s: unexpected object %T", opt.kindName, obj)
+	}
+	ctx = WithResource(ctx, ks.WithContext(ctx), recorder)
+	if foo.GetDeletionTimestamp() != nil {
+		return Result{}, opt.reconciler.Finalize(ctx, (*T)(foo))
+	}
+	return opt.reconciler.Reconcile(ctx, (*T)(foo))
+}
+
+// PatchObject patches obj with the typed client which T was listed and watched with
+func (opt *typedOption[T, PT]) PatchObject(ctx context.Context, obj interface{}, pt types.PatchType, data []byte) error {
+	foo, ok := obj.(PT)
+	if !ok {
+		return fmt.Errorf("%s: unexpected object %T", opt.kindName, obj)
+	}
+	return opt.patch(ctx, foo.GetNamespace(), foo.GetName(), pt, data)
+}
+
+// SyncPausedObject refreshes the paused state of obj if the Reconciler implements the PauseReconciler
+func (opt *typedOption[T, PT]) SyncPausedObject(ctx context.Context, obj interface{}, paused bool, ks KubernetesResource, recorder record.EventRecorder) error {
+	pr, ok := opt.reconciler.(PauseReconciler[T])
+	if !ok {
+		return nil
+	}
+	foo, ok := obj.(PT)
+	if !ok {
+		return fmt.Errorf("%s: unexpected object %T", opt.kindName, obj)
+	}
+	return pr.ReconcilePaused(WithResource(ctx, ks.WithContext(ctx), recorder), (*T)(foo), paused)
+}
+
+// Priority tells whether obj goes on the priority lane if the Reconciler implements the PriorityReconciler
+func (opt *typedOption[T, PT]) Priority(obj interface{}) bool {
+	pr, ok := opt.reconciler.(PriorityReconciler[T])
+	if !ok {
+		return false
+	}
+	foo, ok := obj.(PT)
+	if !ok {
+		return false
+	}
+	return pr.Priority((*T)(foo))
+}
+
+func (opt *typedOption[T, PT]) CompareResourceVersion(old, new interface{}) bool {
+	oldResource, ok := old.(PT)
+	if !ok {
+		return false
+	}
+	newResource, ok := new.(PT)
+	if !ok {
+		return false
+	}
+	// Periodic resync will send update events for all known objects.
+	// Two different versions of the same object will always have different RVs.
+	return oldResource.GetResourceVersion() == newResource.GetResourceVersion()
+}
+
+func (opt *typedOption[T, PT]) Get(nameSpace, ownerRefName string) (obj interface{}, err error) {
+	item, exists, err := opt.informer.GetIndexer().GetByKey(fmt.Sprintf("%s/%s", nameSpace, ownerRefName))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{Resource: strings.ToLower(opt.kindName)}, ownerRefName)
+	}
+	return item, nil
+}
+
+// SyncObjectStatus refreshes the status of the owner of obj if the Reconciler implements the StatusReconciler
+func (opt *typedOption[T, PT]) SyncObjectStatus(ctx context.Context, obj interface{}, ks KubernetesResource, recorder record.EventRecorder) error {
+	sr, ok := opt.reconciler.(StatusReconciler[T])
+	if !ok {
+		return nil
+	}
+	object, ok := obj.(metav1.Object)
+	if !ok {
+		return fmt.Errorf("%s: unexpected object %T", opt.kindName, obj)
+	}
+	ownerRef := metav1.GetControllerOf(object)
+	if ownerRef == nil || ownerRef.Kind != opt.kindName {
+		return nil
+	}
+	owner, err := opt.Get(object.GetNamespace(), ownerRef.Name)
+	if err != nil {
+		return err
+	}
+	return sr.ReconcileStatus(WithResource(ctx, ks.WithContext(ctx), recorder), (*T)(owner.(PT)), object)
+}
+
+// validatingOption adapts a ValidatingReconciler to the ValidatingOption
+type validatingOption[T any, PT Object[T]] struct {
+	*typedOption[T, PT]
+	validator ValidatingReconciler[T]
+}
+
+func (opt *validatingOption[T, PT]) New() runtime.Object {
+	return PT(new(T))
+}
+
+func (opt *validatingOption[T, PT]) ValidateCreate(obj runtime.Object) field.ErrorList {
+	foo, ok := obj.(PT)
+	if !ok {
+		return field.ErrorList{field.InternalError(nil, fmt.Errorf("%s: unexpected object %T", opt.kindName, obj))}
+	}
+	return opt.validator.ValidateCreate((*T)(foo), opt.others(foo))
+}
+
+func (opt *validatingOption[T, PT]) ValidateUpdate(old, obj runtime.Object) field.ErrorList {
+	oldFoo, ok := old.(PT)
+	if !ok {
+		return field.ErrorList{field.InternalError(nil, fmt.Errorf("%s: unexpected object %T", opt.kindName, old))}
+	}
+	foo, ok := obj.(PT)
+	if !ok {
+		return field.ErrorList{field.InternalError(nil, fmt.Errorf("%s: unexpected object %T", opt.kindName, obj))}
+	}
+	return opt.validator.ValidateUpdate((*T)(oldFoo), (*T)(foo), opt.others(foo))
+}
+
+// others lists the objects in the namespace of foo except foo from the informer
+func (opt *validatingOption[T, PT]) others(foo PT) []*T {
+	items, err := opt.informer.GetIndexer().ByIndex(cache.NamespaceIndex, foo.GetNamespace())
+	if err != nil {
+		klog.Warningf("%s: listing the namespace %s: %v", opt.kindName, foo.GetNamespace(), err)
+	}
+	res := make([]*T, 0, len(items))
+	for _, v := range items {
+		other, ok := v.(PT)
+		if !ok || other.GetName() == foo.GetName() {
+			continue
+		}
+		res = append(res, (*T)(other))
+	}
+	return res
+}
+
+// defaultingOption adapts a DefaultingReconciler to the DefaultingOption
+type defaultingOption[T any, PT Object[T]] struct {
+	*typedOption[T, PT]
+	defaulter DefaultingReconciler[T]
+}
+
+func (opt *defaultingOption[T, PT]) New() runtime.Object {
+	return PT(new(T))
+}
+
+func (opt *defaultingOption[T, PT]) Default(obj runtime.Object) error {
+	return defaultObject[T, PT](opt.kindName, opt.defaulter, obj)
+}
+
+// admissionOption is both the ValidatingOption and the DefaultingOption
+type admissionOption[T any, PT Object[T]] struct {
+	*validatingOption[T, PT]
+	defaulter DefaultingReconciler[T]
+}
+
+func (opt *admissionOption[T, PT]) Default(obj runtime.Object) error {
+	return defaultObject[T, PT](opt.kindName, opt.defaulter, obj)
+}
+
+func defaultObject[T any, PT Object[T]](kindName string, d DefaultingReconciler[T], obj runtime.Object) error {
+	foo, ok := obj.(PT)
+	if !ok {
+		return fmt.Errorf("%s: unexpected object %T", kindName, obj)
+	}
+	d.Default((*T)(foo))
+	return nil
+}
@@ -0,0 +1,199 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/env"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/remote"
+)
+
+const (
+	// SuccessSyncedRemote is used as part of the Event 'reason' when a Foo was synced by the remote reconciler
+	SuccessSyncedRemote = "Synced"
+	// MessageResourceSyncedRemote is the message used for an Event fired when a Foo was synced by the remote reconciler
+	MessageResourceSyncedRemote = "%s synced successfully by %s"
+)
+
+// RemoteConfig configures the RemoteOption of the custom resource of Resource, which was reconciled by the
+// reconciler at Endpoint. The children were of the kinds of Children, the other kinds were refused.
+type RemoteConfig struct {
+	Resource DynamicResource   `json:"resource"`
+	Children []DynamicResource `json:"children,omitempty"`
+	// Endpoint is the address of the gRPC server of the reconciler, such as memcached-reconciler.default.svc:9090
+	Endpoint string `json:"endpoint"`
+	// TimeoutSeconds bounds each call of the reconciler, it defaults to the execution timeout
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty"`
+	// TLS configures the transport credentials, the server was verified with the system roots if it was empty
+	TLS remote.TLSConfig `json:"tls,omitempty"`
+	// Insecure calls the reconciler without TLS, it was meant for the local tests
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// Validate returns the problem of c which the RemoteOption couldn't work with
+func (c RemoteConfig) Validate() error {
+	if err := validateResources(append([]DynamicResource{c.Resource}, c.Children...)...); err != nil {
+		return err
+	}
+	if c.Endpoint == "" {
+		return fmt.Errorf("the endpoint of %s must be specified", c.Resource.Kind)
+	}
+	if c.Insecure && !c.TLS.IsZero() {
+		return fmt.Errorf("the tls of %s couldn't be specified together with insecure", c.Resource.Kind)
+	}
+	return nil
+}
+
+// transportCredentials returns the credentials of the calls of c
+func (c RemoteConfig) transportCredentials() (credentials.TransportCredentials, error) {
+	if c.Insecure {
+		klog.Warningf("the reconciler of %s at %s was called without TLS", c.Resource.Kind, c.Endpoint)
+		return insecure.NewCredentials(), nil
+	}
+	return c.TLS.ClientCredentials()
+}
+
+// RemoteClient is the client of the remote reconciler, which was the remote.Client except in the tests
+type RemoteClient interface {
+	Reconcile(ctx context.Context, req *remote.ReconcileRequest) (*remote.ReconcileResponse, error)
+	ReconcileStatus(ctx context.Context, req *remote.ReconcileRequest) (*remote.ReconcileResponse, error)
+}
+
+// RemoteOption is the Option of a custom resource which was watched with the dynamic client and reconciled by
+// a reconciler in another process, so that the reconciler could be built and released on its own.
+// The reconciler gets the object and its children, and returns the desired children and status,
+// which were applied by the RemoteOption.
+type RemoteOption struct {
+	unstructuredOption
+	config RemoteConfig
+	remote RemoteClient
+}
+
+// NewRemoteOption returns the RemoteOption of config whose reconciler was called over gRPC with TLS unless
+// config was Insecure. The connection was closed once stopCh was closed.
+func NewRemoteOption(agentName string, config RemoteConfig, client dynamic.Interface, stopCh <-chan struct{}) (*RemoteOption, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	creds, err := config.transportCredentials()
+	if err != nil {
+		return nil, fmt.Errorf("the tls of %s: %v", config.Resource.Kind, err)
+	}
+	remoteClient, err := remote.NewClient(config.Endpoint, creds)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		<-stopCh
+		if err := remoteClient.Close(); err != nil {
+			klog.Errorf("closing the connection to the reconciler of %s: %v", config.Resource.Kind, err)
+		}
+	}()
+	return NewRemoteOptionWithClient(agentName, config, client, remoteClient, stopCh)
+}
+
+// NewRemoteOptionWithClient is the same as NewRemoteOption with the RemoteClient of the reconciler
+func NewRemoteOptionWithClient(agentName string, config RemoteConfig, client dynamic.Interface, remoteClient RemoteClient, stopCh <-chan struct{}) (*RemoteOption, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &RemoteOption{
+		unstructuredOption: newUnstructuredOption(agentName, config.Resource, config.Children, client, stopCh),
+		config:             config,
+		remote:             remoteClient,
+	}, nil
+}
+
+// SyncHandleObject sends obj with its children to the reconciler, and applies the desired children and status.
+// The object being deleted was left to the garbage collector together with its children.
+func (opt *RemoteOption) SyncHandleObject(ctx context.Context, obj interface{}, ks KubernetesResource, recorder record.EventRecorder) (Result, error) {
+	foo, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return Result{}, fmt.Errorf("%s: unexpected object %T", opt.KindName(), obj)
+	}
+	if foo.GetDeletionTimestamp() != nil {
+		return Result{}, nil
+	}
+	children, err := opt.listChildren(ctx, foo)
+	if err != nil {
+		return Result{}, err
+	}
+	callCtx, cancel := context.WithTimeout(ctx, opt.timeout())
+	res, err := opt.remote.Reconcile(callCtx, &remote.ReconcileRequest{Object: foo, Children: children})
+	cancel()
+	if err != nil {
+		return Result{}, fmt.Errorf("calling the reconciler of %s at %s: %v", opt.KindName(), opt.config.Endpoint, err)
+	}
+	opt.recordEvents(foo, res.Events, recorder)
+	if err = opt.applyChildren(ctx, foo, children, res.Children, recorder); err != nil {
+		return Result{}, err
+	}
+	if err = opt.updateStatus(ctx, foo, res.Status); err != nil {
+		return Result{}, err
+	}
+	recorder.Eventf(foo, corev1.EventTypeNormal, SuccessSyncedRemote, MessageResourceSyncedRemote, opt.KindName(), opt.config.Endpoint)
+	if res.RequeueAfterSeconds > 0 {
+		return RequeueAfter(time.Second * time.Duration(res.RequeueAfterSeconds)), nil
+	}
+	return Result{}, nil
+}
+
+// SyncObjectStatus sends the changed child, such as a Deployment, to the reconciler with its owner,
+// and applies the desired status of the owner
+func (opt *RemoteOption) SyncObjectStatus(ctx context.Context, obj interface{}, ks KubernetesResource, recorder record.EventRecorder) error {
+	object, ok := obj.(metav1.Object)
+	if !ok {
+		return fmt.Errorf("%s: unexpected object %T", opt.KindName(), obj)
+	}
+	ownerRef := metav1.GetControllerOf(object)
+	if ownerRef == nil || ownerRef.Kind != opt.KindName() {
+		return nil
+	}
+	owner, err := opt.Get(object.GetNamespace(), ownerRef.Name)
+	if err != nil {
+		return err
+	}
+	foo := owner.(*unstructured.Unstructured)
+	child, err := toUnstructured(obj.(runtime.Object))
+	if err != nil {
+		return err
+	}
+	callCtx, cancel := context.WithTimeout(ctx, opt.timeout())
+	res, err := opt.remote.ReconcileStatus(callCtx, &remote.ReconcileRequest{Object: foo, Child: child})
+	cancel()
+	if err != nil {
+		return fmt.Errorf("calling the reconciler of %s at %s: %v", opt.KindName(), opt.config.Endpoint, err)
+	}
+	opt.recordEvents(foo, res.Events, recorder)
+	return opt.updateStatus(ctx, foo, res.Status)
+}
+
+func (opt *RemoteOption) timeout() time.Duration {
+	if opt.config.TimeoutSeconds > 0 {
+		return time.Second * time.Duration(opt.config.TimeoutSeconds)
+	}
+	return time.Second * time.Duration(env.DefaultExecutionDuration)
+}
+
+// recordEvents records the Events of the reconciler on foo
+func (opt *RemoteOption) recordEvents(foo *unstructured.Unstructured, events []remote.Event, recorder record.EventRecorder) {
+	for _, e := range events {
+		eventType := corev1.EventTypeNormal
+		if strings.EqualFold(e.Type, corev1.EventTypeWarning) {
+			eventType = corev1.EventTypeWarning
+		}
+		recorder.Event(foo, eventType, e.Reason, e.Message)
+	}
+}
@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"context"
+
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 )
@@ -11,6 +13,14 @@ type KubernetesResource interface {
 	Service() KubernetesService
 	StatefulSet() KubernetesStatefulSet
 	ConfigMap() KubernetesConfigMap
+	Job() KubernetesJob
+	Secret() KubernetesSecret
+	Pod() KubernetesPod
+	// Context returns the context which the requests of the resources were bound to
+	Context() context.Context
+	// WithContext returns a copy of the KubernetesResource whose requests were bound to ctx,
+	// so that they were cancelled together with ctx
+	WithContext(ctx context.Context) KubernetesResource
 }
 
 type kubernetesResource struct {
@@ -21,6 +31,11 @@ type kubernetesResource struct {
 	service     KubernetesService
 	statefulSet KubernetesStatefulSet
 	configMap   KubernetesConfigMap
+	job         KubernetesJob
+	secret      KubernetesSecret
+	pod         KubernetesPod
+
+	ctx context.Context
 }
 
 func NewKubernetesResource(kubeClientSet kubernetes.Interface, kubeInformerFactory kubeinformers.SharedInformerFactory) KubernetesResource {
@@ -31,6 +46,10 @@ func NewKubernetesResource(kubeClientSet kubernetes.Interface, kubeInformerFacto
 		service:             NewKubernetesService(kubeClientSet, kubeInformerFactory),
 		statefulSet:         NewKubernetesStatefulSet(kubeClientSet, kubeInformerFactory),
 		configMap:           NewKubernetesConfigMap(kubeClientSet, kubeInformerFactory),
+		job:                 NewKubernetesJob(kubeClientSet, kubeInformerFactory),
+		secret:              NewKubernetesSecret(kubeClientSet, kubeInformerFactory),
+		pod:                 NewKubernetesPod(kubeInformerFactory),
+		ctx:                 context.Background(),
 	}
 	return kr
 }
@@ -54,3 +73,34 @@ func (kr *kubernetesResource) StatefulSet() KubernetesStatefulSet {
 func (kr *kubernetesResource) ConfigMap() KubernetesConfigMap {
 	return kr.configMap
 }
+
+func (kr *kubernetesResource) Job() KubernetesJob {
+	return kr.job
+}
+
+func (kr *kubernetesResource) Secret() KubernetesSecret {
+	return kr.secret
+}
+
+func (kr *kubernetesResource) Pod() KubernetesPod {
+	return kr.pod
+}
+
+func (kr *kubernetesResource) Context() context.Context {
+	return kr.ctx
+}
+
+func (kr *kubernetesResource) WithContext(ctx context.Context) KubernetesResource {
+	return &kubernetesResource{
+		kubeClientSet:       kr.kubeClientSet,
+		kubeInformerFactory: kr.kubeInformerFactory,
+		deployment:          kr.deployment.WithContext(ctx),
+		service:             kr.service.WithContext(ctx),
+		statefulSet:         kr.statefulSet.WithContext(ctx),
+		configMap:           kr.configMap.WithContext(ctx),
+		job:                 kr.job.WithContext(ctx),
+		secret:              kr.secret.WithContext(ctx),
+		pod:                 kr.pod,
+		ctx:                 ctx,
+	}
+}
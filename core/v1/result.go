@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+// RolloutRequeueAfter is how often the status was refreshed while a StatefulSet was being rolled out
+const RolloutRequeueAfter = time.Second * 10
+
+// Result tells the controller when the Foo should be synced again without waiting for an informer event,
+// e.g. while a rollout was in progress. The zero Result syncs the Foo again only on the next event.
+type Result struct {
+	// Requeue puts the Foo back on the workqueue with the rate limiter
+	Requeue bool
+	// RequeueAfter puts the Foo back on the workqueue after the duration, it takes precedence over Requeue
+	RequeueAfter time.Duration
+}
+
+// IsZero reports whether the Foo was not requeued by the Result
+func (r Result) IsZero() bool {
+	return !r.Requeue && r.RequeueAfter <= 0
+}
+
+// RequeueAfter returns the Result which syncs the Foo again after d
+func RequeueAfter(d time.Duration) Result {
+	return Result{RequeueAfter: d}
+}
+
+// Merge returns the Result which requeues the Foo as soon as either of r and o does
+func (r Result) Merge(o Result) Result {
+	res := Result{Requeue: r.Requeue || o.Requeue, RequeueAfter: r.RequeueAfter}
+	if o.RequeueAfter > 0 && (res.RequeueAfter <= 0 || o.RequeueAfter < res.RequeueAfter) {
+		res.RequeueAfter = o.RequeueAfter
+	}
+	return res
+}
+
+// StatefulSetRolledOut reports whether the latest spec of ss was observed and all of its replicas were updated and ready
+func StatefulSetRolledOut(ss *appsv1.StatefulSet) bool {
+	if ss.Status.ObservedGeneration < ss.Generation {
+		return false
+	}
+	replicas := int32(1)
+	if ss.Spec.Replicas != nil {
+		replicas = *ss.Spec.Replicas
+	}
+	if ss.Status.UpdateRevision != "" && ss.Status.CurrentRevision != ss.Status.UpdateRevision {
+		return false
+	}
+	return ss.Status.UpdatedReplicas >= replicas && ss.Status.ReadyReplicas >= replicas
+}
+
+// DeploymentRolledOut reports whether the latest spec of d was observed and all of its replicas were updated and available
+func DeploymentRolledOut(d *appsv1.Deployment) bool {
+	if d.Status.ObservedGeneration < d.Generation {
+		return false
+	}
+	replicas := int32(1)
+	if d.Spec.Replicas != nil {
+		replicas = *d.Spec.Replicas
+	}
+	return d.Status.UpdatedReplicas >= replicas && d.Status.AvailableReplicas >= replicas && d.Status.Replicas == d.Status.UpdatedReplicas
+}
@@ -0,0 +1,155 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/env"
+	"time"
+
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	kubeInformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	coreListersV1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+)
+
+type KubernetesSecret interface {
+	Get(nameSpace, specName string) (s *coreV1.Secret, err error)
+	Create(nameSpace string, s *coreV1.Secret) (*coreV1.Secret, error)
+	Update(nameSpace string, s *coreV1.Secret) (*coreV1.Secret, error)
+	Delete(nameSpace, specName string) error
+	List(nameSpace, filterName string) (sl *coreV1.SecretList, err error)
+	WithContext(ctx context.Context) KubernetesSecret
+}
+
+func NewKubernetesSecret(kubeClientSet kubernetes.Interface, kubeInformerFactory kubeInformers.SharedInformerFactory) KubernetesSecret {
+	timeout, _ := env.GetExecutionTimeoutDuration()
+	return &kubernetesSecret{
+		kubeClientSet:         kubeClientSet,
+		secretLister:          kubeInformerFactory.Core().V1().Secrets().Lister(),
+		executionTimeoutInSec: timeout,
+		ctx:                   context.Background(),
+	}
+}
+
+type kubernetesSecret struct {
+	kubeClientSet         kubernetes.Interface
+	secretLister          coreListersV1.SecretLister
+	executionTimeoutInSec int64
+	ctx                   context.Context
+}
+
+// WithContext returns a copy of the KubernetesSecret whose requests were bound to ctx
+func (ks *kubernetesSecret) WithContext(ctx context.Context) KubernetesSecret {
+	c := *ks
+	c.ctx = ctx
+	return &c
+}
+
+func (ks *kubernetesSecret) Get(nameSpace, specName string) (s *coreV1.Secret, err error) {
+	if specName == "" {
+		// We choose to absorb the error here as the worker would requeue the
+		// resource otherwise. Instead, the next time the resource is updated
+		// the resource will be queued again.
+		utilruntime.HandleError(fmt.Errorf("%s: name must be specified", specName))
+		return s, fmt.Errorf("%s: name must be specified", specName)
+	}
+	// Get the secret with the name specified in spec
+	secret, err := ks.secretLister.Secrets(nameSpace).Get(fmt.Sprintf(SecretNameTemplate, specName))
+	return secret, err
+}
+
+func (ks *kubernetesSecret) Create(nameSpace string, s *coreV1.Secret) (*coreV1.Secret, error) {
+	createOpt := metav1.CreateOptions{DryRun: DryRunOptions()}
+	ctx, cancel := context.WithTimeout(ks.ctx, time.Second*time.Duration(ks.executionTimeoutInSec))
+	secret, err := ks.kubeClientSet.CoreV1().Secrets(nameSpace).Create(ctx, s, createOpt)
+	cancel()
+	if err != nil {
+		klog.V(2).Info(err)
+	} else {
+		logDryRun("create", "Secret", nameSpace, s.Name, nil, secret)
+	}
+	return secret, err
+}
+
+func (ks *kubernetesSecret) Update(nameSpace string, s *coreV1.Secret) (*coreV1.Secret, error) {
+	updateOpt := metav1.UpdateOptions{DryRun: DryRunOptions()}
+	ctx, cancel := context.WithTimeout(ks.ctx, time.Second*time.Duration(ks.executionTimeoutInSec))
+	secret, err := ks.kubeClientSet.CoreV1().Secrets(nameSpace).Update(ctx, s, updateOpt)
+	cancel()
+	if err != nil {
+		klog.V(2).Info(err)
+	} else {
+		logDryRun("update", "Secret", nameSpace, s.Name, ks.live(nameSpace, s.Name), secret)
+	}
+	return secret, err
+}
+
+func (ks *kubernetesSecret) Delete(nameSpace, specName string) error {
+	// Get the secret with the name specified in spec
+	_, err := ks.Get(nameSpace, specName)
+	// If the resource doesn't exist, we'll return nil
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	opts := metav1.DeleteOptions{DryRun: DryRunOptions()}
+	ctx, cancel := context.WithTimeout(ks.ctx, time.Second*time.Duration(ks.executionTimeoutInSec))
+	err = ks.kubeClientSet.CoreV1().Secrets(nameSpace).Delete(ctx, fmt.Sprintf(SecretNameTemplate, specName), opts)
+	cancel()
+	if err != nil {
+		klog.V(2).Info(err)
+		return err
+	}
+	logDryRun("delete", "Secret", nameSpace, fmt.Sprintf(SecretNameTemplate, specName), nil, nil)
+	return nil
+}
+
+func (ks *kubernetesSecret) List(nameSpace, filterName string) (sl *coreV1.SecretList, err error) {
+	opts := metav1.ListOptions{
+		LabelSelector: filterName,
+	}
+	ctx, cancel := context.WithTimeout(ks.ctx, time.Second*time.Duration(ks.executionTimeoutInSec))
+	sl, err = ks.kubeClientSet.CoreV1().Secrets(nameSpace).List(ctx, opts)
+	cancel()
+	if err != nil {
+		klog.V(2).Info(err)
+	}
+	return sl, err
+}
+
+// ApplySecret creates the Secret, or updates the data and the labels of the existing one
+func ApplySecret(s KubernetesSecret, d *coreV1.Secret) error {
+	current, err := s.Get(d.Namespace, d.Name)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		_, err = s.Create(d.Namespace, d)
+		return err
+	}
+	if equality.Semantic.DeepEqual(current.Data, d.Data) && equality.Semantic.DeepEqual(current.Labels, d.Labels) {
+		return nil
+	}
+	secret := current.DeepCopy()
+	secret.Labels = d.Labels
+	secret.Data = d.Data
+	_, err = s.Update(d.Namespace, secret)
+	return err
+}
+
+// live returns the Secret in the store, or nil if it doesn't exist
+func (ks *kubernetesSecret) live(nameSpace, name string) runtime.Object {
+	if !IsDryRun() {
+		return nil
+	}
+	obj, err := ks.secretLister.Secrets(nameSpace).Get(name)
+	if err != nil {
+		return nil
+	}
+	return obj
+}
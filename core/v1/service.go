@@ -7,8 +7,10 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
@@ -22,6 +24,7 @@ type KubernetesService interface {
 	Update(nameSpace string, d *corev1.Service) (*corev1.Service, error)
 	Delete(nameSpace, specName string) error
 	List(nameSpace, filterName string) (sl *corev1.ServiceList, err error)
+	WithContext(ctx context.Context) KubernetesService
 }
 
 func NewKubernetesService(kubeClientSet kubernetes.Interface, kubeInformerFactory kubeinformers.SharedInformerFactory) KubernetesService {
@@ -30,6 +33,7 @@ func NewKubernetesService(kubeClientSet kubernetes.Interface, kubeInformerFactor
 		kubeClientSet:         kubeClientSet,
 		servicesLister:        kubeInformerFactory.Core().V1().Services().Lister(),
 		executionTimeoutInSec: timeout,
+		ctx:                   context.Background(),
 	}
 }
 
@@ -37,6 +41,14 @@ type kubernetesService struct {
 	kubeClientSet         kubernetes.Interface
 	servicesLister        corelistersv1.ServiceLister
 	executionTimeoutInSec int64
+	ctx                   context.Context
+}
+
+// WithContext returns a copy of the KubernetesService whose requests were bound to ctx
+func (ks *kubernetesService) WithContext(ctx context.Context) KubernetesService {
+	c := *ks
+	c.ctx = ctx
+	return &c
 }
 
 func (ks *kubernetesService) Get(nameSpace, specName string) (d *corev1.Service, err error) {
@@ -55,23 +67,27 @@ func (ks *kubernetesService) Get(nameSpace, specName string) (d *corev1.Service,
 }
 
 func (ks *kubernetesService) Create(nameSpace string, d *corev1.Service) (*corev1.Service, error) {
-	createOpt := metav1.CreateOptions{}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(ks.executionTimeoutInSec))
+	createOpt := metav1.CreateOptions{DryRun: DryRunOptions()}
+	ctx, cancel := context.WithTimeout(ks.ctx, time.Second*time.Duration(ks.executionTimeoutInSec))
 	service, err := ks.kubeClientSet.CoreV1().Services(nameSpace).Create(ctx, d, createOpt)
 	cancel()
 	if err != nil {
 		klog.V(2).Info(err)
+	} else {
+		logDryRun("create", "Service", nameSpace, d.Name, nil, service)
 	}
 	return service, err
 }
 
 func (ks *kubernetesService) Update(nameSpace string, d *corev1.Service) (*corev1.Service, error) {
-	updateOpt := metav1.UpdateOptions{}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(ks.executionTimeoutInSec))
+	updateOpt := metav1.UpdateOptions{DryRun: DryRunOptions()}
+	ctx, cancel := context.WithTimeout(ks.ctx, time.Second*time.Duration(ks.executionTimeoutInSec))
 	service, err := ks.kubeClientSet.CoreV1().Services(nameSpace).Update(ctx, d, updateOpt)
 	cancel()
 	if err != nil {
 		klog.V(2).Info(err)
+	} else {
+		logDryRun("update", "Service", nameSpace, d.Name, ks.live(nameSpace, d.Name), service)
 	}
 	return service, err
 }
@@ -84,9 +100,10 @@ func (ks *kubernetesService) Delete(nameSpace, specName string) error {
 		return nil
 	}
 	opts := metav1.DeleteOptions{
+		DryRun: DryRunOptions(),
 		//GracePeriodSeconds: int64ToPointer(30),
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(ks.executionTimeoutInSec))
+	ctx, cancel := context.WithTimeout(ks.ctx, time.Second*time.Duration(ks.executionTimeoutInSec))
 	name := fmt.Sprintf(ServiceNameTemplate, specName)
 	err = ks.kubeClientSet.CoreV1().Services(nameSpace).Delete(ctx, name, opts)
 	cancel()
@@ -94,6 +111,7 @@ func (ks *kubernetesService) Delete(nameSpace, specName string) error {
 		klog.V(2).Info(err)
 		return err
 	}
+	logDryRun("delete", "Service", nameSpace, name, nil, nil)
 	return nil
 }
 
@@ -101,7 +119,7 @@ func (ks *kubernetesService) List(nameSpace, filterName string) (sl *corev1.Serv
 	opts := metav1.ListOptions{
 		LabelSelector: filterName,
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(ks.executionTimeoutInSec))
+	ctx, cancel := context.WithTimeout(ks.ctx, time.Second*time.Duration(ks.executionTimeoutInSec))
 	sl, err = ks.kubeClientSet.CoreV1().Services(nameSpace).List(ctx, opts)
 	cancel()
 	if err != nil {
@@ -116,3 +134,51 @@ func GetServiceType(st corev1.ServiceType) corev1.ServiceType {
 	}
 	return st
 }
+
+// ApplyService creates the Service, or updates the selector, the ports and the labels of the existing one
+func ApplyService(s KubernetesService, d *corev1.Service) error {
+	current, err := s.Get(d.Namespace, d.Name)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		_, err = s.Create(d.Namespace, d)
+		return err
+	}
+	if equality.Semantic.DeepEqual(current.Spec.Selector, d.Spec.Selector) &&
+		equality.Semantic.DeepEqual(current.Labels, d.Labels) &&
+		samePorts(current.Spec.Ports, d.Spec.Ports) {
+		return nil
+	}
+	svc := current.DeepCopy()
+	svc.Labels = d.Labels
+	svc.Spec.Selector = d.Spec.Selector
+	svc.Spec.Ports = d.Spec.Ports
+	_, err = s.Update(d.Namespace, svc)
+	return err
+}
+
+// samePorts compares the ports by the numbers, since the other fields were defaulted by the apiserver
+func samePorts(current, desired []corev1.ServicePort) bool {
+	if len(current) != len(desired) {
+		return false
+	}
+	for i := range current {
+		if current[i].Port != desired[i].Port || current[i].Name != desired[i].Name {
+			return false
+		}
+	}
+	return true
+}
+
+// live returns the Service in the store, or nil if it doesn't exist
+func (ks *kubernetesService) live(nameSpace, name string) runtime.Object {
+	if !IsDryRun() {
+		return nil
+	}
+	obj, err := ks.servicesLister.Services(nameSpace).Get(name)
+	if err != nil {
+		return nil
+	}
+	return obj
+}
@@ -9,6 +9,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/watch"
@@ -26,6 +27,7 @@ type KubernetesStatefulSet interface {
 	List(nameSpace, filterName string) (dl *appsv1.StatefulSetList, err error)
 	Watch(nameSpace string, filter string) (w watch.Interface, err error)
 	Patch(nameSpace string, name string, pt types.PatchType, data []byte, subResources ...string) (*appsv1.StatefulSet, error)
+	WithContext(ctx context.Context) KubernetesStatefulSet
 }
 
 func NewKubernetesStatefulSet(kubeClientSet kubernetes.Interface, kubeInformerFactory kubeinformers.SharedInformerFactory) KubernetesStatefulSet {
@@ -34,6 +36,7 @@ func NewKubernetesStatefulSet(kubeClientSet kubernetes.Interface, kubeInformerFa
 		kubeClientSet:         kubeClientSet,
 		statefulSetLister:     kubeInformerFactory.Apps().V1().StatefulSets().Lister(),
 		executionTimeoutInSec: timeout,
+		ctx:                   context.Background(),
 	}
 }
 
@@ -41,6 +44,14 @@ type kubernetesStatefulSet struct {
 	kubeClientSet         kubernetes.Interface
 	statefulSetLister     appslistersv1.StatefulSetLister
 	executionTimeoutInSec int64
+	ctx                   context.Context
+}
+
+// WithContext returns a copy of the KubernetesStatefulSet whose requests were bound to ctx
+func (sts *kubernetesStatefulSet) WithContext(ctx context.Context) KubernetesStatefulSet {
+	c := *sts
+	c.ctx = ctx
+	return &c
 }
 
 func (sts *kubernetesStatefulSet) Get(nameSpace, specName string) (ss *appsv1.StatefulSet, err error) {
@@ -59,23 +70,27 @@ func (sts *kubernetesStatefulSet) Get(nameSpace, specName string) (ss *appsv1.St
 }
 
 func (sts *kubernetesStatefulSet) Create(nameSpace string, ss *appsv1.StatefulSet) (*appsv1.StatefulSet, error) {
-	createOpt := metav1.CreateOptions{}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(sts.executionTimeoutInSec))
+	createOpt := metav1.CreateOptions{DryRun: DryRunOptions()}
+	ctx, cancel := context.WithTimeout(sts.ctx, time.Second*time.Duration(sts.executionTimeoutInSec))
 	statefulSet, err := sts.kubeClientSet.AppsV1().StatefulSets(nameSpace).Create(ctx, ss, createOpt)
 	cancel()
 	if err != nil {
 		klog.V(2).Info(err)
+	} else {
+		logDryRun("create", "StatefulSet", nameSpace, ss.Name, nil, statefulSet)
 	}
 	return statefulSet, err
 }
 
 func (sts *kubernetesStatefulSet) Update(nameSpace string, ss *appsv1.StatefulSet) (*appsv1.StatefulSet, error) {
-	opt := metav1.UpdateOptions{}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(sts.executionTimeoutInSec))
+	opt := metav1.UpdateOptions{DryRun: DryRunOptions()}
+	ctx, cancel := context.WithTimeout(sts.ctx, time.Second*time.Duration(sts.executionTimeoutInSec))
 	statefulSet, err := sts.kubeClientSet.AppsV1().StatefulSets(nameSpace).Update(ctx, ss, opt)
 	cancel()
 	if err != nil {
 		klog.V(2).Info(err)
+	} else {
+		logDryRun("update", "StatefulSet", nameSpace, ss.Name, sts.live(nameSpace, ss.Name), statefulSet)
 	}
 	return statefulSet, err
 }
@@ -88,9 +103,10 @@ func (sts *kubernetesStatefulSet) Delete(nameSpace, specName string) error {
 		return nil
 	}
 	opts := metav1.DeleteOptions{
+		DryRun: DryRunOptions(),
 		//GracePeriodSeconds: int64ToPointer(30),
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(sts.executionTimeoutInSec))
+	ctx, cancel := context.WithTimeout(sts.ctx, time.Second*time.Duration(sts.executionTimeoutInSec))
 	name := fmt.Sprintf(StatefulSetNameTemplate, specName)
 	err = sts.kubeClientSet.AppsV1().StatefulSets(nameSpace).Delete(ctx, name, opts)
 	cancel()
@@ -98,6 +114,7 @@ func (sts *kubernetesStatefulSet) Delete(nameSpace, specName string) error {
 		klog.V(2).Info(err)
 		return err
 	}
+	logDryRun("delete", "StatefulSet", nameSpace, name, nil, nil)
 	return nil
 }
 
@@ -107,7 +124,7 @@ func (sts *kubernetesStatefulSet) List(nameSpace, filterName string) (ssl *appsv
 		LabelSelector:  filterName,
 		TimeoutSeconds: &timeout,
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(sts.executionTimeoutInSec))
+	ctx, cancel := context.WithTimeout(sts.ctx, time.Second*time.Duration(sts.executionTimeoutInSec))
 	ssl, err = sts.kubeClientSet.AppsV1().StatefulSets(nameSpace).List(ctx, opts)
 	cancel()
 	if err != nil {
@@ -122,7 +139,7 @@ func (sts *kubernetesStatefulSet) Watch(nameSpace string, filterName string) (w
 		LabelSelector:  filterName,
 		TimeoutSeconds: &timeout,
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(sts.executionTimeoutInSec))
+	ctx, cancel := context.WithTimeout(sts.ctx, time.Second*time.Duration(sts.executionTimeoutInSec))
 	w, err = sts.kubeClientSet.AppsV1().StatefulSets(nameSpace).Watch(ctx, opts)
 	cancel()
 	if err != nil {
@@ -132,12 +149,26 @@ func (sts *kubernetesStatefulSet) Watch(nameSpace string, filterName string) (w
 }
 
 func (sts *kubernetesStatefulSet) Patch(nameSpace string, name string, pt types.PatchType, data []byte, subResources ...string) (*appsv1.StatefulSet, error) {
-	opt := metav1.PatchOptions{}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(sts.executionTimeoutInSec))
+	opt := metav1.PatchOptions{DryRun: DryRunOptions()}
+	ctx, cancel := context.WithTimeout(sts.ctx, time.Second*time.Duration(sts.executionTimeoutInSec))
 	s, err := sts.kubeClientSet.AppsV1().StatefulSets(nameSpace).Patch(ctx, name, pt, data, opt, subResources...)
 	cancel()
 	if err != nil {
 		klog.V(2).Info(err)
+	} else {
+		logDryRun("patch", "StatefulSet", nameSpace, name, sts.live(nameSpace, name), s)
 	}
 	return s, err
 }
+
+// live returns the StatefulSet in the store, or nil if it doesn't exist
+func (sts *kubernetesStatefulSet) live(nameSpace, name string) runtime.Object {
+	if !IsDryRun() {
+		return nil
+	}
+	obj, err := sts.statefulSetLister.StatefulSets(nameSpace).Get(name)
+	if err != nil {
+		return nil
+	}
+	return obj
+}
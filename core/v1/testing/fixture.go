@@ -0,0 +1,302 @@
+// Package testing wires the fake clientsets into the operator and the controller of core/v1,
+// so that the Options could be reconciled once at a time in the unit tests.
+package testing
+
+import (
+	"context"
+	"sort"
+	stdtesting "testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+)
+
+// AgentName is the name of the controller of the Fixture
+const AgentName = "test-controller"
+
+// cacheSyncTimeout bounds how long a Fixture waits for its informers to observe the fake clientsets
+const cacheSyncTimeout = time.Second * 5
+
+// Fixture holds the fake kube clientset, the Recorder, the operator and the controller of a test.
+// The Options were built with the fake clientsets of the custom resources and StopCh by the test,
+// and then passed to Start.
+type Fixture struct {
+	t stdtesting.TB
+
+	KubeClientSet *fake.Clientset
+	Recorder      *Recorder
+	Operator      k8sCoreV1.KubernetesOperator
+	Controller    k8sCoreV1.KubernetesControllerV1
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	stopCh chan struct{}
+}
+
+// NewFixture returns the Fixture whose kube clientset was seeded with objects,
+// it was stopped once the test finished.
+func NewFixture(t stdtesting.TB, objects ...runtime.Object) *Fixture {
+	t.Helper()
+	f := &Fixture{
+		t:             t,
+		KubeClientSet: fake.NewSimpleClientset(objects...),
+		Recorder:      NewRecorder(),
+		stopCh:        make(chan struct{}),
+	}
+	f.ctx, f.cancel = context.WithCancel(context.Background())
+	t.Cleanup(func() {
+		f.cancel()
+		close(f.stopCh)
+	})
+	return f
+}
+
+// StopCh is closed once the test finished, the Options should be built with it
+func (f *Fixture) StopCh() <-chan struct{} {
+	return f.stopCh
+}
+
+// Context is cancelled once the test finished
+func (f *Fixture) Context() context.Context {
+	return f.ctx
+}
+
+// Start builds the operator and the controller with opts, and waits for their informers to be synced
+func (f *Fixture) Start(opts ...k8sCoreV1.Option) {
+	f.t.Helper()
+	options := k8sCoreV1.NewOptions()
+	if err := options.Add(opts...); err != nil {
+		f.t.Fatalf("adding options: %v", err)
+	}
+	f.Operator = k8sCoreV1.NewKubernetesOperator(f.KubeClientSet, f.stopCh, AgentName, options, k8sCoreV1.WithRecorder(f.Recorder))
+	f.Controller = k8sCoreV1.NewKubernetesController(f.Operator)
+	// The informers which were registered by the controller were started here, as Run does
+	f.Operator.InformerFactory().Start(f.stopCh)
+	for t, v := range f.Operator.InformerFactory().WaitForCacheSync(f.stopCh) {
+		if !v {
+			f.t.Fatalf("failed to sync the informer of %v", t)
+		}
+	}
+	for _, opt := range opts {
+		if !cache.WaitForCacheSync(f.stopCh, opt.Informer().HasSynced) {
+			f.t.Fatalf("failed to sync the informer of %s", opt.KindName())
+		}
+	}
+}
+
+// Resource returns the KubernetesResource of the operator which was bound to the Context
+func (f *Fixture) Resource() k8sCoreV1.KubernetesResource {
+	return f.Operator.Resource().WithContext(f.ctx)
+}
+
+// Reconcile syncs obj once through the SyncHandler of the controller, as if it was taken off the work queue.
+// It waits for the informers to observe obj and the children beforehand, since the sync reads the listers.
+func (f *Fixture) Reconcile(obj runtime.Object) (k8sCoreV1.Result, error) {
+	f.t.Helper()
+	f.WaitForObject(obj)
+	f.WaitForCache()
+	t, err := k8sCoreV1.NewTask(obj)
+	if err != nil {
+		f.t.Fatalf("the task of %T: %v", obj, err)
+	}
+	return f.Controller.SyncHandler(f.ctx, t)
+}
+
+// WaitForObject waits for the informer of the Option of obj to hold the same obj
+func (f *Fixture) WaitForObject(obj runtime.Object) {
+	f.t.Helper()
+	object, ok := obj.(metav1.Object)
+	if !ok {
+		f.t.Fatalf("unexpected object %T", obj)
+	}
+	opt := f.Operator.Options().Get(k8sCoreV1.ObjectType(obj))
+	if opt == nil {
+		f.t.Fatalf("no option of %T", obj)
+	}
+	err := wait.PollImmediate(time.Millisecond*10, cacheSyncTimeout, func() (bool, error) {
+		cached, err := opt.Get(object.GetNamespace(), object.GetName())
+		if err != nil {
+			return false, nil
+		}
+		return equality.Semantic.DeepEqual(cached, obj), nil
+	})
+	if err != nil {
+		f.t.Fatalf("waiting for %s %s/%s in the informer: %v", opt.KindName(), object.GetNamespace(), object.GetName(), err)
+	}
+}
+
+// WaitForCache waits for the listers of the StatefulSets, the Deployments, the Services, the Secrets,
+// the ConfigMaps and the Pods to hold what the kube clientset holds.
+func (f *Fixture) WaitForCache() {
+	f.t.Helper()
+	factory := f.Operator.InformerFactory()
+	opts := metav1.ListOptions{}
+	stores := []struct {
+		resource string
+		list     func() (runtime.Object, error)
+		store    cache.Store
+	}{
+		{"statefulsets", func() (runtime.Object, error) {
+			return f.KubeClientSet.AppsV1().StatefulSets(metav1.NamespaceAll).List(f.ctx, opts)
+		}, factory.Apps().V1().StatefulSets().Informer().GetStore()},
+		{"deployments", func() (runtime.Object, error) {
+			return f.KubeClientSet.AppsV1().Deployments(metav1.NamespaceAll).List(f.ctx, opts)
+		}, factory.Apps().V1().Deployments().Informer().GetStore()},
+		{"services", func() (runtime.Object, error) {
+			return f.KubeClientSet.CoreV1().Services(metav1.NamespaceAll).List(f.ctx, opts)
+		}, factory.Core().V1().Services().Informer().GetStore()},
+		{"secrets", func() (runtime.Object, error) {
+			return f.KubeClientSet.CoreV1().Secrets(metav1.NamespaceAll).List(f.ctx, opts)
+		}, factory.Core().V1().Secrets().Informer().GetStore()},
+		{"configmaps", func() (runtime.Object, error) {
+			return f.KubeClientSet.CoreV1().ConfigMaps(metav1.NamespaceAll).List(f.ctx, opts)
+		}, factory.Core().V1().ConfigMaps().Informer().GetStore()},
+		{"pods", func() (runtime.Object, error) {
+			return f.KubeClientSet.CoreV1().Pods(metav1.NamespaceAll).List(f.ctx, opts)
+		}, factory.Core().V1().Pods().Informer().GetStore()},
+	}
+	for _, s := range stores {
+		err := wait.PollImmediate(time.Millisecond*10, cacheSyncTimeout, func() (bool, error) {
+			l, err := s.list()
+			if err != nil {
+				return false, err
+			}
+			objects, err := meta.ExtractList(l)
+			if err != nil {
+				return false, err
+			}
+			if len(objects) != len(s.store.ListKeys()) {
+				return false, nil
+			}
+			for _, o := range objects {
+				cached, exists, err := s.store.Get(o)
+				if err != nil || !exists || !equality.Semantic.DeepEqual(cached, o) {
+					return false, nil
+				}
+			}
+			return true, nil
+		})
+		if err != nil {
+			f.t.Fatalf("waiting for the %s in the informer: %v", s.resource, err)
+		}
+	}
+}
+
+// StatefulSet returns the StatefulSet of the kube clientset, it fails the test if it was not found
+func (f *Fixture) StatefulSet(namespace, name string) *appsv1.StatefulSet {
+	f.t.Helper()
+	ss, err := f.KubeClientSet.AppsV1().StatefulSets(namespace).Get(f.ctx, name, metav1.GetOptions{})
+	if err != nil {
+		f.t.Fatalf("getting StatefulSet %s/%s: %v", namespace, name, err)
+	}
+	return ss
+}
+
+// Deployment returns the Deployment of the kube clientset, it fails the test if it was not found
+func (f *Fixture) Deployment(namespace, name string) *appsv1.Deployment {
+	f.t.Helper()
+	d, err := f.KubeClientSet.AppsV1().Deployments(namespace).Get(f.ctx, name, metav1.GetOptions{})
+	if err != nil {
+		f.t.Fatalf("getting Deployment %s/%s: %v", namespace, name, err)
+	}
+	return d
+}
+
+// Service returns the Service of the kube clientset, it fails the test if it was not found
+func (f *Fixture) Service(namespace, name string) *corev1.Service {
+	f.t.Helper()
+	svc, err := f.KubeClientSet.CoreV1().Services(namespace).Get(f.ctx, name, metav1.GetOptions{})
+	if err != nil {
+		f.t.Fatalf("getting Service %s/%s: %v", namespace, name, err)
+	}
+	return svc
+}
+
+// Secret returns the Secret of the kube clientset, it fails the test if it was not found
+func (f *Fixture) Secret(namespace, name string) *corev1.Secret {
+	f.t.Helper()
+	s, err := f.KubeClientSet.CoreV1().Secrets(namespace).Get(f.ctx, name, metav1.GetOptions{})
+	if err != nil {
+		f.t.Fatalf("getting Secret %s/%s: %v", namespace, name, err)
+	}
+	return s
+}
+
+// StatefulSetNames returns the sorted names of the StatefulSets in namespace
+func (f *Fixture) StatefulSetNames(namespace string) []string {
+	f.t.Helper()
+	l, err := f.KubeClientSet.AppsV1().StatefulSets(namespace).List(f.ctx, metav1.ListOptions{})
+	if err != nil {
+		f.t.Fatalf("listing StatefulSets: %v", err)
+	}
+	res := make([]string, 0)
+	for _, v := range l.Items {
+		res = append(res, v.Name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// ServiceNames returns the sorted names of the Services in namespace
+func (f *Fixture) ServiceNames(namespace string) []string {
+	f.t.Helper()
+	l, err := f.KubeClientSet.CoreV1().Services(namespace).List(f.ctx, metav1.ListOptions{})
+	if err != nil {
+		f.t.Fatalf("listing Services: %v", err)
+	}
+	res := make([]string, 0)
+	for _, v := range l.Items {
+		res = append(res, v.Name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// ConfigMapNames returns the sorted names of the ConfigMaps in namespace
+func (f *Fixture) ConfigMapNames(namespace string) []string {
+	f.t.Helper()
+	l, err := f.KubeClientSet.CoreV1().ConfigMaps(namespace).List(f.ctx, metav1.ListOptions{})
+	if err != nil {
+		f.t.Fatalf("listing ConfigMaps: %v", err)
+	}
+	res := make([]string, 0)
+	for _, v := range l.Items {
+		res = append(res, v.Name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// SetStatefulSetStatus overwrites the status of the StatefulSet in the kube clientset, e.g. to finish a rollout
+func (f *Fixture) SetStatefulSetStatus(namespace, name string, status appsv1.StatefulSetStatus) *appsv1.StatefulSet {
+	f.t.Helper()
+	ss := f.StatefulSet(namespace, name).DeepCopy()
+	ss.Status = status
+	ss, err := f.KubeClientSet.AppsV1().StatefulSets(namespace).UpdateStatus(f.ctx, ss, metav1.UpdateOptions{})
+	if err != nil {
+		f.t.Fatalf("updating the status of StatefulSet %s/%s: %v", namespace, name, err)
+	}
+	return ss
+}
+
+// SetDeploymentStatus is the same as SetStatefulSetStatus for the Deployment
+func (f *Fixture) SetDeploymentStatus(namespace, name string, status appsv1.DeploymentStatus) *appsv1.Deployment {
+	f.t.Helper()
+	d := f.Deployment(namespace, name).DeepCopy()
+	d.Status = status
+	d, err := f.KubeClientSet.AppsV1().Deployments(namespace).UpdateStatus(f.ctx, d, metav1.UpdateOptions{})
+	if err != nil {
+		f.t.Fatalf("updating the status of Deployment %s/%s: %v", namespace, name, err)
+	}
+	return d
+}
@@ -0,0 +1,61 @@
+package testing
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+)
+
+// Namespace is the namespace of the Foos and the children of the tests
+const Namespace = metav1.NamespaceDefault
+
+// Int32Ptr returns the pointer of i, e.g. for the replicas of a spec
+func Int32Ptr(i int32) *int32 { return &i }
+
+// ObjectMeta returns the ObjectMeta of the Foo of name in Namespace, the UID was derived from name
+// so that the ownerReferences of the children were stable across the tests
+func ObjectMeta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: Namespace,
+		UID:       types.UID(name + "-uid"),
+	}
+}
+
+// SyncCase is the part of a table driven test of Sync which every Option shares,
+// the tables embed it and add the expectations of their children.
+type SyncCase struct {
+	Name string
+	// Objects were seeded into the kube clientset, e.g. the children which existed before the sync
+	Objects []runtime.Object
+	// WantErr expects the sync to fail, the children were usually not checked then
+	WantErr bool
+	// WantResult was compared with the Result of the sync unless it was nil
+	WantResult *k8sCoreV1.Result
+	// WantReasons were the reasons of the events which were recorded in order
+	WantReasons []string
+}
+
+// ExpectSync reconciles obj once and checks it against c, it fails the test if the error was unexpected.
+// It returns true if the sync succeeded, so that the test could go on checking the children.
+func (f *Fixture) ExpectSync(obj runtime.Object, c SyncCase) bool {
+	f.t.Helper()
+	result, err := f.Reconcile(obj)
+	if (err != nil) != c.WantErr {
+		f.t.Fatalf("Sync() error = %v, wantErr %v", err, c.WantErr)
+	}
+	if c.WantResult != nil && result != *c.WantResult {
+		f.t.Errorf("Sync() result = %v, want %v", result, *c.WantResult)
+	}
+	if got := f.Recorder.Reasons(); !reflect.DeepEqual(got, c.WantReasons) && len(got)+len(c.WantReasons) > 0 {
+		f.t.Errorf("event reasons = %v, want %v", got, c.WantReasons)
+	}
+	return err == nil
+}
+
+// Result returns the pointer of r for SyncCase.WantResult
+func Result(r k8sCoreV1.Result) *k8sCoreV1.Result { return &r }
@@ -0,0 +1,68 @@
+package testing
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Event is an event which was recorded by the Recorder
+type Event struct {
+	Type    string
+	Reason  string
+	Message string
+}
+
+func (e Event) String() string {
+	return fmt.Sprintf("%s %s %s", e.Type, e.Reason, e.Message)
+}
+
+// Recorder is the EventRecorder which keeps the events in memory, unlike record.FakeRecorder
+// it never blocks once a sync records more events than expected.
+type Recorder struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+// NewRecorder returns the empty Recorder
+func NewRecorder() *Recorder {
+	return &Recorder{events: make([]Event, 0)}
+}
+
+func (r *Recorder) Event(object runtime.Object, eventtype, reason, message string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, Event{Type: eventtype, Reason: reason, Message: message})
+}
+
+func (r *Recorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *Recorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Eventf(object, eventtype, reason, messageFmt, args...)
+}
+
+// Events returns a copy of the events which were recorded so far
+func (r *Recorder) Events() []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Event(nil), r.events...)
+}
+
+// Reasons returns the reasons of the events in order
+func (r *Recorder) Reasons() []string {
+	res := make([]string, 0)
+	for _, e := range r.Events() {
+		res = append(res, e.Reason)
+	}
+	return res
+}
+
+// Reset drops the events which were recorded so far
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = r.events[:0]
+}
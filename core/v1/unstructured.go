@@ -0,0 +1,388 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/env"
+)
+
+// unstructuredTypes holds the reflect.Type of each GroupVersionKind which was served by the unstructured objects
+var unstructuredTypes sync.Map
+
+// UnstructuredType returns the reflect.Type which stands for the unstructured objects of gvk, since the Options
+// were keyed by the reflect.Type and all of the unstructured objects share *unstructured.Unstructured.
+// The type was never instantiated, it only tells the kinds apart.
+func UnstructuredType(gvk schema.GroupVersionKind) reflect.Type {
+	if t, ok := unstructuredTypes.Load(gvk); ok {
+		return t.(reflect.Type)
+	}
+	t := reflect.PtrTo(reflect.StructOf([]reflect.StructField{{
+		Name: "Object",
+		Type: reflect.TypeOf(unstructured.Unstructured{}),
+		Tag:  reflect.StructTag(fmt.Sprintf("gvk:%q", gvk.String())),
+	}}))
+	actual, _ := unstructuredTypes.LoadOrStore(gvk, t)
+	return actual.(reflect.Type)
+}
+
+// ObjectType returns the reflect.Type of the Option of obj, which was the UnstructuredType of the unstructured object
+func ObjectType(obj interface{}) reflect.Type {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return UnstructuredType(u.GroupVersionKind())
+	}
+	return reflect.TypeOf(obj)
+}
+
+// DynamicResource is a namespaced resource which was served by the dynamic client, such as
+//
+//	{group: example.com, version: v1, kind: Memcached, resource: memcacheds}
+type DynamicResource struct {
+	Group    string `json:"group"`
+	Version  string `json:"version"`
+	Kind     string `json:"kind"`
+	Resource string `json:"resource"`
+}
+
+// GroupVersionKind returns the GroupVersionKind of the objects of r
+func (r DynamicResource) GroupVersionKind() schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: r.Group, Version: r.Version, Kind: r.Kind}
+}
+
+// GroupVersionResource returns the GroupVersionResource which r was served by
+func (r DynamicResource) GroupVersionResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: r.Group, Version: r.Version, Resource: r.Resource}
+}
+
+// validateResources returns the problem of the resource which the dynamic client couldn't be used with
+func validateResources(resources ...DynamicResource) error {
+	for _, r := range resources {
+		if r.Version == "" || r.Kind == "" || r.Resource == "" {
+			return fmt.Errorf("the version, the kind and the resource of %+v must be specified", r)
+		}
+	}
+	return nil
+}
+
+// unstructuredOption is the common part of the Options of the custom resources which were watched with the dynamic
+// client, whose children were of the kinds of children. The children were listed by the labels and the controller.
+type unstructuredOption struct {
+	resource  DynamicResource
+	children  []DynamicResource
+	agentName string
+	client    dynamic.Interface
+	informer  cache.SharedIndexInformer
+}
+
+// newUnstructuredOption returns the unstructuredOption of resource and runs its informer until stopCh was closed
+func newUnstructuredOption(agentName string, resource DynamicResource, children []DynamicResource, client dynamic.Interface, stopCh <-chan struct{}) unstructuredOption {
+	opt := unstructuredOption{
+		resource:  resource,
+		children:  children,
+		agentName: agentName,
+		client:    client,
+		informer:  NewUnstructuredInformer(client, resource.GroupVersionResource(), resource.GroupVersionKind(), time.Second*30),
+	}
+	go opt.informer.Run(stopCh)
+	return opt
+}
+
+// NewUnstructuredInformer returns the informer of the objects of gvr in all of the namespaces.
+// The objects without the apiVersion and the kind were given gvk, which the UnstructuredType relies on.
+func NewUnstructuredInformer(client dynamic.Interface, gvr schema.GroupVersionResource, gvk schema.GroupVersionKind, resync time.Duration) cache.SharedIndexInformer {
+	resource := client.Resource(gvr)
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			list, err := resource.Namespace(metav1.NamespaceAll).List(context.TODO(), options)
+			if err != nil {
+				return nil, err
+			}
+			for i := range list.Items {
+				if list.Items[i].GetKind() == "" {
+					list.Items[i].SetGroupVersionKind(gvk)
+				}
+			}
+			return list, nil
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			w, err := resource.Namespace(metav1.NamespaceAll).Watch(context.TODO(), options)
+			if err != nil {
+				return nil, err
+			}
+			return watch.Filter(w, func(e watch.Event) (watch.Event, bool) {
+				if u, ok := e.Object.(*unstructured.Unstructured); ok && u.GetKind() == "" {
+					u.SetGroupVersionKind(gvk)
+				}
+				return e, true
+			}), nil
+		},
+	}
+	return cache.NewSharedIndexInformer(lw, &unstructured.Unstructured{}, resync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+}
+
+func (opt *unstructuredOption) GetReflectType() reflect.Type {
+	return UnstructuredType(opt.resource.GroupVersionKind())
+}
+
+func (opt *unstructuredOption) KindName() string {
+	return opt.resource.Kind
+}
+
+func (opt *unstructuredOption) AgentName() string {
+	return opt.agentName
+}
+
+func (opt *unstructuredOption) Informer() cache.SharedIndexInformer {
+	return opt.informer
+}
+
+func (opt *unstructuredOption) CompareResourceVersion(old, new interface{}) bool {
+	oldResource, ok := old.(*unstructured.Unstructured)
+	if !ok {
+		return false
+	}
+	newResource, ok := new.(*unstructured.Unstructured)
+	if !ok {
+		return false
+	}
+	return oldResource.GetResourceVersion() == newResource.GetResourceVersion()
+}
+
+func (opt *unstructuredOption) Get(nameSpace, ownerRefName string) (obj interface{}, err error) {
+	item, exists, err := opt.informer.GetIndexer().GetByKey(fmt.Sprintf("%s/%s", nameSpace, ownerRefName))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(opt.resource.GroupVersionResource().GroupResource(), ownerRefName)
+	}
+	return item, nil
+}
+
+// PatchObject patches obj with the dynamic client by the resource of the Option
+func (opt *unstructuredOption) PatchObject(ctx context.Context, obj interface{}, pt types.PatchType, data []byte) error {
+	foo, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("%s: unexpected object %T", opt.KindName(), obj)
+	}
+	_, err := opt.client.Resource(opt.resource.GroupVersionResource()).Namespace(foo.GetNamespace()).Patch(ctx, foo.GetName(), pt, data, metav1.PatchOptions{})
+	return err
+}
+
+// childLabels returns the labels of the children of foo, which the children were listed by
+func (opt *unstructuredOption) childLabels(foo *unstructured.Unstructured) map[string]string {
+	return map[string]string{
+		LabelApp:        opt.KindName(),
+		LabelController: foo.GetName(),
+	}
+}
+
+// listChildren lists the children of the configured kinds which were controlled by foo
+func (opt *unstructuredOption) listChildren(ctx context.Context, foo *unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	selector := labels.SelectorFromSet(opt.childLabels(foo)).String()
+	res := make([]*unstructured.Unstructured, 0)
+	for _, c := range opt.children {
+		list, err := opt.client.Resource(c.GroupVersionResource()).Namespace(foo.GetNamespace()).List(ctx, metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			child := &list.Items[i]
+			if !metav1.IsControlledBy(child, foo) {
+				continue
+			}
+			if child.GetKind() == "" {
+				child.SetGroupVersionKind(c.GroupVersionKind())
+			}
+			res = append(res, child)
+		}
+	}
+	return res, nil
+}
+
+// applyChildren creates or patches the desired children, and deletes the current ones which were not desired.
+// The desired children were put in the namespace of foo, labelled and controlled by it. The desired fields were
+// merged into the live children, so that the fields which were set by the apiserver or by the others were kept,
+// and the fields which were no longer desired were left on the children.
+func (opt *unstructuredOption) applyChildren(ctx context.Context, foo *unstructured.Unstructured, current, desired []*unstructured.Unstructured, recorder record.EventRecorder) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	gvk := opt.resource.GroupVersionKind()
+	wanted := make(map[string]bool, len(desired))
+	for _, d := range desired {
+		r, ok := opt.childResource(d.GroupVersionKind())
+		if !ok {
+			return fmt.Errorf("%s: the kind %s of the child %s was not configured", opt.KindName(), d.GroupVersionKind(), d.GetName())
+		}
+		d = d.DeepCopy()
+		d.SetNamespace(foo.GetNamespace())
+		childLabels := d.GetLabels()
+		if childLabels == nil {
+			childLabels = make(map[string]string)
+		}
+		for k, v := range opt.childLabels(foo) {
+			childLabels[k] = v
+		}
+		d.SetLabels(childLabels)
+		d.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(foo, gvk)})
+		wanted[childKey(d)] = true
+
+		resource := opt.client.Resource(r.GroupVersionResource()).Namespace(foo.GetNamespace())
+		live, err := resource.Get(ctx, d.GetName(), metav1.GetOptions{})
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				return err
+			}
+			if _, err = resource.Create(ctx, d, metav1.CreateOptions{DryRun: DryRunOptions()}); err != nil {
+				return err
+			}
+			logDryRun("create", r.Kind, d.GetNamespace(), d.GetName(), nil, d)
+			continue
+		}
+		// The orphan was adopted by the update below if foo was annotated with AdoptAnnotation
+		adopted, err := claim(foo, gvk, r.Kind, live)
+		if err != nil {
+			return err
+		}
+		if !adopted && containsFields(live.Object, d.Object) {
+			continue
+		}
+		// The patch was refused if the child was modified since it was read
+		d.SetResourceVersion(live.GetResourceVersion())
+		patch, err := d.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		if _, err = resource.Patch(ctx, d.GetName(), types.MergePatchType, patch, metav1.PatchOptions{DryRun: DryRunOptions(), FieldManager: opt.agentName}); err != nil {
+			return err
+		}
+		logDryRun("patch", r.Kind, d.GetNamespace(), d.GetName(), live, d)
+		if adopted {
+			recordAdoption(recorder, foo, r.Kind, d.GetName())
+		}
+	}
+	for _, c := range current {
+		if wanted[childKey(c)] {
+			continue
+		}
+		r, ok := opt.childResource(c.GroupVersionKind())
+		if !ok {
+			continue
+		}
+		err := opt.client.Resource(r.GroupVersionResource()).Namespace(c.GetNamespace()).Delete(ctx, c.GetName(), metav1.DeleteOptions{DryRun: DryRunOptions()})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		logDryRun("delete", r.Kind, c.GetNamespace(), c.GetName(), nil, nil)
+		recordCollection(recorder, foo, r.Kind, c.GetName())
+	}
+	return nil
+}
+
+// childResource returns the configured child of gvk
+func (opt *unstructuredOption) childResource(gvk schema.GroupVersionKind) (DynamicResource, bool) {
+	for _, c := range opt.children {
+		if c.GroupVersionKind() == gvk {
+			return c, true
+		}
+	}
+	return DynamicResource{}, false
+}
+
+// updateStatus replaces the status of foo with status through the status subresource,
+// nothing was updated if status was nil or unchanged
+func (opt *unstructuredOption) updateStatus(ctx context.Context, foo *unstructured.Unstructured, status map[string]interface{}) error {
+	if status == nil || SuppressedByDryRun(opt.KindName(), foo) {
+		return nil
+	}
+	current, _, _ := unstructured.NestedMap(foo.Object, "status")
+	if equality.Semantic.DeepEqual(current, status) {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	fooCopy := foo.DeepCopy()
+	if err := unstructured.SetNestedMap(fooCopy.Object, status, "status"); err != nil {
+		return err
+	}
+	_, err := opt.client.Resource(opt.resource.GroupVersionResource()).Namespace(foo.GetNamespace()).UpdateStatus(ctx, fooCopy, metav1.UpdateOptions{})
+	return err
+}
+
+// childKey identifies the child by its kind and name
+func childKey(u *unstructured.Unstructured) string {
+	return u.GroupVersionKind().String() + "/" + u.GetName()
+}
+
+// toUnstructured converts the typed obj, such as a Deployment from the informers, into the unstructured one
+func toUnstructured(obj runtime.Object) (*unstructured.Unstructured, error) {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return u, nil
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	u := &unstructured.Unstructured{Object: content}
+	// The objects of the informers have no apiVersion and kind
+	if u.GetKind() == "" {
+		if gvks, _, err := scheme.Scheme.ObjectKinds(obj); err == nil && len(gvks) > 0 {
+			u.SetGroupVersionKind(gvks[0])
+		}
+	}
+	return u, nil
+}
+
+// containsFields reports whether live holds every field of desired with the same value, so that the fields
+// which were defaulted by the apiserver didn't make the child being updated again and again
+func containsFields(live, desired interface{}) bool {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range d {
+			// The metadata which was set by the apiserver was skipped
+			if k == "resourceVersion" || k == "creationTimestamp" {
+				continue
+			}
+			if !containsFields(l[k], v) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(d) {
+			return false
+		}
+		for i := range d {
+			if !containsFields(l[i], d[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return equality.Semantic.DeepEqual(live, desired)
+	}
+}
@@ -0,0 +1,152 @@
+#!/usr/bin/env bash
+
+# The archive layout:
+#   ${MYSQL_BACKUP_ARCHIVE_DIR}/binlog/<server_uuid>/binlog.000001  the raw binlogs shipped from each master, the masters
+#   which were promoted by the failovers were kept apart since their binlogs were named and numbered on their own
+#   ${MYSQL_BACKUP_ARCHIVE_DIR}/full/<unix-timestamp>/dump.sql.gz  the full dumps, the binlog
+#   coordinates of each dump were written into it by `--master-data=2`
+#   ${MYSQL_BACKUP_ARCHIVE_DIR}/full/<unix-timestamp>/server_uuid  the master which the dump was taken from
+# The binlogs and the dumps of the former releases were kept in binlog/ and full/ without the server_uuid.
+
+binlogDir="${MYSQL_BACKUP_ARCHIVE_DIR}/binlog"
+fullDir="${MYSQL_BACKUP_ARCHIVE_DIR}/full"
+mkdir -p ${binlogDir} ${fullDir}
+
+# The host was the read-write Service, which follows the master once a slave was promoted
+mysqlAuth="-h${MYSQL_HOST} -P${MYSQL_PORT} -u${MYSQL_USER} -p${MYSQL_PASSWORD}"
+
+until mysql ${mysqlAuth} -e "SELECT 1"; do sleep 1; done
+
+serverUUID() {
+    mysql ${mysqlAuth} -N -e "SELECT @@server_uuid"
+}
+
+# sourceDir prints the directory of the binlogs which the full dump of $1 replays from
+sourceDir() {
+    if [[ -f ${fullDir}/$1/server_uuid ]]
+    then
+        echo "${binlogDir}/`cat ${fullDir}/$1/server_uuid`"
+    else
+        echo "${binlogDir}"
+    fi
+}
+
+# shipBinlogs streams the binlogs of the master into the archive, it restarts from the
+# last archived file (which would be overwritten) whenever the connection was broken.
+# It starts over from the first binlog of the master if the last archived one was not found there,
+# e.g. the read-write Service was switched to another master, or the binlog was purged meanwhile.
+shipBinlogs() {
+    while true
+    do
+        uuid=`serverUUID`
+        if [[ "$uuid" == "" ]]
+        then
+            sleep 5
+            continue
+        fi
+        dir=${binlogDir}/${uuid}
+        mkdir -p ${dir}
+        binlogs=`mysql ${mysqlAuth} -N -e "SHOW BINARY LOGS;" | awk '{print $1}'`
+        first=`ls ${dir} | sort | tail -n 1`
+        if [[ "$first" == "" ]] || ! echo "${binlogs}" | grep -qxF "${first}"
+        then
+            if [[ "$first" != "" ]]
+            then
+                echo "the binlog ${first} of ${uuid} was not found on the master, some binlogs may be missing from the archive"
+            fi
+            first=`echo "${binlogs}" | head -n 1`
+        fi
+        echo "ship binlogs of ${uuid} from ${first}"
+        cd ${dir} && mysqlbinlog ${mysqlAuth} --read-from-remote-server --raw --stop-never ${first}
+        sleep 5
+    done
+}
+
+# cleanup keeps the latest ${MYSQL_BACKUP_RETENTION_COUNT} full dumps, and the binlogs of each master after its oldest dump.
+# The binlogs of a former master were removed once none of its dumps was kept.
+cleanup() {
+    ls ${fullDir} | grep -E "^[0-9]+$" | sort -n | head -n -${MYSQL_BACKUP_RETENTION_COUNT} | while read d
+    do
+        echo "remove the full dump ${d}"
+        rm -rf ${fullDir}/${d}
+    done
+    current=`serverUUID`
+    for dir in ${binlogDir} ${binlogDir}/*/
+    do
+        dir=${dir%/}
+        if [[ ! -d "$dir" ]]
+        then
+            continue
+        fi
+        oldest=""
+        for d in `ls ${fullDir} | grep -E "^[0-9]+$" | sort -n`
+        do
+            if [[ "`sourceDir ${d}`" == "$dir" ]]
+            then
+                oldest=${d}
+                break
+            fi
+        done
+        if [[ "$oldest" == "" ]]
+        then
+            if [[ "$dir" != "$binlogDir" && "$dir" != "${binlogDir}/${current}" ]]
+            then
+                echo "remove the binlogs of ${dir}"
+                rm -rf ${dir}
+            fi
+            continue
+        fi
+        oldestBinlog=`zcat ${fullDir}/${oldest}/dump.sql.gz | head -n 50 | grep "CHANGE MASTER TO" | sed -E "s/.*MASTER_LOG_FILE='([^']+)'.*/\1/"`
+        if [[ "$oldestBinlog" == "" ]]
+        then
+            continue
+        fi
+        ls -p ${dir} | grep -v / | sort | while read f
+        do
+            if [[ "$f" < "$oldestBinlog" ]]
+            then
+                echo "remove the binlog ${dir}/${f}"
+                rm -f ${dir}/${f}
+            fi
+        done
+    done
+}
+
+fullDump() {
+    while true
+    do
+        now=`date +%s`
+        last=`ls ${fullDir} | grep -E "^[0-9]+$" | sort -n | tail -n 1`
+        uuid=`serverUUID`
+        # The binlogs of a promoted master couldn't be replayed on the dumps of the former one, it was dumped at once
+        if [[ "$last" == "" ]] || (( now - last >= MYSQL_BACKUP_FULL_DUMP_INTERVAL )) || [[ "`cat ${fullDir}/${last}/server_uuid 2>/dev/null`" != "$uuid" ]]
+        then
+            echo "take a full dump at ${now}"
+            mkdir -p ${fullDir}/${now}.tmp
+            # --flush-logs rotates the binlog so that the dump starts at the beginning of a file
+            if mysqldump ${mysqlAuth} --all-databases --single-transaction --flush-logs --master-data=2 \
+                --routines --events --triggers | gzip > ${fullDir}/${now}.tmp/dump.sql.gz
+            then
+                # The dump was dropped if the read-write Service was switched to another master meanwhile
+                if [[ "$uuid" != "" && "`serverUUID`" == "$uuid" ]]
+                then
+                    echo "${uuid}" > ${fullDir}/${now}.tmp/server_uuid
+                    mv ${fullDir}/${now}.tmp ${fullDir}/${now}
+                    cleanup
+                else
+                    echo "the master was switched during the full dump at ${now}"
+                    rm -rf ${fullDir}/${now}.tmp
+                fi
+            else
+                echo "the full dump at ${now} was failed"
+                rm -rf ${fullDir}/${now}.tmp
+            fi
+        fi
+        sleep 60
+    done
+}
+
+shipBinlogs &
+fullDump &
+
+wait
@@ -0,0 +1,114 @@
+#!/usr/bin/env bash
+set -e
+
+binlogDir="${MYSQL_BACKUP_ARCHIVE_DIR}/binlog"
+fullDir="${MYSQL_BACKUP_ARCHIVE_DIR}/full"
+
+mysqlAuth="-h${MYSQL_HOST} -P${MYSQL_PORT} -u${MYSQL_USER} -p${MYSQL_PASSWORD}"
+# The restored rows mustn't be written into the binlog of the master again, otherwise the next point-in-time
+# recovery would replay them twice and the slaves would apply them on top of their old rows.
+# The slaves were reseeded by the same dump and binlogs instead.
+noBinlog="--init-command=SET SESSION sql_log_bin=0"
+
+if [[ -z "$MYSQL_RESTORE_TO_TIME" ]]
+then
+    echo "error: no env MYSQL_RESTORE_TO_TIME"
+    exit 1
+fi
+target=`date -u -d "${MYSQL_RESTORE_TO_TIME}" +%s`
+stopDatetime=`date -u -d "@${target}" "+%F %T"`
+
+# pick the latest full dump which was taken before the target
+base=""
+for d in `ls ${fullDir} | grep -E "^[0-9]+$" | sort -n`
+do
+    if (( d <= target ))
+    then
+        base=${d}
+    fi
+done
+if [[ "$base" == "" ]]
+then
+    echo "error: there is no full dump taken before ${MYSQL_RESTORE_TO_TIME}"
+    exit 1
+fi
+dump="${fullDir}/${base}/dump.sql.gz"
+
+coordinates=`zcat ${dump} | head -n 50 | grep "CHANGE MASTER TO"`
+startFile=`echo ${coordinates} | sed -E "s/.*MASTER_LOG_FILE='([^']+)'.*/\1/"`
+startPos=`echo ${coordinates} | sed -E "s/.*MASTER_LOG_POS=([0-9]+).*/\1/"`
+echo "restore the full dump ${base} and replay the binlogs from ${startFile}:${startPos} to ${stopDatetime}"
+
+until mysql ${mysqlAuth} -e "SELECT 1"; do sleep 1; done
+
+# The master was fenced before anything was restored, so that the clients couldn't write in between the restored rows.
+# The restore connects as root which bypasses read_only, super_read_only would block it as well.
+# The master stays read only if the restore failed halfway, since its data is neither the old one nor the target.
+mysql ${mysqlAuth} -e "SET GLOBAL read_only=ON;"
+echo "fenced the writes of the master"
+
+# With GTID the dump and the binlogs carry the GTIDs which the master has executed already, they would be skipped
+# (or rejected by gtid_purged) on replay. They were stripped, so the statements run as anonymous ones without binlog.
+gtidMode=`mysql ${mysqlAuth} -N -e "SELECT @@global.gtid_mode"`
+skipGtids=""
+if [[ "$gtidMode" == "ON" ]]
+then
+    skipGtids="--skip-gtids"
+fi
+
+# The binlogs were the ones of the master which the dump was taken from, the dumps of the former releases
+# were replayed with the binlogs at the top of the archive
+sourceDir=${binlogDir}
+if [[ -f ${fullDir}/${base}/server_uuid ]]
+then
+    sourceDir="${binlogDir}/`cat ${fullDir}/${base}/server_uuid`"
+fi
+binlogs=""
+for f in `ls -p ${sourceDir} | grep -v / | sort`
+do
+    if [[ ! "$f" < "$startFile" ]]
+    then
+        binlogs="${binlogs} ${sourceDir}/${f}"
+    fi
+done
+
+# restoreInto loads the full dump and replays the binlogs into the server of the auth without logging them
+restoreInto() {
+    zcat ${dump} | sed -E '/^SET @@GLOBAL.GTID_PURGED/d' | mysql "$@" "${noBinlog}"
+    if [[ "$binlogs" == "" ]]
+    then
+        echo "there is no binlog after ${startFile}, the full dump was restored only"
+        return
+    fi
+    # --start-position only applies to the first binlog file
+    mysqlbinlog ${skipGtids} --start-position=${startPos} --stop-datetime="${stopDatetime}" ${binlogs} | mysql "$@" "${noBinlog}"
+}
+
+restoreInto ${mysqlAuth}
+echo "restored the master to ${MYSQL_RESTORE_TO_TIME}"
+
+# Nothing of the restore was written into the binlog of the master, so the slaves start replicating from its
+# current coordinates once they were restored to the same point. The coordinates were read while the master was fenced,
+# the writes after them reach the slaves through the replication. The connection settings of the slaves were kept.
+masterFile=`mysql ${mysqlAuth} -N -e "SHOW MASTER STATUS" | awk '{print $1}'`
+masterPos=`mysql ${mysqlAuth} -N -e "SHOW MASTER STATUS" | awk '{print $2}'`
+masterGtids=`mysql ${mysqlAuth} -N -e "SELECT REPLACE(@@global.gtid_executed, '\\n', '')"`
+mysql ${mysqlAuth} -e "SET GLOBAL read_only=OFF;"
+echo "released the writes of the master"
+for slave in ${MYSQL_SLAVE_HOSTS}
+do
+    slaveAuth="-h${slave%:*} -P${slave##*:} -u${MYSQL_USER} -p${MYSQL_PASSWORD}"
+    until mysql ${slaveAuth} -e "SELECT 1"; do sleep 1; done
+    mysql ${slaveAuth} -e "STOP SLAVE; SET GLOBAL super_read_only=OFF;"
+    restoreInto ${slaveAuth}
+    if [[ "$gtidMode" == "ON" ]]
+    then
+        # The slave has executed what the master had once it was restored, the auto position fetches the rest
+        echo "reseed the slave ${slave} from ${masterGtids}"
+        mysql ${slaveAuth} -e "RESET MASTER; SET GLOBAL gtid_purged='${masterGtids}'; CHANGE MASTER TO MASTER_AUTO_POSITION=1; SET GLOBAL super_read_only=ON; START SLAVE;"
+    else
+        echo "reseed the slave ${slave} from ${masterFile}:${masterPos}"
+        mysql ${slaveAuth} -e "CHANGE MASTER TO MASTER_AUTO_POSITION=0, MASTER_LOG_FILE='${masterFile}', MASTER_LOG_POS=${masterPos}; SET GLOBAL super_read_only=ON; START SLAVE;"
+    fi
+done
+echo "restored to ${MYSQL_RESTORE_TO_TIME}"
@@ -42,6 +42,12 @@ else
     echo -e "relay-log = mysql-bin" >> ${defaultConf}
     echo -e "\n"
     echo -e "relay-log-index  = 1" >> ${defaultConf}
+    # The slaves write their own binlogs, so that the others could replicate from the one promoted by the failover.
+    # It was named apart from the relay logs.
+    echo -e "\n"
+    echo -e "log-bin = binlog" >> ${defaultConf}
+    echo -e "\n"
+    echo -e "log-slave-updates = 1" >> ${defaultConf}
 fi
 
 echo -e "\n"
@@ -58,33 +64,37 @@ echo -e "default-character-set = utf8 " >> ${defaultConf}
 echo -e "\n"
 
 shutdownSave() {
-   mysqladmin  -uroot -proot shutdown
+   mysqladmin  -uroot -p"${MYSQL_ROOT_PASSWORD}" shutdown
 }
 
 trap "echo 'get the signal,mysqld would shut down and take some actions before releasing container'; shutdownSave" SIGHUP SIGINT SIGQUIT SIGTERM
 
 docker-entrypoint.sh mysqld &
 
-until mysql -uroot -proot -h 127.0.0.1 -e "SELECT 1"; do sleep 1; done
+until mysql -uroot -p"${MYSQL_ROOT_PASSWORD}" -h 127.0.0.1 -e "SELECT 1"; do sleep 1; done
 
 
 # set utf-8
-mysql -uroot -proot -e "SET NAMES utf8;"
+mysql -uroot -p"${MYSQL_ROOT_PASSWORD}" -e "SET NAMES utf8;"
 
-mysql -uroot -proot -e "show databases;"
+mysql -uroot -p"${MYSQL_ROOT_PASSWORD}" -e "show databases;"
+# The credentials of the replication account were passed from the Secret by the operator
+replUser=${MYSQL_MASTER_USER:-repl}
+replPassword=${MYSQL_MASTER_PASSWORD:-root}
 if [[ "$mysqlServerId" == "1" ]]
 then
     echo "**********master************"
-#            mysql -uroot -proot -e "CREATE USER 'repl'@'%.example.com' IDENTIFIED BY 'password';"
-#            mysql -uroot -proot -e "GRANT REPLICATION SLAVE ON *.* TO 'repl'@'%.example.com';"
-    mysql -uroot -proot -e "CREATE USER IF NOT EXISTS 'repl' IDENTIFIED BY 'root';"
-#    mysql -uroot -proot -e "CREATE USER IF NOT EXISTS 'repl'@'${MYSQL_MASTER_HOST}:${MYSQL_MASTER_PORT}' IDENTIFIED BY 'root';"
-    mysql -uroot -proot -e "GRANT REPLICATION SLAVE ON *.* TO 'repl';"
+#            mysql -uroot -p"${MYSQL_ROOT_PASSWORD}" -e "CREATE USER 'repl'@'%.example.com' IDENTIFIED BY 'password';"
+#            mysql -uroot -p"${MYSQL_ROOT_PASSWORD}" -e "GRANT REPLICATION SLAVE ON *.* TO 'repl'@'%.example.com';"
+    mysql -uroot -p"${MYSQL_ROOT_PASSWORD}" -e "CREATE USER IF NOT EXISTS '${replUser}' IDENTIFIED BY '${replPassword}';"
+#    mysql -uroot -p"${MYSQL_ROOT_PASSWORD}" -e "CREATE USER IF NOT EXISTS 'repl'@'${MYSQL_MASTER_HOST}:${MYSQL_MASTER_PORT}' IDENTIFIED BY 'root';"
+    mysql -uroot -p"${MYSQL_ROOT_PASSWORD}" -e "ALTER USER '${replUser}' IDENTIFIED BY '${replPassword}';"
+    mysql -uroot -p"${MYSQL_ROOT_PASSWORD}" -e "GRANT REPLICATION SLAVE ON *.* TO '${replUser}';"
 else
     echo "**********salve************"
-    export MASTER_LOG_FILE=`mysql -uroot -proot -e "show slave status\G" | grep Master_Log_File | grep -v Relay | awk '{split($0,a,"\:"); print a[2]}' | xargs`
+    export MASTER_LOG_FILE=`mysql -uroot -p"${MYSQL_ROOT_PASSWORD}" -e "show slave status\G" | grep Master_Log_File | grep -v Relay | awk '{split($0,a,"\:"); print a[2]}' | xargs`
     echo ${MASTER_LOG_FILE}
-    export MASTER_LOG_POS=`mysql -uroot -proot -e "show slave status\G" | grep Read_Master_Log_Pos | awk '{split($0,a,"\:"); print a[2]}'`
+    export MASTER_LOG_POS=`mysql -uroot -p"${MYSQL_ROOT_PASSWORD}" -e "show slave status\G" | grep Read_Master_Log_Pos | awk '{split($0,a,"\:"); print a[2]}'`
     if [ "$MASTER_LOG_POS" = "" ]
     then
       echo "MASTER_LOG_POS is not set!, we set 0"
@@ -93,11 +103,11 @@ else
       echo "MASTER_LOG_POS is set !"
       echo ${MASTER_LOG_POS}
     fi
-    mysql -uroot -proot -e "set global read_only=1;"
-    mysql -uroot -proot -e "set global super_read_only=on;"
-    mysql -uroot -proot -e "STOP SLAVE IO_THREAD FOR CHANNEL '';"
-    mysql -uroot -proot -e "CHANGE MASTER TO MASTER_HOST='${MYSQL_MASTER_HOST}', MASTER_PORT=${MYSQL_MASTER_PORT}, MASTER_USER='repl', MASTER_PASSWORD='root', MASTER_CONNECT_RETRY=10, MASTER_LOG_FILE='${MASTER_LOG_FILE}', MASTER_LOG_POS=${MASTER_LOG_POS};"
-    mysql -uroot -proot -e "START SLAVE;"
+    mysql -uroot -p"${MYSQL_ROOT_PASSWORD}" -e "set global read_only=1;"
+    mysql -uroot -p"${MYSQL_ROOT_PASSWORD}" -e "set global super_read_only=on;"
+    mysql -uroot -p"${MYSQL_ROOT_PASSWORD}" -e "STOP SLAVE IO_THREAD FOR CHANNEL '';"
+    mysql -uroot -p"${MYSQL_ROOT_PASSWORD}" -e "CHANGE MASTER TO MASTER_HOST='${MYSQL_MASTER_HOST}', MASTER_PORT=${MYSQL_MASTER_PORT}, MASTER_USER='${replUser}', MASTER_PASSWORD='${replPassword}', MASTER_CONNECT_RETRY=10, MASTER_LOG_FILE='${MASTER_LOG_FILE}', MASTER_LOG_POS=${MASTER_LOG_POS};"
+    mysql -uroot -p"${MYSQL_ROOT_PASSWORD}" -e "START SLAVE;"
 fi
 
 wait
\ No newline at end of file
@@ -25,8 +25,10 @@ import (
 	io "io"
 
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
-	v11 "k8s.io/api/core/v1"
+	v1 "k8s.io/api/core/v1"
+	v11 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	math "math"
 	math_bits "math/bits"
@@ -43,12 +45,68 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func (m *MysqlBackupSpec) Reset()      { *m = MysqlBackupSpec{} }
+func (*MysqlBackupSpec) ProtoMessage() {}
+func (*MysqlBackupSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331b1c7c7a035a4b, []int{0}
+}
+func (m *MysqlBackupSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MysqlBackupSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MysqlBackupSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MysqlBackupSpec.Merge(m, src)
+}
+func (m *MysqlBackupSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MysqlBackupSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MysqlBackupSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MysqlBackupSpec proto.InternalMessageInfo
+
+func (m *MysqlConnectionSpec) Reset()      { *m = MysqlConnectionSpec{} }
+func (*MysqlConnectionSpec) ProtoMessage() {}
+func (*MysqlConnectionSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331b1c7c7a035a4b, []int{1}
+}
+func (m *MysqlConnectionSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MysqlConnectionSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MysqlConnectionSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MysqlConnectionSpec.Merge(m, src)
+}
+func (m *MysqlConnectionSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MysqlConnectionSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MysqlConnectionSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MysqlConnectionSpec proto.InternalMessageInfo
 
 func (m *MysqlCore) Reset()      { *m = MysqlCore{} }
 func (*MysqlCore) ProtoMessage() {}
 func (*MysqlCore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331b1c7c7a035a4b, []int{0}
+	return fileDescriptor_331b1c7c7a035a4b, []int{2}
 }
 func (m *MysqlCore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -73,10 +131,178 @@ func (m *MysqlCore) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MysqlCore proto.InternalMessageInfo
 
+func (m *MysqlDatabase) Reset()      { *m = MysqlDatabase{} }
+func (*MysqlDatabase) ProtoMessage() {}
+func (*MysqlDatabase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331b1c7c7a035a4b, []int{3}
+}
+func (m *MysqlDatabase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MysqlDatabase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MysqlDatabase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MysqlDatabase.Merge(m, src)
+}
+func (m *MysqlDatabase) XXX_Size() int {
+	return m.Size()
+}
+func (m *MysqlDatabase) XXX_DiscardUnknown() {
+	xxx_messageInfo_MysqlDatabase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MysqlDatabase proto.InternalMessageInfo
+
+func (m *MysqlDatabaseList) Reset()      { *m = MysqlDatabaseList{} }
+func (*MysqlDatabaseList) ProtoMessage() {}
+func (*MysqlDatabaseList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331b1c7c7a035a4b, []int{4}
+}
+func (m *MysqlDatabaseList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MysqlDatabaseList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MysqlDatabaseList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MysqlDatabaseList.Merge(m, src)
+}
+func (m *MysqlDatabaseList) XXX_Size() int {
+	return m.Size()
+}
+func (m *MysqlDatabaseList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MysqlDatabaseList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MysqlDatabaseList proto.InternalMessageInfo
+
+func (m *MysqlDatabaseSpec) Reset()      { *m = MysqlDatabaseSpec{} }
+func (*MysqlDatabaseSpec) ProtoMessage() {}
+func (*MysqlDatabaseSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331b1c7c7a035a4b, []int{5}
+}
+func (m *MysqlDatabaseSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MysqlDatabaseSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MysqlDatabaseSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MysqlDatabaseSpec.Merge(m, src)
+}
+func (m *MysqlDatabaseSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MysqlDatabaseSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MysqlDatabaseSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MysqlDatabaseSpec proto.InternalMessageInfo
+
+func (m *MysqlDatabaseStatus) Reset()      { *m = MysqlDatabaseStatus{} }
+func (*MysqlDatabaseStatus) ProtoMessage() {}
+func (*MysqlDatabaseStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331b1c7c7a035a4b, []int{6}
+}
+func (m *MysqlDatabaseStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MysqlDatabaseStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MysqlDatabaseStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MysqlDatabaseStatus.Merge(m, src)
+}
+func (m *MysqlDatabaseStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MysqlDatabaseStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MysqlDatabaseStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MysqlDatabaseStatus proto.InternalMessageInfo
+
+func (m *MysqlFailoverSpec) Reset()      { *m = MysqlFailoverSpec{} }
+func (*MysqlFailoverSpec) ProtoMessage() {}
+func (*MysqlFailoverSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331b1c7c7a035a4b, []int{7}
+}
+func (m *MysqlFailoverSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MysqlFailoverSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MysqlFailoverSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MysqlFailoverSpec.Merge(m, src)
+}
+func (m *MysqlFailoverSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MysqlFailoverSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MysqlFailoverSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MysqlFailoverSpec proto.InternalMessageInfo
+
+func (m *MysqlGrant) Reset()      { *m = MysqlGrant{} }
+func (*MysqlGrant) ProtoMessage() {}
+func (*MysqlGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331b1c7c7a035a4b, []int{8}
+}
+func (m *MysqlGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MysqlGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MysqlGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MysqlGrant.Merge(m, src)
+}
+func (m *MysqlGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MysqlGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MysqlGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MysqlGrant proto.InternalMessageInfo
+
 func (m *MysqlOperator) Reset()      { *m = MysqlOperator{} }
 func (*MysqlOperator) ProtoMessage() {}
 func (*MysqlOperator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331b1c7c7a035a4b, []int{1}
+	return fileDescriptor_331b1c7c7a035a4b, []int{9}
 }
 func (m *MysqlOperator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -104,7 +330,7 @@ var xxx_messageInfo_MysqlOperator proto.InternalMessageInfo
 func (m *MysqlOperatorList) Reset()      { *m = MysqlOperatorList{} }
 func (*MysqlOperatorList) ProtoMessage() {}
 func (*MysqlOperatorList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331b1c7c7a035a4b, []int{2}
+	return fileDescriptor_331b1c7c7a035a4b, []int{10}
 }
 func (m *MysqlOperatorList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -132,7 +358,7 @@ var xxx_messageInfo_MysqlOperatorList proto.InternalMessageInfo
 func (m *MysqlOperatorSpec) Reset()      { *m = MysqlOperatorSpec{} }
 func (*MysqlOperatorSpec) ProtoMessage() {}
 func (*MysqlOperatorSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331b1c7c7a035a4b, []int{3}
+	return fileDescriptor_331b1c7c7a035a4b, []int{11}
 }
 func (m *MysqlOperatorSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,10 +383,38 @@ func (m *MysqlOperatorSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MysqlOperatorSpec proto.InternalMessageInfo
 
+func (m *MysqlOperatorStatus) Reset()      { *m = MysqlOperatorStatus{} }
+func (*MysqlOperatorStatus) ProtoMessage() {}
+func (*MysqlOperatorStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331b1c7c7a035a4b, []int{12}
+}
+func (m *MysqlOperatorStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MysqlOperatorStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MysqlOperatorStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MysqlOperatorStatus.Merge(m, src)
+}
+func (m *MysqlOperatorStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MysqlOperatorStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MysqlOperatorStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MysqlOperatorStatus proto.InternalMessageInfo
+
 func (m *MysqlSpec) Reset()      { *m = MysqlSpec{} }
 func (*MysqlSpec) ProtoMessage() {}
 func (*MysqlSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331b1c7c7a035a4b, []int{4}
+	return fileDescriptor_331b1c7c7a035a4b, []int{13}
 }
 func (m *MysqlSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,7 +442,7 @@ var xxx_messageInfo_MysqlSpec proto.InternalMessageInfo
 func (m *MysqlStatus) Reset()      { *m = MysqlStatus{} }
 func (*MysqlStatus) ProtoMessage() {}
 func (*MysqlStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331b1c7c7a035a4b, []int{5}
+	return fileDescriptor_331b1c7c7a035a4b, []int{14}
 }
 func (m *MysqlStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,10 +467,122 @@ func (m *MysqlStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MysqlStatus proto.InternalMessageInfo
 
+func (m *MysqlUser) Reset()      { *m = MysqlUser{} }
+func (*MysqlUser) ProtoMessage() {}
+func (*MysqlUser) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331b1c7c7a035a4b, []int{15}
+}
+func (m *MysqlUser) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MysqlUser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MysqlUser) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MysqlUser.Merge(m, src)
+}
+func (m *MysqlUser) XXX_Size() int {
+	return m.Size()
+}
+func (m *MysqlUser) XXX_DiscardUnknown() {
+	xxx_messageInfo_MysqlUser.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MysqlUser proto.InternalMessageInfo
+
+func (m *MysqlUserList) Reset()      { *m = MysqlUserList{} }
+func (*MysqlUserList) ProtoMessage() {}
+func (*MysqlUserList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331b1c7c7a035a4b, []int{16}
+}
+func (m *MysqlUserList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MysqlUserList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MysqlUserList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MysqlUserList.Merge(m, src)
+}
+func (m *MysqlUserList) XXX_Size() int {
+	return m.Size()
+}
+func (m *MysqlUserList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MysqlUserList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MysqlUserList proto.InternalMessageInfo
+
+func (m *MysqlUserSpec) Reset()      { *m = MysqlUserSpec{} }
+func (*MysqlUserSpec) ProtoMessage() {}
+func (*MysqlUserSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331b1c7c7a035a4b, []int{17}
+}
+func (m *MysqlUserSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MysqlUserSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MysqlUserSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MysqlUserSpec.Merge(m, src)
+}
+func (m *MysqlUserSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MysqlUserSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MysqlUserSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MysqlUserSpec proto.InternalMessageInfo
+
+func (m *MysqlUserStatus) Reset()      { *m = MysqlUserStatus{} }
+func (*MysqlUserStatus) ProtoMessage() {}
+func (*MysqlUserStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331b1c7c7a035a4b, []int{18}
+}
+func (m *MysqlUserStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MysqlUserStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MysqlUserStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MysqlUserStatus.Merge(m, src)
+}
+func (m *MysqlUserStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MysqlUserStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MysqlUserStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MysqlUserStatus proto.InternalMessageInfo
+
 func (m *ServerConfig) Reset()      { *m = ServerConfig{} }
 func (*ServerConfig) ProtoMessage() {}
 func (*ServerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331b1c7c7a035a4b, []int{6}
+	return fileDescriptor_331b1c7c7a035a4b, []int{19}
 }
 func (m *ServerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,12 +608,26 @@ func (m *ServerConfig) XXX_DiscardUnknown() {
 var xxx_messageInfo_ServerConfig proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterType((*MysqlBackupSpec)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlBackupSpec")
+	proto.RegisterType((*MysqlConnectionSpec)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlConnectionSpec")
 	proto.RegisterType((*MysqlCore)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlCore")
+	proto.RegisterType((*MysqlDatabase)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlDatabase")
+	proto.RegisterType((*MysqlDatabaseList)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlDatabaseList")
+	proto.RegisterType((*MysqlDatabaseSpec)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlDatabaseSpec")
+	proto.RegisterType((*MysqlDatabaseStatus)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlDatabaseStatus")
+	proto.RegisterType((*MysqlFailoverSpec)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlFailoverSpec")
+	proto.RegisterType((*MysqlGrant)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlGrant")
 	proto.RegisterType((*MysqlOperator)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlOperator")
 	proto.RegisterType((*MysqlOperatorList)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlOperatorList")
 	proto.RegisterType((*MysqlOperatorSpec)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlOperatorSpec")
+	proto.RegisterType((*MysqlOperatorStatus)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlOperatorStatus")
 	proto.RegisterType((*MysqlSpec)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlSpec")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlSpec.MyCnfEntry")
 	proto.RegisterType((*MysqlStatus)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlStatus")
+	proto.RegisterType((*MysqlUser)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlUser")
+	proto.RegisterType((*MysqlUserList)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlUserList")
+	proto.RegisterType((*MysqlUserSpec)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlUserSpec")
+	proto.RegisterType((*MysqlUserStatus)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.MysqlUserStatus")
 	proto.RegisterType((*ServerConfig)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.mysqloperator.v1.ServerConfig")
 }
 
@@ -256,91 +636,150 @@ func init() {
 }
 
 var fileDescriptor_331b1c7c7a035a4b = []byte{
-	// 1291 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x26, 0x71, 0x62, 0x8f, 0x9d, 0x3f, 0x1d, 0x04, 0x2c, 0x11, 0x72, 0x82, 0x39, 0x60,
-	0x10, 0x59, 0xd3, 0x0a, 0xaa, 0xaa, 0x48, 0x48, 0xdd, 0xd0, 0x42, 0x51, 0x43, 0xa3, 0x71, 0x93,
-	0x42, 0x29, 0x4a, 0x27, 0xeb, 0x17, 0x67, 0xc9, 0xee, 0x8e, 0x3b, 0x33, 0x6b, 0x14, 0xb8, 0x00,
-	0x12, 0x87, 0x4a, 0x95, 0xca, 0x95, 0x2f, 0xc1, 0xe7, 0xe8, 0xb1, 0xc7, 0x9e, 0x22, 0x1a, 0x3e,
-	0x02, 0x82, 0x43, 0x4f, 0x68, 0x66, 0xc7, 0xfb, 0xc7, 0xde, 0x00, 0x87, 0xe6, 0xe6, 0x99, 0xf7,
-	0x7b, 0xbf, 0xdf, 0x7b, 0x6f, 0xde, 0x7b, 0x6b, 0xf4, 0x75, 0xdf, 0x97, 0x07, 0xf1, 0x9e, 0xe3,
-	0xb1, 0xb0, 0x13, 0xc1, 0x10, 0xb8, 0x47, 0x05, 0x74, 0x0e, 0x2f, 0x89, 0x75, 0x8f, 0x45, 0x92,
-	0xb3, 0x20, 0x00, 0xbe, 0xee, 0xc5, 0x42, 0xb2, 0x70, 0x9d, 0x83, 0x60, 0x31, 0xf7, 0xa0, 0x33,
-	0x38, 0xec, 0x77, 0xe8, 0xc0, 0x17, 0x9d, 0xf0, 0x48, 0xdc, 0x0f, 0xd8, 0x00, 0x38, 0x95, 0x8c,
-	0x77, 0x86, 0xe7, 0x3b, 0x7d, 0x88, 0xd4, 0x01, 0x7a, 0xce, 0x80, 0x33, 0xc9, 0xf0, 0x66, 0x46,
-	0xef, 0xa4, 0xf4, 0xce, 0xe1, 0x25, 0xb1, 0x9b, 0xd1, 0xef, 0x26, 0xf4, 0xbb, 0x23, 0x7a, 0x67,
-	0x70, 0xd8, 0x77, 0x14, 0xbd, 0x53, 0xa0, 0x77, 0x86, 0xe7, 0x57, 0xd6, 0x73, 0xd1, 0xf6, 0x59,
-	0x9f, 0x75, 0xb4, 0xca, 0x5e, 0xbc, 0xaf, 0x4f, 0xfa, 0xa0, 0x7f, 0x25, 0xea, 0x2b, 0xad, 0xc3,
-	0x4b, 0xc2, 0xf1, 0x99, 0x8a, 0xb5, 0xe3, 0x31, 0x0e, 0x25, 0x11, 0xae, 0xbc, 0x9f, 0x61, 0x42,
-	0xea, 0x1d, 0xf8, 0x11, 0xf0, 0xa3, 0x5c, 0x82, 0x20, 0x69, 0x99, 0x57, 0xe7, 0x34, 0x2f, 0x1e,
-	0x47, 0xd2, 0x0f, 0x61, 0xc2, 0xe1, 0xe2, 0x7f, 0x39, 0x08, 0xef, 0x00, 0x42, 0x3a, 0xee, 0xd7,
-	0x7a, 0x38, 0x8d, 0x6a, 0x9b, 0xaa, 0x0c, 0x1b, 0x8c, 0x03, 0xfe, 0x0e, 0xcd, 0x8a, 0x01, 0x78,
-	0xb6, 0xb5, 0x66, 0xb5, 0xeb, 0x17, 0xbe, 0x70, 0x5e, 0x68, 0x75, 0x1d, 0xad, 0xd3, 0x1d, 0x80,
-	0xe7, 0x36, 0x1e, 0x1f, 0xaf, 0x4e, 0x9d, 0x1c, 0xaf, 0xce, 0xaa, 0x13, 0xd1, 0x9a, 0xf8, 0x27,
-	0x0b, 0xcd, 0x09, 0x49, 0x65, 0x2c, 0xec, 0x69, 0x2d, 0x7f, 0xe7, 0x4c, 0xe4, 0xb5, 0x82, 0xbb,
-	0x68, 0x02, 0x98, 0x4b, 0xce, 0xc4, 0x28, 0xb7, 0xfe, 0xb6, 0xd0, 0x82, 0xc6, 0xdd, 0x34, 0x8e,
-	0xf8, 0x1e, 0xaa, 0xaa, 0x47, 0xea, 0x51, 0x49, 0x4d, 0x59, 0xde, 0x73, 0x92, 0x5a, 0x3b, 0xf9,
-	0x5a, 0xe7, 0x74, 0x41, 0x52, 0x25, 0x77, 0x73, 0xef, 0x1b, 0xf0, 0xe4, 0x26, 0x48, 0xea, 0x62,
-	0xa3, 0x86, 0xb2, 0x3b, 0x92, 0xb2, 0xaa, 0xc4, 0x93, 0xaa, 0x27, 0x69, 0xdf, 0x3b, 0x8b, 0xb4,
-	0x47, 0xe9, 0x9c, 0x56, 0xfd, 0xd6, 0x5f, 0x16, 0x3a, 0x57, 0x40, 0xde, 0xf0, 0x85, 0xc4, 0x77,
-	0x27, 0x92, 0x77, 0xfe, 0x5f, 0xf2, 0xca, 0x5b, 0xa7, 0xbe, 0x6c, 0xb4, 0xaa, 0xa3, 0x9b, 0x5c,
-	0xe2, 0x3f, 0x5a, 0xa8, 0xe2, 0x4b, 0x08, 0xd5, 0x83, 0xcf, 0xb4, 0xeb, 0x17, 0xee, 0x9e, 0x65,
-	0xe6, 0xee, 0x82, 0x89, 0xa4, 0x72, 0x5d, 0x49, 0x92, 0x44, 0xb9, 0xf5, 0xdb, 0xf4, 0x58, 0xde,
-	0xaa, 0x26, 0xf8, 0xa1, 0x85, 0x50, 0x48, 0x85, 0x04, 0x7d, 0x3c, 0xcb, 0x71, 0x50, 0x63, 0x97,
-	0xf5, 0xc7, 0x66, 0xaa, 0x49, 0x72, 0xfa, 0xf8, 0x81, 0x85, 0x6a, 0x22, 0xa0, 0x43, 0xe8, 0x66,
-	0x6d, 0x72, 0x76, 0xd1, 0x9c, 0x33, 0xd1, 0xd4, 0xba, 0x23, 0x49, 0x92, 0xa9, 0xb7, 0xfe, 0x44,
-	0x66, 0x61, 0xe8, 0xc8, 0xd6, 0xd0, 0x6c, 0x44, 0x43, 0xd0, 0x15, 0xaa, 0x65, 0x8d, 0xf5, 0x39,
-	0x0d, 0x81, 0x68, 0x0b, 0x6e, 0xa3, 0x2a, 0x87, 0x41, 0xe0, 0x7b, 0x34, 0x99, 0xeb, 0x8a, 0xdb,
-	0x50, 0xed, 0x40, 0xcc, 0x1d, 0x49, 0xad, 0xf8, 0x4d, 0x54, 0xf1, 0x43, 0xda, 0x07, 0x7b, 0x46,
-	0x93, 0x65, 0xef, 0xa5, 0x2e, 0x49, 0x62, 0xc3, 0xbf, 0x5a, 0x68, 0x59, 0xff, 0xda, 0x8a, 0x83,
-	0xa0, 0x0b, 0x1e, 0x07, 0x29, 0xec, 0x59, 0xdd, 0x3e, 0xed, 0x5c, 0x6b, 0x3a, 0x6a, 0x1d, 0xeb,
-	0x46, 0x64, 0x1e, 0x0d, 0x92, 0xb1, 0x23, 0xb0, 0x0f, 0x1c, 0x22, 0x0f, 0xdc, 0x0d, 0x43, 0xbd,
-	0x7c, 0x7d, 0x8c, 0xe9, 0xf9, 0xf1, 0xea, 0x5b, 0x93, 0x3b, 0xbd, 0x94, 0x84, 0x4c, 0x84, 0x81,
-	0x77, 0xd0, 0x0c, 0x44, 0x43, 0xbb, 0xa2, 0xa3, 0x59, 0x29, 0x8b, 0xe6, 0x6a, 0x34, 0xdc, 0xa1,
-	0xdc, 0x6d, 0x1b, 0xfd, 0x99, 0xab, 0xd1, 0xf0, 0xf9, 0xf1, 0xea, 0x6b, 0x25, 0x92, 0x09, 0x92,
-	0x28, 0x42, 0xfc, 0x25, 0xaa, 0x8d, 0x1e, 0x50, 0xd8, 0x73, 0xfa, 0xf5, 0x4b, 0x73, 0x25, 0x06,
-	0x44, 0xe0, 0x7e, 0xec, 0x73, 0x08, 0x21, 0x92, 0x22, 0x7b, 0xcd, 0x91, 0x55, 0x90, 0x8c, 0x0d,
-	0x7f, 0x8f, 0x1a, 0x43, 0x16, 0xc4, 0x21, 0x6c, 0xb2, 0x38, 0x92, 0xc2, 0x9e, 0xd7, 0xb1, 0xaf,
-	0x96, 0xb1, 0xef, 0x64, 0x38, 0xf7, 0xa2, 0x21, 0x6d, 0xe4, 0x2e, 0x55, 0xf1, 0x9a, 0x25, 0x99,
-	0xe4, 0x20, 0xa4, 0x20, 0x86, 0x7f, 0xb6, 0xd0, 0xa2, 0xea, 0x55, 0xaa, 0x96, 0xc8, 0x16, 0xe3,
-	0x52, 0xd8, 0x55, 0xad, 0xff, 0x46, 0x99, 0xfe, 0x46, 0x1e, 0xe9, 0x5e, 0x36, 0x11, 0x2c, 0x16,
-	0xae, 0x55, 0x0c, 0x6b, 0x25, 0x31, 0x14, 0x40, 0x64, 0x4c, 0x54, 0x15, 0x41, 0x00, 0x1f, 0xfa,
-	0x1e, 0x24, 0x41, 0xd4, 0x4e, 0x2f, 0x42, 0x37, 0xc3, 0x65, 0x45, 0xc8, 0x5d, 0x9e, 0x56, 0x84,
-	0x1c, 0x84, 0x14, 0xc4, 0xf0, 0x6d, 0x54, 0x37, 0xe7, 0x5b, 0x47, 0x03, 0xb0, 0x91, 0xee, 0xfd,
-	0x0f, 0x0c, 0x75, 0xbd, 0x9b, 0x99, 0xfe, 0x9d, 0x59, 0x21, 0x48, 0x9e, 0x09, 0x5f, 0x40, 0x28,
-	0xa9, 0xf6, 0x16, 0x95, 0x07, 0x76, 0x5d, 0xf3, 0xa6, 0x8b, 0x66, 0x27, 0xb5, 0x90, 0x1c, 0x4a,
-	0x8d, 0x33, 0x67, 0x01, 0xd8, 0x8d, 0xe2, 0x38, 0x13, 0x16, 0x00, 0xd1, 0x16, 0xfc, 0xc8, 0x4a,
-	0x8a, 0x05, 0x7c, 0x83, 0x45, 0xfb, 0x7e, 0xdf, 0x5e, 0xd0, 0xfd, 0xf8, 0xd5, 0x0b, 0xde, 0x46,
-	0xdd, 0x9c, 0x44, 0xf6, 0xb1, 0x4e, 0xce, 0xa4, 0x10, 0x00, 0xfe, 0x18, 0x2d, 0x9b, 0xb4, 0x6f,
-	0x1f, 0xf8, 0x12, 0xd4, 0x77, 0xc6, 0x5e, 0x5c, 0xb3, 0xda, 0x55, 0xd7, 0x1e, 0x8d, 0x79, 0x77,
-	0xcc, 0x4e, 0x26, 0x3c, 0xf0, 0x35, 0x54, 0xa5, 0xfb, 0xfb, 0x7e, 0xe4, 0xcb, 0x23, 0x7b, 0x49,
-	0xa7, 0xf4, 0x7a, 0xd9, 0xfb, 0x5f, 0x31, 0x98, 0x64, 0x89, 0x8d, 0x4e, 0x24, 0xf5, 0xc5, 0xdb,
-	0xa8, 0x2e, 0x59, 0xa0, 0x12, 0xf1, 0x59, 0x24, 0xec, 0x65, 0xdd, 0x4a, 0xcd, 0x32, 0xaa, 0x5b,
-	0x29, 0xcc, 0x7d, 0x69, 0xf4, 0xdc, 0xd9, 0x9d, 0x20, 0x79, 0x9e, 0xd6, 0x83, 0x59, 0x54, 0xcf,
-	0xfd, 0x7f, 0xc1, 0x9f, 0x21, 0xcc, 0xf6, 0x74, 0x19, 0x7a, 0x9f, 0x24, 0xff, 0xe8, 0x7c, 0x16,
-	0xe9, 0x2d, 0x3c, 0xe3, 0xae, 0x18, 0x36, 0x7c, 0x73, 0x02, 0x41, 0x4a, 0xbc, 0xf0, 0xbb, 0x13,
-	0x1b, 0x3a, 0xfd, 0x68, 0x97, 0x6c, 0xe9, 0x0f, 0xd1, 0x02, 0x07, 0xda, 0x3b, 0x1a, 0x99, 0xf4,
-	0xb6, 0xae, 0xb8, 0x2f, 0x1b, 0x97, 0x05, 0x92, 0x37, 0x92, 0x22, 0x16, 0x5f, 0x41, 0x4b, 0x5e,
-	0xcc, 0x39, 0x44, 0x32, 0x75, 0x9f, 0xd5, 0xee, 0xaf, 0x1a, 0xf7, 0xa5, 0x8d, 0xa2, 0x99, 0x8c,
-	0xe3, 0x15, 0x45, 0x3c, 0xe8, 0xa9, 0x7f, 0xb0, 0x29, 0x45, 0xa5, 0x48, 0xb1, 0x5d, 0x34, 0x93,
-	0x71, 0x7c, 0x21, 0x8a, 0xa1, 0x2f, 0x54, 0xe5, 0xe6, 0x74, 0xc3, 0x4f, 0x46, 0x91, 0x98, 0xc9,
-	0x38, 0x1e, 0x7f, 0x84, 0x16, 0x13, 0xd6, 0x94, 0x61, 0x5e, 0x33, 0xbc, 0x32, 0x5a, 0x4b, 0xdb,
-	0x05, 0x2b, 0x19, 0x43, 0xe3, 0xcb, 0x6a, 0xf3, 0x05, 0x81, 0x3e, 0x6c, 0xa8, 0x6d, 0x68, 0xd7,
-	0x74, 0x12, 0x38, 0x59, 0x69, 0x79, 0x0b, 0x19, 0x43, 0xb6, 0x1e, 0x4d, 0xa3, 0x46, 0x7e, 0x3e,
-	0xf0, 0xdb, 0xa8, 0x96, 0x4c, 0xc4, 0xae, 0xdf, 0xd3, 0x3d, 0x60, 0xbe, 0xb1, 0x09, 0xe8, 0x7a,
-	0x8f, 0x54, 0x85, 0xf9, 0xa5, 0x06, 0xfc, 0x80, 0x09, 0xa9, 0xdf, 0x39, 0x37, 0xe0, 0x9f, 0x32,
-	0x21, 0x89, 0xb6, 0x28, 0x44, 0x2c, 0x80, 0x9b, 0x8f, 0x70, 0x8a, 0xd8, 0x16, 0xc0, 0x89, 0xb6,
-	0xa8, 0x7e, 0x19, 0x50, 0x21, 0xbe, 0x65, 0xbc, 0xa7, 0x5f, 0xaf, 0x96, 0xf5, 0xcb, 0x96, 0xb9,
-	0x27, 0x29, 0x02, 0xbf, 0x83, 0xaa, 0x01, 0xeb, 0xef, 0xee, 0xfb, 0x01, 0xe8, 0x87, 0xaa, 0xb9,
-	0x4b, 0x06, 0x3d, 0x7f, 0x83, 0xf5, 0xaf, 0xf9, 0x01, 0x90, 0xf9, 0x20, 0xf9, 0x81, 0x2f, 0xa2,
-	0x86, 0xc2, 0x0e, 0x98, 0xf0, 0x65, 0xf6, 0x2a, 0xe9, 0x74, 0xdc, 0x60, 0xfd, 0x2d, 0x63, 0x22,
-	0xf5, 0x20, 0x3b, 0xb8, 0xed, 0xc7, 0xcf, 0x9a, 0x53, 0x4f, 0x9e, 0x35, 0xa7, 0x9e, 0x3e, 0x6b,
-	0x4e, 0xfd, 0x70, 0xd2, 0xb4, 0x1e, 0x9f, 0x34, 0xad, 0x27, 0x27, 0x4d, 0xeb, 0xe9, 0x49, 0xd3,
-	0xfa, 0xfd, 0xa4, 0x69, 0xfd, 0xf2, 0x47, 0x73, 0xea, 0xce, 0xf4, 0xf0, 0xfc, 0x3f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xf9, 0x61, 0x66, 0xcd, 0x8f, 0x0e, 0x00, 0x00,
+	// 2238 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdd, 0x6f, 0x5b, 0x49,
+	0x15, 0xef, 0xb5, 0xe3, 0xc4, 0x1e, 0x3b, 0x1f, 0x3b, 0x5d, 0xb6, 0x97, 0x00, 0x4e, 0x30, 0x12,
+	0x64, 0x57, 0xad, 0x4d, 0x2b, 0xb6, 0x8a, 0x8a, 0x84, 0x54, 0xbb, 0x4d, 0x09, 0x4a, 0xa8, 0x75,
+	0x9c, 0x74, 0x3f, 0xd9, 0xec, 0xe4, 0x7a, 0xe2, 0x5c, 0x72, 0x7d, 0xc7, 0x3b, 0x73, 0x6d, 0x14,
+	0x56, 0xac, 0x58, 0x10, 0x68, 0x41, 0x2b, 0x15, 0x21, 0x21, 0xb1, 0x12, 0xff, 0x06, 0x4f, 0xfc,
+	0x03, 0x7d, 0xdc, 0x17, 0xa4, 0x7d, 0x8a, 0x68, 0x78, 0xe5, 0x15, 0x1e, 0xfa, 0x02, 0x9a, 0x8f,
+	0xfb, 0x69, 0xbb, 0x04, 0x54, 0x77, 0xa5, 0x7d, 0xf3, 0xcc, 0xf9, 0xcd, 0xf9, 0x9e, 0x73, 0xcf,
+	0x1c, 0xa3, 0x1f, 0xf5, 0xdc, 0xe0, 0x78, 0x78, 0x58, 0x77, 0x58, 0xbf, 0xe1, 0xd3, 0x11, 0xe5,
+	0x0e, 0x11, 0xb4, 0x71, 0xb2, 0x29, 0xae, 0x39, 0xcc, 0x0f, 0x38, 0xf3, 0x3c, 0xca, 0xaf, 0x39,
+	0x43, 0x11, 0xb0, 0xfe, 0x35, 0x4e, 0x05, 0x1b, 0x72, 0x87, 0x36, 0x06, 0x27, 0xbd, 0x06, 0x19,
+	0xb8, 0xa2, 0xd1, 0x3f, 0x15, 0xef, 0x79, 0x6c, 0x40, 0x39, 0x09, 0x18, 0x6f, 0x8c, 0xae, 0x37,
+	0x7a, 0xd4, 0x97, 0x0b, 0xda, 0xad, 0x0f, 0x38, 0x0b, 0x18, 0xde, 0x8d, 0xd9, 0xd7, 0x23, 0xf6,
+	0xf5, 0x93, 0x4d, 0x71, 0x10, 0xb3, 0x3f, 0xd0, 0xec, 0x0f, 0x42, 0xf6, 0xf5, 0xc1, 0x49, 0xaf,
+	0x2e, 0xd9, 0xd7, 0x53, 0xec, 0xeb, 0xa3, 0xeb, 0xab, 0xd7, 0x12, 0xda, 0xf6, 0x58, 0x8f, 0x35,
+	0x94, 0x94, 0xc3, 0xe1, 0x91, 0x5a, 0xa9, 0x85, 0xfa, 0xa5, 0xa5, 0xaf, 0xd6, 0x4e, 0x36, 0x45,
+	0xdd, 0x65, 0x52, 0xd7, 0x86, 0xc3, 0x38, 0x9d, 0xa0, 0xe1, 0xea, 0x77, 0x62, 0x4c, 0x9f, 0x38,
+	0xc7, 0xae, 0x4f, 0xf9, 0x69, 0xc2, 0x40, 0x1a, 0x90, 0x49, 0xa7, 0x1a, 0xd3, 0x4e, 0xf1, 0xa1,
+	0x1f, 0xb8, 0x7d, 0x3a, 0x76, 0xe0, 0xe6, 0x7f, 0x3b, 0x20, 0x9c, 0x63, 0xda, 0x27, 0xd9, 0x73,
+	0xb5, 0x3f, 0xe6, 0xd1, 0xf2, 0xae, 0x74, 0x43, 0x93, 0x38, 0x27, 0xc3, 0x41, 0x67, 0x40, 0x1d,
+	0xfc, 0x0d, 0x54, 0x70, 0xfb, 0xa4, 0x47, 0x6d, 0x6b, 0xdd, 0xda, 0x28, 0x35, 0x17, 0x1f, 0x9d,
+	0xad, 0x5d, 0x3a, 0x3f, 0x5b, 0x2b, 0x6c, 0xcb, 0x4d, 0xd0, 0x34, 0xfc, 0x2a, 0x2a, 0x13, 0xee,
+	0x1c, 0xbb, 0x23, 0xda, 0x26, 0xc1, 0xb1, 0x9d, 0x53, 0xd0, 0xcb, 0x06, 0x5a, 0xbe, 0x1d, 0x93,
+	0x20, 0x89, 0xc3, 0xfb, 0xe8, 0xca, 0xd1, 0xd0, 0xf3, 0xee, 0x0c, 0xfb, 0x83, 0x6d, 0x3f, 0xa0,
+	0x7c, 0x44, 0xbc, 0x0e, 0x75, 0x98, 0xdf, 0x15, 0x76, 0x7e, 0xdd, 0xda, 0x28, 0x34, 0xbf, 0x72,
+	0x7e, 0xb6, 0x76, 0x65, 0x6b, 0x32, 0x04, 0xa6, 0x9d, 0xc5, 0xb7, 0xd0, 0x12, 0xa7, 0x01, 0xf5,
+	0x03, 0x97, 0xf9, 0x2d, 0x36, 0xf4, 0x03, 0x7b, 0x4e, 0x71, 0xc3, 0xe7, 0x67, 0x6b, 0x4b, 0x90,
+	0xa2, 0x40, 0x06, 0x89, 0xbf, 0x8b, 0x16, 0x39, 0x15, 0x01, 0xe3, 0x74, 0x8f, 0xed, 0xb9, 0x7d,
+	0x6a, 0x17, 0x94, 0x2d, 0x5f, 0x32, 0xb6, 0x2c, 0x42, 0x92, 0x08, 0x69, 0x2c, 0x7e, 0x03, 0x95,
+	0xc2, 0xbc, 0x12, 0xf6, 0xfc, 0xba, 0xb5, 0x51, 0xbe, 0xb1, 0x51, 0xd7, 0xb1, 0x90, 0x39, 0x56,
+	0x97, 0x69, 0x51, 0x1f, 0x5d, 0xaf, 0x83, 0x01, 0x01, 0x7d, 0x6f, 0xe8, 0x72, 0xda, 0xa7, 0x7e,
+	0x20, 0x9a, 0x2f, 0x18, 0x11, 0xa5, 0x90, 0x2a, 0x20, 0xe6, 0x56, 0xfb, 0x8b, 0x85, 0x2e, 0xab,
+	0xd0, 0xb4, 0x98, 0xef, 0x53, 0x47, 0x2a, 0xac, 0xc2, 0xb3, 0x8e, 0xe6, 0x86, 0x82, 0x72, 0x13,
+	0x9d, 0x8a, 0xe1, 0x31, 0xb7, 0x2f, 0x28, 0x07, 0x45, 0xc1, 0x1f, 0x5a, 0x68, 0xbe, 0xc7, 0x89,
+	0x1f, 0x08, 0x3b, 0xb7, 0x9e, 0xdf, 0x28, 0xdf, 0x78, 0xa3, 0xfe, 0x4c, 0xef, 0x49, 0x5d, 0xa9,
+	0x75, 0x4f, 0x4a, 0x68, 0x2e, 0x19, 0xf9, 0xf3, 0x6a, 0x29, 0xc0, 0x08, 0xae, 0x7d, 0x9c, 0x43,
+	0x25, 0xa3, 0x3d, 0xa7, 0xf8, 0xa7, 0x68, 0x4e, 0x0c, 0xa8, 0xa3, 0x74, 0x2e, 0xdf, 0x78, 0x7d,
+	0x16, 0xea, 0x48, 0xdf, 0xc4, 0xde, 0x90, 0x2b, 0x50, 0x32, 0xf1, 0x2f, 0x2c, 0x34, 0x2f, 0x02,
+	0x12, 0x0c, 0x85, 0xca, 0xd2, 0xf2, 0x8d, 0x37, 0x67, 0x22, 0x5e, 0x49, 0x88, 0xdd, 0xa1, 0xd7,
+	0x60, 0x24, 0xd7, 0x7e, 0x99, 0x47, 0x8b, 0x0a, 0x77, 0x87, 0x04, 0xe4, 0x90, 0x08, 0x8a, 0xdf,
+	0x45, 0x45, 0x79, 0xfb, 0xbb, 0x24, 0x20, 0xc6, 0x2d, 0xdf, 0x4e, 0x24, 0x4e, 0x74, 0x89, 0x13,
+	0x72, 0x69, 0x40, 0xa4, 0xb8, 0xfb, 0x87, 0x3f, 0xa6, 0x4e, 0xb0, 0x4b, 0x03, 0xd2, 0xc4, 0x46,
+	0x1a, 0x8a, 0xf7, 0x20, 0xe2, 0x2a, 0x0d, 0xd7, 0x5e, 0xd7, 0x66, 0xbf, 0x3b, 0x0b, 0xb3, 0x43,
+	0x73, 0xa6, 0x7a, 0xff, 0xb7, 0xb1, 0xf7, 0xf3, 0x4a, 0x8d, 0xc3, 0x99, 0xaa, 0xf1, 0xf4, 0x28,
+	0xfc, 0xd3, 0x42, 0x2f, 0xa4, 0xf0, 0x3b, 0xae, 0x08, 0xf0, 0xdb, 0x63, 0x91, 0xa8, 0x5f, 0x2c,
+	0x12, 0xf2, 0xb4, 0x8a, 0xc3, 0x8a, 0x91, 0x57, 0x0c, 0x77, 0x12, 0x51, 0xf8, 0xd0, 0x42, 0x05,
+	0x37, 0xa0, 0xfd, 0xf0, 0x2e, 0xbe, 0x3d, 0x4b, 0xfb, 0x13, 0xc5, 0x5a, 0x8a, 0x04, 0x2d, 0xb9,
+	0xf6, 0x51, 0x2e, 0x63, 0xb7, 0x2a, 0x24, 0x57, 0x51, 0x31, 0x64, 0x64, 0x8a, 0x49, 0x64, 0xc7,
+	0x7d, 0xb3, 0x0f, 0x11, 0x42, 0x96, 0x1d, 0x9f, 0xf4, 0xa9, 0xa9, 0xf4, 0x51, 0xa8, 0x7f, 0x48,
+	0xfa, 0x14, 0x14, 0x05, 0x6f, 0xa2, 0x8a, 0x73, 0x4c, 0x38, 0x71, 0x02, 0xca, 0x3b, 0x34, 0x50,
+	0xf1, 0x2e, 0x35, 0x5f, 0x34, 0xc8, 0x4a, 0x2b, 0x41, 0x83, 0x14, 0x12, 0x37, 0x50, 0xc9, 0x61,
+	0x9e, 0x47, 0x64, 0x8d, 0x53, 0x95, 0xbb, 0x14, 0xd7, 0xc6, 0x56, 0x48, 0x80, 0x18, 0x23, 0x45,
+	0x75, 0x39, 0x1b, 0xdc, 0xf7, 0xef, 0x50, 0x8f, 0x06, 0xba, 0x64, 0x17, 0x63, 0x51, 0x77, 0x12,
+	0x34, 0x48, 0x21, 0x6b, 0x14, 0x5d, 0x4e, 0x7b, 0x42, 0x65, 0x86, 0xfc, 0xe6, 0x0d, 0x8e, 0x89,
+	0x18, 0xfb, 0xe6, 0xb5, 0xe5, 0x26, 0x68, 0x1a, 0x7e, 0x19, 0x2d, 0xf4, 0xa9, 0x10, 0xa4, 0x17,
+	0x7a, 0x61, 0xd9, 0xc0, 0x16, 0x76, 0xf5, 0x36, 0x84, 0xf4, 0xda, 0x5b, 0xc6, 0xe1, 0x5b, 0xc4,
+	0xf5, 0xd8, 0x88, 0x72, 0xe5, 0xf0, 0x2d, 0x84, 0x7b, 0x9c, 0x38, 0xb4, 0x4d, 0xb9, 0xcb, 0xba,
+	0xe1, 0x77, 0xcf, 0x52, 0x5f, 0xaa, 0x97, 0xce, 0xcf, 0xd6, 0xf0, 0xbd, 0x31, 0x2a, 0x4c, 0x38,
+	0x51, 0x7b, 0x68, 0x21, 0x14, 0x97, 0x60, 0x19, 0xc7, 0xae, 0xb1, 0x26, 0x1b, 0xc7, 0xd0, 0x4a,
+	0x88, 0x10, 0xd2, 0xd2, 0x80, 0x1c, 0x7a, 0xa1, 0x09, 0x91, 0xa5, 0x7b, 0x72, 0x13, 0x34, 0x0d,
+	0xd7, 0x11, 0x1a, 0x70, 0x77, 0xe4, 0x7a, 0xb4, 0x47, 0xe5, 0xc5, 0xcd, 0x6f, 0x94, 0x9a, 0x4b,
+	0xb2, 0xd0, 0xb4, 0xa3, 0x5d, 0x48, 0x20, 0xe2, 0xf2, 0x16, 0x26, 0xce, 0x17, 0xa4, 0xbc, 0x85,
+	0xe6, 0x7c, 0xce, 0xe5, 0x2d, 0x52, 0xe3, 0x82, 0xe5, 0x2d, 0xc4, 0x7f, 0x51, 0xca, 0x5b, 0x68,
+	0xcf, 0x94, 0xf2, 0xf6, 0xd7, 0x42, 0xc6, 0x6e, 0x75, 0xdb, 0x3e, 0xb6, 0x10, 0xea, 0x13, 0x11,
+	0xe8, 0xcb, 0x37, 0xcb, 0xd6, 0x43, 0xb6, 0x38, 0x71, 0xb2, 0xee, 0x46, 0x32, 0x21, 0x21, 0x1f,
+	0xff, 0xc6, 0x42, 0x25, 0xe1, 0x91, 0x11, 0xed, 0xc4, 0x39, 0x3b, 0x3b, 0x6d, 0xa2, 0xf2, 0xd9,
+	0x09, 0x45, 0x42, 0x2c, 0x5d, 0xb5, 0x44, 0x87, 0xaa, 0xe1, 0x37, 0x59, 0xfb, 0xce, 0x2c, 0x14,
+	0x89, 0x9f, 0x14, 0x4d, 0x24, 0xb3, 0x55, 0xaf, 0xc1, 0x48, 0x96, 0x57, 0xa7, 0x78, 0x64, 0xca,
+	0xa3, 0x3d, 0x37, 0xbb, 0x3b, 0x9c, 0x2c, 0xc1, 0xcd, 0x8a, 0x4c, 0xe3, 0x70, 0x07, 0x22, 0xf9,
+	0xf8, 0xf7, 0x16, 0x42, 0x4e, 0xd4, 0x67, 0xdb, 0x85, 0xd9, 0xdd, 0xe5, 0x74, 0x37, 0xaf, 0xab,
+	0x6a, 0xbc, 0x07, 0x09, 0x2d, 0x6a, 0x7f, 0x9e, 0x43, 0x97, 0xd3, 0x79, 0xfd, 0x3f, 0x7c, 0xac,
+	0xae, 0xa2, 0x22, 0xa7, 0x03, 0xcf, 0x75, 0x88, 0xee, 0x7b, 0x0b, 0xf1, 0x35, 0x06, 0xb3, 0x0f,
+	0x11, 0x42, 0x3f, 0x82, 0x48, 0xf7, 0x34, 0x24, 0x99, 0xd7, 0x58, 0xe2, 0x11, 0x94, 0x20, 0x42,
+	0x1a, 0x2b, 0x45, 0x09, 0xea, 0x51, 0x27, 0x60, 0x3a, 0x90, 0x89, 0x0f, 0x50, 0xc7, 0xec, 0x43,
+	0x84, 0xc0, 0x8e, 0xf2, 0x74, 0xd7, 0x95, 0x26, 0x0a, 0xbb, 0xa0, 0xaa, 0x46, 0xe3, 0x62, 0x15,
+	0xa9, 0x15, 0x9e, 0x8b, 0x6f, 0x5b, 0xb4, 0x25, 0x20, 0xc1, 0x16, 0x7f, 0x80, 0xe6, 0xf5, 0xdd,
+	0xb3, 0xe7, 0x67, 0xde, 0xf3, 0xab, 0xe4, 0xd6, 0xb7, 0x1e, 0x8c, 0x54, 0xfc, 0x3e, 0x2a, 0xa8,
+	0xeb, 0x66, 0x2f, 0xcc, 0x5c, 0x7c, 0x49, 0x86, 0x5e, 0x5d, 0x73, 0xd0, 0x32, 0x6b, 0x7f, 0x5a,
+	0x34, 0x6f, 0xaf, 0xf0, 0xbd, 0xa8, 0x1a, 0x37, 0x6b, 0x6a, 0xe3, 0xb6, 0x31, 0x96, 0x2a, 0x95,
+	0x29, 0x69, 0x12, 0x8d, 0x06, 0xf2, 0x4f, 0x19, 0x0d, 0x7c, 0x62, 0xa1, 0x15, 0xf5, 0xab, 0x3d,
+	0xf4, 0xe4, 0x0b, 0x9d, 0xd3, 0x40, 0xd8, 0x73, 0xeb, 0xf9, 0x69, 0x6f, 0xe3, 0x1d, 0xe6, 0x10,
+	0x4f, 0x7f, 0xe2, 0x81, 0x1e, 0x51, 0x4e, 0x7d, 0x87, 0x36, 0x5b, 0x86, 0xf5, 0xca, 0x76, 0x86,
+	0xd3, 0x93, 0xb3, 0xb5, 0x6f, 0x8d, 0xcf, 0x5d, 0x26, 0x32, 0x81, 0x31, 0x35, 0xf0, 0x03, 0x94,
+	0xa7, 0xfe, 0xc8, 0x64, 0xdd, 0xea, 0x24, 0x6d, 0xee, 0xfa, 0xa3, 0x07, 0x84, 0x37, 0x37, 0x8c,
+	0xfc, 0xfc, 0x5d, 0x7f, 0xf4, 0xe4, 0x6c, 0xed, 0xcb, 0x13, 0x44, 0x6a, 0x24, 0x48, 0x86, 0x33,
+	0x9c, 0x03, 0xe0, 0xf7, 0x51, 0x65, 0xc4, 0xbc, 0x61, 0x9f, 0xee, 0xca, 0x71, 0x85, 0xb0, 0x17,
+	0x94, 0xee, 0x6b, 0x93, 0xb8, 0x3f, 0x88, 0x71, 0xcd, 0x9b, 0x61, 0x33, 0x9c, 0xd8, 0x94, 0xce,
+	0xab, 0x4e, 0xb0, 0x24, 0x01, 0x81, 0x94, 0x30, 0xfc, 0x2b, 0x0b, 0x2d, 0xc9, 0x0c, 0x25, 0xf2,
+	0x46, 0xb6, 0x19, 0x0f, 0x84, 0x5d, 0x54, 0xf2, 0xbf, 0x3e, 0x49, 0x7e, 0x2b, 0x89, 0x6c, 0xde,
+	0x32, 0x1a, 0x2c, 0xa5, 0xb6, 0xa5, 0x0e, 0xeb, 0x13, 0x74, 0x48, 0x81, 0x20, 0x23, 0x54, 0x3a,
+	0x41, 0x50, 0x3e, 0x72, 0x1d, 0xaa, 0x95, 0x28, 0x4d, 0x77, 0x42, 0x27, 0xc6, 0xc5, 0x4e, 0x48,
+	0x6c, 0x4e, 0x73, 0x42, 0x02, 0x02, 0x29, 0x61, 0xf8, 0x35, 0x54, 0x36, 0xeb, 0xbd, 0xd3, 0x01,
+	0xb5, 0x91, 0xca, 0xfd, 0x57, 0xc3, 0x59, 0x57, 0x27, 0x26, 0x3d, 0x9d, 0xb3, 0x44, 0x40, 0x92,
+	0x13, 0xbe, 0x81, 0x90, 0xf6, 0xb6, 0x9a, 0xa1, 0x95, 0x15, 0xdf, 0xa8, 0xb2, 0x3d, 0x88, 0x28,
+	0x90, 0x40, 0xc9, 0xeb, 0xcc, 0x99, 0x47, 0xed, 0x4a, 0xfa, 0x3a, 0x03, 0xf3, 0x28, 0x28, 0x0a,
+	0x7e, 0x68, 0x69, 0x67, 0x51, 0xde, 0x62, 0xfe, 0x91, 0xdb, 0xb3, 0x17, 0x55, 0x3e, 0xbe, 0xf5,
+	0x8c, 0x6b, 0x50, 0x27, 0x21, 0x22, 0x6e, 0x49, 0xf5, 0x1a, 0x52, 0x0a, 0xe0, 0x3b, 0x68, 0xc5,
+	0x98, 0xfd, 0xda, 0xb1, 0x1b, 0xa8, 0x57, 0xb7, 0xbd, 0xa4, 0x9e, 0x6c, 0x76, 0x78, 0xcd, 0x3b,
+	0x19, 0x3a, 0x8c, 0x9d, 0xc0, 0x5b, 0xa8, 0x48, 0x8e, 0x8e, 0x5c, 0xdf, 0x0d, 0x4e, 0xed, 0x65,
+	0x65, 0xd2, 0x57, 0x27, 0xc5, 0xff, 0xb6, 0xc1, 0xe8, 0x22, 0x16, 0xae, 0x20, 0x3a, 0x8b, 0xf7,
+	0x51, 0x39, 0x60, 0x9e, 0x34, 0x44, 0x7d, 0x81, 0x56, 0x54, 0x2a, 0x55, 0x27, 0xb1, 0xda, 0x8b,
+	0x60, 0xf1, 0x68, 0x33, 0xde, 0x13, 0x90, 0xe4, 0x83, 0x3f, 0xb2, 0x50, 0xa1, 0x7f, 0xda, 0xf2,
+	0x8f, 0xec, 0x17, 0x14, 0x47, 0x67, 0x56, 0x53, 0xae, 0xfa, 0xae, 0x94, 0x72, 0xd7, 0x0f, 0xf8,
+	0x69, 0x5c, 0x81, 0xd5, 0x1e, 0x68, 0x05, 0xf0, 0x21, 0x5a, 0x8e, 0x2a, 0x5f, 0x9b, 0x79, 0xae,
+	0x73, 0x6a, 0x63, 0x95, 0x2e, 0x9b, 0x06, 0xbe, 0xbc, 0x9d, 0x26, 0x3f, 0x39, 0x5b, 0xfb, 0xda,
+	0x84, 0xc4, 0x8d, 0x01, 0x90, 0x65, 0xb8, 0xba, 0x89, 0x50, 0xac, 0x07, 0x5e, 0x41, 0xf9, 0x13,
+	0x7a, 0xaa, 0xbf, 0x31, 0x20, 0x7f, 0xe2, 0x17, 0x51, 0x61, 0x44, 0xbc, 0xa1, 0x79, 0x67, 0x82,
+	0x5e, 0xdc, 0xca, 0x6d, 0x5a, 0xb5, 0x4f, 0x16, 0x50, 0x39, 0xf1, 0x01, 0xc3, 0x3f, 0x40, 0x98,
+	0x1d, 0xaa, 0x7c, 0xe9, 0xde, 0xd3, 0xe3, 0x69, 0xd9, 0x82, 0x49, 0x56, 0xf9, 0xe6, 0xaa, 0x51,
+	0x18, 0xdf, 0x1f, 0x43, 0xc0, 0x84, 0x53, 0xcf, 0xb3, 0xeb, 0xb9, 0x8d, 0x96, 0x9d, 0x21, 0xe7,
+	0xd4, 0x0f, 0xa2, 0xe3, 0x7a, 0xe8, 0x7c, 0x25, 0x74, 0x72, 0x2b, 0x4d, 0x86, 0x2c, 0x5e, 0xb2,
+	0x18, 0x0e, 0xba, 0x72, 0x1c, 0x1f, 0xb1, 0x28, 0xa4, 0x59, 0xec, 0xa7, 0xc9, 0x90, 0xc5, 0xa7,
+	0xb4, 0x18, 0xb9, 0x42, 0x7a, 0x6e, 0x5e, 0x85, 0x7a, 0x5c, 0x0b, 0x4d, 0x86, 0x2c, 0x1e, 0x7f,
+	0x0f, 0x2d, 0x69, 0xae, 0x11, 0x87, 0x05, 0xc5, 0xe1, 0xa5, 0xb0, 0x7e, 0xef, 0xa7, 0xa8, 0x90,
+	0x41, 0xcb, 0xe1, 0xbb, 0x9c, 0xcc, 0xb8, 0x22, 0x1c, 0xa9, 0xdb, 0xa5, 0x78, 0xf8, 0xde, 0x4a,
+	0x51, 0x20, 0x83, 0x94, 0x83, 0x1c, 0x33, 0x50, 0x57, 0xcd, 0xab, 0xa9, 0xad, 0xd1, 0x20, 0x07,
+	0x12, 0x34, 0x48, 0x21, 0xa5, 0xd6, 0x66, 0xdd, 0x35, 0x73, 0xfb, 0x72, 0x5a, 0x6b, 0x48, 0x51,
+	0x21, 0x83, 0x96, 0xb1, 0x37, 0x8e, 0xd0, 0xad, 0x9b, 0x29, 0xa8, 0x51, 0xec, 0x5b, 0x49, 0x22,
+	0xa4, 0xb1, 0xb2, 0xa0, 0xc5, 0x7d, 0xba, 0xee, 0x2d, 0x54, 0x95, 0x2d, 0xc5, 0x05, 0xad, 0x95,
+	0xa1, 0xc3, 0xd8, 0x09, 0xfc, 0x4d, 0x34, 0x3f, 0x20, 0x43, 0x41, 0xbb, 0xa6, 0x18, 0x46, 0x45,
+	0xb4, 0xad, 0x76, 0xc1, 0x50, 0xb1, 0x8b, 0x96, 0x75, 0x5b, 0xb9, 0xc3, 0x44, 0xd0, 0x71, 0x7d,
+	0x87, 0x9a, 0xfa, 0xf7, 0xca, 0xc5, 0xda, 0x66, 0x69, 0x6f, 0xf3, 0xb2, 0xcc, 0x85, 0xdd, 0x34,
+	0x1b, 0xc8, 0xf2, 0xad, 0xfd, 0x2b, 0x1c, 0xdb, 0xcb, 0xbf, 0x13, 0x9e, 0xc3, 0x10, 0xe7, 0x83,
+	0xd4, 0x0c, 0x67, 0x26, 0xc3, 0x03, 0x69, 0xc9, 0xd4, 0xf9, 0xcd, 0xaf, 0xb3, 0xf3, 0x9b, 0x77,
+	0x66, 0xa6, 0xc2, 0xd3, 0x67, 0x37, 0xff, 0xb0, 0xd0, 0x62, 0x84, 0x7d, 0x0e, 0x73, 0x9b, 0x9f,
+	0xa5, 0xc7, 0x36, 0xaf, 0xcf, 0xca, 0xec, 0x29, 0x23, 0x9b, 0x7f, 0xe7, 0x12, 0xe6, 0xfe, 0x7f,
+	0xd3, 0x68, 0xf5, 0x27, 0x58, 0x6e, 0xea, 0x9f, 0x60, 0xeb, 0x68, 0xee, 0x98, 0x89, 0x70, 0x0a,
+	0x1d, 0x21, 0xbe, 0xcf, 0x44, 0x00, 0x8a, 0x22, 0x2b, 0xc8, 0x80, 0x08, 0xf1, 0x13, 0xc6, 0xbb,
+	0xe6, 0x0a, 0xcf, 0xa5, 0x2b, 0x48, 0x3b, 0x45, 0x85, 0x0c, 0x3a, 0xf9, 0x37, 0x5b, 0xe1, 0x73,
+	0xfa, 0x9b, 0x6d, 0x6c, 0x10, 0x3e, 0x7f, 0xe1, 0x41, 0xf8, 0x1f, 0x2c, 0xf3, 0xcf, 0x6f, 0x9c,
+	0x9c, 0xcf, 0x7a, 0x0a, 0x2e, 0xfb, 0x5b, 0xa1, 0x7c, 0x25, 0x1f, 0x9b, 0x26, 0x12, 0x51, 0x3d,
+	0xe8, 0x44, 0x14, 0x48, 0xa0, 0x6a, 0x0f, 0x73, 0xa8, 0x92, 0x6c, 0x2d, 0xf1, 0xcb, 0xa8, 0xa4,
+	0x9b, 0xc9, 0x03, 0xb7, 0x6b, 0x86, 0xe5, 0x15, 0x3d, 0x5a, 0x90, 0x9b, 0xdb, 0x5d, 0x39, 0x5a,
+	0xd0, 0xbf, 0xa2, 0x98, 0xe7, 0xa6, 0xc6, 0x3c, 0xcc, 0x9b, 0xfc, 0xd4, 0xbc, 0xb9, 0x8a, 0x8a,
+	0x61, 0x9c, 0xb3, 0xc3, 0x8c, 0x30, 0x1f, 0x20, 0x42, 0xe0, 0x57, 0x50, 0xd1, 0x63, 0xbd, 0x83,
+	0x23, 0xd7, 0x0b, 0xff, 0x37, 0x8e, 0xbc, 0xb1, 0xc3, 0x7a, 0x5b, 0xae, 0x47, 0x61, 0xc1, 0xd3,
+	0x3f, 0xf0, 0x4d, 0x54, 0x91, 0xd8, 0x01, 0x13, 0x6a, 0x48, 0x61, 0xbe, 0xd3, 0x51, 0x63, 0xb9,
+	0xc3, 0x7a, 0x6d, 0x43, 0x82, 0xb2, 0x17, 0x2f, 0x9a, 0x1b, 0x8f, 0x1e, 0x57, 0x2f, 0x7d, 0xfa,
+	0xb8, 0x7a, 0xe9, 0xb3, 0xc7, 0xd5, 0x4b, 0x3f, 0x3f, 0xaf, 0x5a, 0x8f, 0xce, 0xab, 0xd6, 0xa7,
+	0xe7, 0x55, 0xeb, 0xb3, 0xf3, 0xaa, 0xf5, 0xb7, 0xf3, 0xaa, 0xf5, 0xbb, 0xbf, 0x57, 0x2f, 0xbd,
+	0x99, 0x1b, 0x5d, 0xff, 0xcf, 0x00, 0x77, 0x4c, 0x8f, 0x99, 0x6e, 0x21, 0x00, 0x00,
 }
 
-func (m *MysqlCore) Marshal() (dAtA []byte, err error) {
+func (m *MysqlBackupSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -350,18 +789,18 @@ func (m *MysqlCore) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MysqlCore) MarshalTo(dAtA []byte) (int, error) {
+func (m *MysqlBackupSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MysqlCore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MysqlBackupSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -369,21 +808,36 @@ func (m *MysqlCore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	dAtA[i] = 0x32
+	i -= len(m.RestoreToTime)
+	copy(dAtA[i:], m.RestoreToTime)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RestoreToTime)))
+	i--
+	dAtA[i] = 0x2a
+	if m.RetentionCount != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RetentionCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FullDumpIntervalSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.FullDumpIntervalSeconds))
+		i--
+		dAtA[i] = 0x18
 	}
+	i -= len(m.ArchivePath)
+	copy(dAtA[i:], m.ArchivePath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ArchivePath)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Image)
+	copy(dAtA[i:], m.Image)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Image)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MysqlOperator) Marshal() (dAtA []byte, err error) {
+func (m *MysqlConnectionSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -393,40 +847,39 @@ func (m *MysqlOperator) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MysqlOperator) MarshalTo(dAtA []byte) (int, error) {
+func (m *MysqlConnectionSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MysqlOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MysqlConnectionSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i -= len(m.User)
+	copy(dAtA[i:], m.User)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.User)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MysqlOperatorList) Marshal() (dAtA []byte, err error) {
+func (m *MysqlCore) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -436,32 +889,28 @@ func (m *MysqlOperatorList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MysqlOperatorList) MarshalTo(dAtA []byte) (int, error) {
+func (m *MysqlCore) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MysqlOperatorList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MysqlCore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -473,7 +922,7 @@ func (m *MysqlOperatorList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MysqlOperatorSpec) Marshal() (dAtA []byte, err error) {
+func (m *MysqlDatabase) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -483,18 +932,28 @@ func (m *MysqlOperatorSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MysqlOperatorSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *MysqlDatabase) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MysqlOperatorSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MysqlDatabase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.SlaveSpec.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -504,7 +963,7 @@ func (m *MysqlOperatorSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x12
 	{
-		size, err := m.MasterSpec.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -516,7 +975,7 @@ func (m *MysqlOperatorSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MysqlSpec) Marshal() (dAtA []byte, err error) {
+func (m *MysqlDatabaseList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -526,109 +985,20 @@ func (m *MysqlSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MysqlSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *MysqlDatabaseList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MysqlSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MysqlDatabaseList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tolerations) > 0 {
-		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tolerations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
-		}
-	}
-	if m.Affinity != nil {
-		{
-			size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x7a
-	}
-	i--
-	if m.ServiceWhiteList {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x70
-	{
-		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x6a
-	i -= len(m.Role)
-	copy(dAtA[i:], m.Role)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Role)))
-	i--
-	dAtA[i] = 0x62
-	i -= len(m.VolumePath)
-	copy(dAtA[i:], m.VolumePath)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.VolumePath)))
-	i--
-	dAtA[i] = 0x5a
-	i -= len(m.ServiceType)
-	copy(dAtA[i:], m.ServiceType)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceType)))
-	i--
-	dAtA[i] = 0x52
-	if len(m.ServicePorts) > 0 {
-		for iNdEx := len(m.ServicePorts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ServicePorts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.ContainerPorts) > 0 {
-		for iNdEx := len(m.ContainerPorts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ContainerPorts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if len(m.VolumeMounts) > 0 {
-		for iNdEx := len(m.VolumeMounts) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.VolumeMounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -636,11 +1006,11 @@ func (m *MysqlSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x12
 		}
 	}
 	{
-		size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -648,54 +1018,11 @@ func (m *MysqlSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
-	if len(m.Env) > 0 {
-		for iNdEx := len(m.Env) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Env[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.ImagePullSecrets) > 0 {
-		for iNdEx := len(m.ImagePullSecrets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ImagePullSecrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	i -= len(m.Image)
-	copy(dAtA[i:], m.Image)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Image)))
-	i--
-	dAtA[i] = 0x1a
-	if m.Replicas != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Replicas))
-		i--
-		dAtA[i] = 0x10
-	}
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MysqlStatus) Marshal() (dAtA []byte, err error) {
+func (m *MysqlDatabaseSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -705,50 +1032,48 @@ func (m *MysqlStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MysqlStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *MysqlDatabaseSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MysqlStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MysqlDatabaseSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CollisionCount != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.CollisionCount))
-		i--
-		dAtA[i] = 0x48
-	}
-	i -= len(m.UpdateRevision)
-	copy(dAtA[i:], m.UpdateRevision)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.UpdateRevision)))
-	i--
-	dAtA[i] = 0x3a
-	i -= len(m.CurrentRevision)
-	copy(dAtA[i:], m.CurrentRevision)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CurrentRevision)))
 	i--
-	dAtA[i] = 0x32
-	i = encodeVarintGenerated(dAtA, i, uint64(m.UpdatedReplicas))
+	if m.DropOnDelete {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
 	i--
 	dAtA[i] = 0x28
-	i = encodeVarintGenerated(dAtA, i, uint64(m.CurrentReplicas))
+	i -= len(m.Collation)
+	copy(dAtA[i:], m.Collation)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Collation)))
 	i--
-	dAtA[i] = 0x20
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ReadyReplicas))
+	dAtA[i] = 0x22
+	i -= len(m.CharacterSet)
+	copy(dAtA[i:], m.CharacterSet)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CharacterSet)))
 	i--
-	dAtA[i] = 0x18
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Replicas))
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
-	dAtA[i] = 0x10
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	dAtA[i] = 0x12
+	i -= len(m.Operator)
+	copy(dAtA[i:], m.Operator)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operator)))
 	i--
-	dAtA[i] = 0x8
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ServerConfig) Marshal() (dAtA []byte, err error) {
+func (m *MysqlDatabaseStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -758,377 +1083,4474 @@ func (m *ServerConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ServerConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *MysqlDatabaseStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ServerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MysqlDatabaseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.LogPosition)
-	copy(dAtA[i:], m.LogPosition)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LogPosition)))
-	i--
-	dAtA[i] = 0x32
-	i -= len(m.LogFile)
-	copy(dAtA[i:], m.LogFile)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LogFile)))
-	i--
-	dAtA[i] = 0x2a
-	i -= len(m.Password)
-	copy(dAtA[i:], m.Password)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Password)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.User)
-	copy(dAtA[i:], m.User)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.User)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Host)
-	copy(dAtA[i:], m.Host)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Host)))
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
 	i--
 	dAtA[i] = 0x12
-	if m.ServerId != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.ServerId))
-		i--
-		dAtA[i] = 0x8
-	}
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MysqlFailoverSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MysqlCore) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+
+func (m *MysqlFailoverSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MysqlOperator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MysqlFailoverSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	if m.GracePeriodSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.GracePeriodSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MysqlOperatorList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MysqlGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MysqlGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MysqlGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Privileges) > 0 {
+		for iNdEx := len(m.Privileges) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Privileges[iNdEx])
+			copy(dAtA[i:], m.Privileges[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Privileges[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	i -= len(m.Table)
+	copy(dAtA[i:], m.Table)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Table)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Database)
+	copy(dAtA[i:], m.Database)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Database)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MysqlOperatorSpec) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MysqlOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.MasterSpec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.SlaveSpec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MysqlSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MysqlOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MysqlOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Replicas != nil {
-		n += 1 + sovGenerated(uint64(*m.Replicas))
-	}
-	l = len(m.Image)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.ImagePullSecrets) > 0 {
-		for _, e := range m.ImagePullSecrets {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if len(m.Env) > 0 {
-		for _, e := range m.Env {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	l = m.Resources.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.VolumeMounts) > 0 {
-		for _, e := range m.VolumeMounts {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if len(m.ContainerPorts) > 0 {
-		for _, e := range m.ContainerPorts {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MysqlOperatorList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ServicePorts) > 0 {
-		for _, e := range m.ServicePorts {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MysqlOperatorList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MysqlOperatorList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.ServiceType)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.VolumePath)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Role)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Config.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	if m.Affinity != nil {
-		l = m.Affinity.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.Tolerations) > 0 {
-		for _, e := range m.Tolerations {
-			l = e.Size()
-			n += 2 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MysqlStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MysqlOperatorSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
-	n += 1 + sovGenerated(uint64(m.Replicas))
-	n += 1 + sovGenerated(uint64(m.ReadyReplicas))
-	n += 1 + sovGenerated(uint64(m.CurrentReplicas))
-	n += 1 + sovGenerated(uint64(m.UpdatedReplicas))
-	l = len(m.CurrentRevision)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.UpdateRevision)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.CollisionCount != nil {
-		n += 1 + sovGenerated(uint64(*m.CollisionCount))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ServerConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MysqlOperatorSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MysqlOperatorSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ServerId != nil {
-		n += 1 + sovGenerated(uint64(*m.ServerId))
+	if m.Connection != nil {
+		{
+			size, err := m.Connection.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Host)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.User)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Password)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.LogFile)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.LogPosition)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	if m.Failover != nil {
+		{
+			size, err := m.Failover.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Backup != nil {
+		{
+			size, err := m.Backup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.SlaveSpec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MasterSpec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovGenerated(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenerated(x uint64) (n int) {
-	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *MysqlCore) String() string {
-	if this == nil {
-		return "nil"
+func (m *MysqlOperatorStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&MysqlCore{`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MysqlSpec", "MysqlSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "MysqlStatus", "MysqlStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *MysqlOperator) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&MysqlOperator{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MysqlOperatorSpec", "MysqlOperatorSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *MysqlOperatorStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *MysqlOperatorList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MysqlOperatorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Slave != nil {
+		{
+			size, err := m.Slave.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	repeatedStringForItems := "[]MysqlOperator{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "MysqlOperator", "MysqlOperator", 1), `&`, ``, 1) + ","
+	if m.Master != nil {
+		{
+			size, err := m.Master.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&MysqlOperatorList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i -= len(m.Selector)
+	copy(dAtA[i:], m.Selector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Selector)))
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ReadyReplicas))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Replicas))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *MysqlOperatorSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MysqlSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&MysqlOperatorSpec{`,
-		`MasterSpec:` + strings.Replace(strings.Replace(this.MasterSpec.String(), "MysqlCore", "MysqlCore", 1), `&`, ``, 1) + `,`,
-		`SlaveSpec:` + strings.Replace(strings.Replace(this.SlaveSpec.String(), "MysqlCore", "MysqlCore", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *MysqlSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MysqlSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MysqlSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ImagePullPolicy)
+	copy(dAtA[i:], m.ImagePullPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ImagePullPolicy)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if len(m.MyCnf) > 0 {
+		keysForMyCnf := make([]string, 0, len(m.MyCnf))
+		for k := range m.MyCnf {
+			keysForMyCnf = append(keysForMyCnf, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMyCnf)
+		for iNdEx := len(keysForMyCnf) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MyCnf[string(keysForMyCnf[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMyCnf[iNdEx])
+			copy(dAtA[i:], keysForMyCnf[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMyCnf[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
 	}
-	repeatedStringForImagePullSecrets := "[]LocalObjectReference{"
-	for _, f := range this.ImagePullSecrets {
-		repeatedStringForImagePullSecrets += fmt.Sprintf("%v", f) + ","
+	if len(m.Tolerations) > 0 {
+		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tolerations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	repeatedStringForImagePullSecrets += "}"
-	repeatedStringForEnv := "[]EnvVar{"
-	for _, f := range this.Env {
-		repeatedStringForEnv += fmt.Sprintf("%v", f) + ","
+	if m.Affinity != nil {
+		{
+			size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	repeatedStringForEnv += "}"
-	repeatedStringForVolumeMounts := "[]VolumeMount{"
-	for _, f := range this.VolumeMounts {
-		repeatedStringForVolumeMounts += fmt.Sprintf("%v", f) + ","
+	i--
+	if m.ServiceWhiteList {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	repeatedStringForVolumeMounts += "}"
-	repeatedStringForContainerPorts := "[]ContainerPort{"
-	for _, f := range this.ContainerPorts {
-		repeatedStringForContainerPorts += fmt.Sprintf("%v", f) + ","
+	i--
+	dAtA[i] = 0x70
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	repeatedStringForContainerPorts += "}"
-	repeatedStringForServicePorts := "[]ServicePort{"
-	for _, f := range this.ServicePorts {
-		repeatedStringForServicePorts += fmt.Sprintf("%v", f) + ","
+	i--
+	dAtA[i] = 0x6a
+	i -= len(m.Role)
+	copy(dAtA[i:], m.Role)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Role)))
+	i--
+	dAtA[i] = 0x62
+	i -= len(m.VolumePath)
+	copy(dAtA[i:], m.VolumePath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.VolumePath)))
+	i--
+	dAtA[i] = 0x5a
+	i -= len(m.ServiceType)
+	copy(dAtA[i:], m.ServiceType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceType)))
+	i--
+	dAtA[i] = 0x52
+	if len(m.ServicePorts) > 0 {
+		for iNdEx := len(m.ServicePorts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ServicePorts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	repeatedStringForServicePorts += "}"
-	repeatedStringForTolerations := "[]Toleration{"
-	for _, f := range this.Tolerations {
-		repeatedStringForTolerations += fmt.Sprintf("%v", f) + ","
+	if len(m.ContainerPorts) > 0 {
+		for iNdEx := len(m.ContainerPorts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContainerPorts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	repeatedStringForTolerations += "}"
-	s := strings.Join([]string{`&MysqlSpec{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
-		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
-		`ImagePullSecrets:` + repeatedStringForImagePullSecrets + `,`,
-		`Env:` + repeatedStringForEnv + `,`,
-		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceRequirements", "v11.ResourceRequirements", 1), `&`, ``, 1) + `,`,
-		`VolumeMounts:` + repeatedStringForVolumeMounts + `,`,
-		`ContainerPorts:` + repeatedStringForContainerPorts + `,`,
-		`ServicePorts:` + repeatedStringForServicePorts + `,`,
-		`ServiceType:` + fmt.Sprintf("%v", this.ServiceType) + `,`,
-		`VolumePath:` + fmt.Sprintf("%v", this.VolumePath) + `,`,
-		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
-		`Config:` + strings.Replace(strings.Replace(this.Config.String(), "ServerConfig", "ServerConfig", 1), `&`, ``, 1) + `,`,
-		`ServiceWhiteList:` + fmt.Sprintf("%v", this.ServiceWhiteList) + `,`,
-		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v11.Affinity", 1) + `,`,
-		`Tolerations:` + repeatedStringForTolerations + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.VolumeMounts) > 0 {
+		for iNdEx := len(m.VolumeMounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VolumeMounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Env) > 0 {
+		for iNdEx := len(m.Env) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Env[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ImagePullSecrets) > 0 {
+		for iNdEx := len(m.ImagePullSecrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ImagePullSecrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.Image)
+	copy(dAtA[i:], m.Image)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Image)))
+	i--
+	dAtA[i] = 0x1a
+	if m.Replicas != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Replicas))
+		i--
+		dAtA[i] = 0x10
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *MysqlStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MysqlStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&MysqlStatus{`,
-		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
-		`Replicas:` + fmt.Sprintf("%v", this.Replicas) + `,`,
-		`ReadyReplicas:` + fmt.Sprintf("%v", this.ReadyReplicas) + `,`,
-		`CurrentReplicas:` + fmt.Sprintf("%v", this.CurrentReplicas) + `,`,
-		`UpdatedReplicas:` + fmt.Sprintf("%v", this.UpdatedReplicas) + `,`,
-		`CurrentRevision:` + fmt.Sprintf("%v", this.CurrentRevision) + `,`,
-		`UpdateRevision:` + fmt.Sprintf("%v", this.UpdateRevision) + `,`,
-		`CollisionCount:` + valueToStringGenerated(this.CollisionCount) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ServerConfig) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MysqlStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MysqlStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MasterLostSince != nil {
+		{
+			size, err := m.MasterLostSince.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	i--
+	if m.Paused {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x70
+	i -= len(m.ConnectionSecret)
+	copy(dAtA[i:], m.ConnectionSecret)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConnectionSecret)))
+	i--
+	dAtA[i] = 0x6a
+	i -= len(m.CurrentMaster)
+	copy(dAtA[i:], m.CurrentMaster)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CurrentMaster)))
+	i--
+	dAtA[i] = 0x62
+	i -= len(m.RestoredToTime)
+	copy(dAtA[i:], m.RestoredToTime)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RestoredToTime)))
+	i--
+	dAtA[i] = 0x5a
+	i -= len(m.RestorePhase)
+	copy(dAtA[i:], m.RestorePhase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RestorePhase)))
+	i--
+	dAtA[i] = 0x52
+	if m.CollisionCount != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.CollisionCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	i -= len(m.UpdateRevision)
+	copy(dAtA[i:], m.UpdateRevision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.UpdateRevision)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.CurrentRevision)
+	copy(dAtA[i:], m.CurrentRevision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CurrentRevision)))
+	i--
+	dAtA[i] = 0x32
+	i = encodeVarintGenerated(dAtA, i, uint64(m.UpdatedReplicas))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintGenerated(dAtA, i, uint64(m.CurrentReplicas))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ReadyReplicas))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Replicas))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *MysqlUser) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MysqlUser) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MysqlUser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MysqlUserList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MysqlUserList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MysqlUserList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MysqlUserSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MysqlUserSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MysqlUserSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.DropOnDelete {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i -= len(m.PasswordSecret)
+	copy(dAtA[i:], m.PasswordSecret)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PasswordSecret)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Host)
+	copy(dAtA[i:], m.Host)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Host)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.User)
+	copy(dAtA[i:], m.User)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.User)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Operator)
+	copy(dAtA[i:], m.Operator)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operator)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MysqlUserStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MysqlUserStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MysqlUserStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.SecretName)
+	copy(dAtA[i:], m.SecretName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SecretName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ServerConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServerConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.LogPosition)
+	copy(dAtA[i:], m.LogPosition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LogPosition)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.LogFile)
+	copy(dAtA[i:], m.LogFile)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LogFile)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Password)
+	copy(dAtA[i:], m.Password)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Password)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.User)
+	copy(dAtA[i:], m.User)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.User)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Host)
+	copy(dAtA[i:], m.Host)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Host)))
+	i--
+	dAtA[i] = 0x12
+	if m.ServerId != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ServerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MysqlBackupSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Image)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ArchivePath)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.FullDumpIntervalSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.FullDumpIntervalSeconds))
+	}
+	if m.RetentionCount != nil {
+		n += 1 + sovGenerated(uint64(*m.RetentionCount))
+	}
+	l = len(m.RestoreToTime)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Resources.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MysqlConnectionSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MysqlCore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MysqlDatabase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MysqlDatabaseList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MysqlDatabaseSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CharacterSet)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Collation)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *MysqlDatabaseStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MysqlFailoverSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GracePeriodSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.GracePeriodSeconds))
+	}
+	return n
+}
+
+func (m *MysqlGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Database)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Table)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Privileges) > 0 {
+		for _, s := range m.Privileges {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MysqlOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MysqlOperatorList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MysqlOperatorSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MasterSpec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.SlaveSpec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Backup != nil {
+		l = m.Backup.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Failover != nil {
+		l = m.Failover.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Connection != nil {
+		l = m.Connection.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *MysqlOperatorStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Replicas))
+	n += 1 + sovGenerated(uint64(m.ReadyReplicas))
+	l = len(m.Selector)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Master != nil {
+		l = m.Master.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Slave != nil {
+		l = m.Slave.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *MysqlSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Replicas != nil {
+		n += 1 + sovGenerated(uint64(*m.Replicas))
+	}
+	l = len(m.Image)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ImagePullSecrets) > 0 {
+		for _, e := range m.ImagePullSecrets {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Env) > 0 {
+		for _, e := range m.Env {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Resources.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.VolumeMounts) > 0 {
+		for _, e := range m.VolumeMounts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ContainerPorts) > 0 {
+		for _, e := range m.ContainerPorts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ServicePorts) > 0 {
+		for _, e := range m.ServicePorts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.ServiceType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.VolumePath)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Role)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Config.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.Affinity != nil {
+		l = m.Affinity.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Tolerations) > 0 {
+		for _, e := range m.Tolerations {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.MyCnf) > 0 {
+		for k, v := range m.MyCnf {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.ImagePullPolicy)
+	n += 2 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MysqlStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	n += 1 + sovGenerated(uint64(m.Replicas))
+	n += 1 + sovGenerated(uint64(m.ReadyReplicas))
+	n += 1 + sovGenerated(uint64(m.CurrentReplicas))
+	n += 1 + sovGenerated(uint64(m.UpdatedReplicas))
+	l = len(m.CurrentRevision)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.UpdateRevision)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CollisionCount != nil {
+		n += 1 + sovGenerated(uint64(*m.CollisionCount))
+	}
+	l = len(m.RestorePhase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RestoredToTime)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CurrentMaster)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ConnectionSecret)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.MasterLostSince != nil {
+		l = m.MasterLostSince.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *MysqlUser) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MysqlUserList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MysqlUserSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.User)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Host)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PasswordSecret)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	return n
+}
+
+func (m *MysqlUserStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SecretName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ServerConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServerId != nil {
+		n += 1 + sovGenerated(uint64(*m.ServerId))
+	}
+	l = len(m.Host)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.User)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Password)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.LogFile)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.LogPosition)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func sovGenerated(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenerated(x uint64) (n int) {
+	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *MysqlBackupSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MysqlBackupSpec{`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`ArchivePath:` + fmt.Sprintf("%v", this.ArchivePath) + `,`,
+		`FullDumpIntervalSeconds:` + valueToStringGenerated(this.FullDumpIntervalSeconds) + `,`,
+		`RetentionCount:` + valueToStringGenerated(this.RetentionCount) + `,`,
+		`RestoreToTime:` + fmt.Sprintf("%v", this.RestoreToTime) + `,`,
+		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceRequirements", "v1.ResourceRequirements", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MysqlConnectionSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForGrants := "[]MysqlGrant{"
+	for _, f := range this.Grants {
+		repeatedStringForGrants += strings.Replace(strings.Replace(f.String(), "MysqlGrant", "MysqlGrant", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForGrants += "}"
+	s := strings.Join([]string{`&MysqlConnectionSpec{`,
+		`User:` + fmt.Sprintf("%v", this.User) + `,`,
+		`Grants:` + repeatedStringForGrants + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MysqlCore) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MysqlCore{`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MysqlSpec", "MysqlSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "MysqlStatus", "MysqlStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MysqlDatabase) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MysqlDatabase{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MysqlDatabaseSpec", "MysqlDatabaseSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "MysqlDatabaseStatus", "MysqlDatabaseStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MysqlDatabaseList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]MysqlDatabase{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "MysqlDatabase", "MysqlDatabase", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&MysqlDatabaseList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MysqlDatabaseSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MysqlDatabaseSpec{`,
+		`Operator:` + fmt.Sprintf("%v", this.Operator) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`CharacterSet:` + fmt.Sprintf("%v", this.CharacterSet) + `,`,
+		`Collation:` + fmt.Sprintf("%v", this.Collation) + `,`,
+		`DropOnDelete:` + fmt.Sprintf("%v", this.DropOnDelete) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MysqlDatabaseStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MysqlDatabaseStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MysqlFailoverSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MysqlFailoverSpec{`,
+		`GracePeriodSeconds:` + valueToStringGenerated(this.GracePeriodSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MysqlGrant) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MysqlGrant{`,
+		`Database:` + fmt.Sprintf("%v", this.Database) + `,`,
+		`Table:` + fmt.Sprintf("%v", this.Table) + `,`,
+		`Privileges:` + fmt.Sprintf("%v", this.Privileges) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MysqlOperator) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MysqlOperator{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MysqlOperatorSpec", "MysqlOperatorSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "MysqlOperatorStatus", "MysqlOperatorStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MysqlOperatorList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]MysqlOperator{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "MysqlOperator", "MysqlOperator", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&MysqlOperatorList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MysqlOperatorSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MysqlOperatorSpec{`,
+		`MasterSpec:` + strings.Replace(strings.Replace(this.MasterSpec.String(), "MysqlCore", "MysqlCore", 1), `&`, ``, 1) + `,`,
+		`SlaveSpec:` + strings.Replace(strings.Replace(this.SlaveSpec.String(), "MysqlCore", "MysqlCore", 1), `&`, ``, 1) + `,`,
+		`Backup:` + strings.Replace(this.Backup.String(), "MysqlBackupSpec", "MysqlBackupSpec", 1) + `,`,
+		`Failover:` + strings.Replace(this.Failover.String(), "MysqlFailoverSpec", "MysqlFailoverSpec", 1) + `,`,
+		`Connection:` + strings.Replace(this.Connection.String(), "MysqlConnectionSpec", "MysqlConnectionSpec", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MysqlOperatorStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForConditions += "}"
+	s := strings.Join([]string{`&MysqlOperatorStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Replicas:` + fmt.Sprintf("%v", this.Replicas) + `,`,
+		`ReadyReplicas:` + fmt.Sprintf("%v", this.ReadyReplicas) + `,`,
+		`Selector:` + fmt.Sprintf("%v", this.Selector) + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`Master:` + strings.Replace(this.Master.String(), "MysqlStatus", "MysqlStatus", 1) + `,`,
+		`Slave:` + strings.Replace(this.Slave.String(), "MysqlStatus", "MysqlStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MysqlSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForImagePullSecrets := "[]LocalObjectReference{"
+	for _, f := range this.ImagePullSecrets {
+		repeatedStringForImagePullSecrets += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForImagePullSecrets += "}"
+	repeatedStringForEnv := "[]EnvVar{"
+	for _, f := range this.Env {
+		repeatedStringForEnv += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForEnv += "}"
+	repeatedStringForVolumeMounts := "[]VolumeMount{"
+	for _, f := range this.VolumeMounts {
+		repeatedStringForVolumeMounts += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForVolumeMounts += "}"
+	repeatedStringForContainerPorts := "[]ContainerPort{"
+	for _, f := range this.ContainerPorts {
+		repeatedStringForContainerPorts += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForContainerPorts += "}"
+	repeatedStringForServicePorts := "[]ServicePort{"
+	for _, f := range this.ServicePorts {
+		repeatedStringForServicePorts += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForServicePorts += "}"
+	repeatedStringForTolerations := "[]Toleration{"
+	for _, f := range this.Tolerations {
+		repeatedStringForTolerations += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForTolerations += "}"
+	keysForMyCnf := make([]string, 0, len(this.MyCnf))
+	for k := range this.MyCnf {
+		keysForMyCnf = append(keysForMyCnf, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMyCnf)
+	mapStringForMyCnf := "map[string]string{"
+	for _, k := range keysForMyCnf {
+		mapStringForMyCnf += fmt.Sprintf("%v: %v,", k, this.MyCnf[k])
+	}
+	mapStringForMyCnf += "}"
+	s := strings.Join([]string{`&MysqlSpec{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`ImagePullSecrets:` + repeatedStringForImagePullSecrets + `,`,
+		`Env:` + repeatedStringForEnv + `,`,
+		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceRequirements", "v1.ResourceRequirements", 1), `&`, ``, 1) + `,`,
+		`VolumeMounts:` + repeatedStringForVolumeMounts + `,`,
+		`ContainerPorts:` + repeatedStringForContainerPorts + `,`,
+		`ServicePorts:` + repeatedStringForServicePorts + `,`,
+		`ServiceType:` + fmt.Sprintf("%v", this.ServiceType) + `,`,
+		`VolumePath:` + fmt.Sprintf("%v", this.VolumePath) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`Config:` + strings.Replace(strings.Replace(this.Config.String(), "ServerConfig", "ServerConfig", 1), `&`, ``, 1) + `,`,
+		`ServiceWhiteList:` + fmt.Sprintf("%v", this.ServiceWhiteList) + `,`,
+		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
+		`Tolerations:` + repeatedStringForTolerations + `,`,
+		`MyCnf:` + mapStringForMyCnf + `,`,
+		`ImagePullPolicy:` + fmt.Sprintf("%v", this.ImagePullPolicy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MysqlStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MysqlStatus{`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`Replicas:` + fmt.Sprintf("%v", this.Replicas) + `,`,
+		`ReadyReplicas:` + fmt.Sprintf("%v", this.ReadyReplicas) + `,`,
+		`CurrentReplicas:` + fmt.Sprintf("%v", this.CurrentReplicas) + `,`,
+		`UpdatedReplicas:` + fmt.Sprintf("%v", this.UpdatedReplicas) + `,`,
+		`CurrentRevision:` + fmt.Sprintf("%v", this.CurrentRevision) + `,`,
+		`UpdateRevision:` + fmt.Sprintf("%v", this.UpdateRevision) + `,`,
+		`CollisionCount:` + valueToStringGenerated(this.CollisionCount) + `,`,
+		`RestorePhase:` + fmt.Sprintf("%v", this.RestorePhase) + `,`,
+		`RestoredToTime:` + fmt.Sprintf("%v", this.RestoredToTime) + `,`,
+		`CurrentMaster:` + fmt.Sprintf("%v", this.CurrentMaster) + `,`,
+		`ConnectionSecret:` + fmt.Sprintf("%v", this.ConnectionSecret) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
+		`MasterLostSince:` + strings.Replace(fmt.Sprintf("%v", this.MasterLostSince), "Time", "v11.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MysqlUser) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MysqlUser{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MysqlUserSpec", "MysqlUserSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "MysqlUserStatus", "MysqlUserStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MysqlUserList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]MysqlUser{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "MysqlUser", "MysqlUser", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&MysqlUserList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MysqlUserSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForGrants := "[]MysqlGrant{"
+	for _, f := range this.Grants {
+		repeatedStringForGrants += strings.Replace(strings.Replace(f.String(), "MysqlGrant", "MysqlGrant", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForGrants += "}"
+	s := strings.Join([]string{`&MysqlUserSpec{`,
+		`Operator:` + fmt.Sprintf("%v", this.Operator) + `,`,
+		`User:` + fmt.Sprintf("%v", this.User) + `,`,
+		`Host:` + fmt.Sprintf("%v", this.Host) + `,`,
+		`PasswordSecret:` + fmt.Sprintf("%v", this.PasswordSecret) + `,`,
+		`Grants:` + repeatedStringForGrants + `,`,
+		`DropOnDelete:` + fmt.Sprintf("%v", this.DropOnDelete) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MysqlUserStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MysqlUserStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`SecretName:` + fmt.Sprintf("%v", this.SecretName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServerConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServerConfig{`,
+		`ServerId:` + valueToStringGenerated(this.ServerId) + `,`,
+		`Host:` + fmt.Sprintf("%v", this.Host) + `,`,
+		`User:` + fmt.Sprintf("%v", this.User) + `,`,
+		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
+		`LogFile:` + fmt.Sprintf("%v", this.LogFile) + `,`,
+		`LogPosition:` + fmt.Sprintf("%v", this.LogPosition) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *MysqlBackupSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MysqlBackupSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MysqlBackupSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullDumpIntervalSeconds", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FullDumpIntervalSeconds = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionCount", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RetentionCount = &v
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoreToTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestoreToTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MysqlConnectionSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MysqlConnectionSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MysqlConnectionSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, MysqlGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MysqlCore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MysqlCore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MysqlCore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MysqlDatabase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MysqlDatabase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MysqlDatabase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MysqlDatabaseList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MysqlDatabaseList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MysqlDatabaseList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, MysqlDatabase{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MysqlDatabaseSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MysqlDatabaseSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MysqlDatabaseSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CharacterSet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CharacterSet = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropOnDelete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DropOnDelete = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MysqlDatabaseStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MysqlDatabaseStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MysqlDatabaseStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MysqlFailoverSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MysqlFailoverSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MysqlFailoverSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriodSeconds", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GracePeriodSeconds = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MysqlGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MysqlGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MysqlGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Privileges", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Privileges = append(m.Privileges, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MysqlOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MysqlOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MysqlOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MysqlOperatorList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MysqlOperatorList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MysqlOperatorList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, MysqlOperator{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MysqlOperatorSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MysqlOperatorSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MysqlOperatorSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MasterSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlaveSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlaveSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Backup == nil {
+				m.Backup = &MysqlBackupSpec{}
+			}
+			if err := m.Backup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failover", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Failover == nil {
+				m.Failover = &MysqlFailoverSpec{}
+			}
+			if err := m.Failover.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Connection == nil {
+				m.Connection = &MysqlConnectionSpec{}
+			}
+			if err := m.Connection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MysqlOperatorStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MysqlOperatorStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MysqlOperatorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyReplicas", wireType)
+			}
+			m.ReadyReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, v11.Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Master == nil {
+				m.Master = &MysqlStatus{}
+			}
+			if err := m.Master.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slave", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Slave == nil {
+				m.Slave = &MysqlStatus{}
+			}
+			if err := m.Slave.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MysqlSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MysqlSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MysqlSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImagePullSecrets = append(m.ImagePullSecrets, k8s_io_api_core_v1.LocalObjectReference{})
+			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, k8s_io_api_core_v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeMounts = append(m.VolumeMounts, k8s_io_api_core_v1.VolumeMount{})
+			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerPorts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerPorts = append(m.ContainerPorts, k8s_io_api_core_v1.ContainerPort{})
+			if err := m.ContainerPorts[len(m.ContainerPorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServicePorts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServicePorts = append(m.ServicePorts, k8s_io_api_core_v1.ServicePort{})
+			if err := m.ServicePorts[len(m.ServicePorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceType = k8s_io_api_core_v1.ServiceType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceWhiteList", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ServiceWhiteList = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Affinity == nil {
+				m.Affinity = &v1.Affinity{}
+			}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MyCnf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MyCnf == nil {
+				m.MyCnf = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MyCnf[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImagePullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&ServerConfig{`,
-		`ServerId:` + valueToStringGenerated(this.ServerId) + `,`,
-		`Host:` + fmt.Sprintf("%v", this.Host) + `,`,
-		`User:` + fmt.Sprintf("%v", this.User) + `,`,
-		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
-		`LogFile:` + fmt.Sprintf("%v", this.LogFile) + `,`,
-		`LogPosition:` + fmt.Sprintf("%v", this.LogPosition) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *MysqlCore) Unmarshal(dAtA []byte) error {
+func (m *MysqlStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1151,17 +5573,112 @@ func (m *MysqlCore) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MysqlCore: wiretype end group for non-group")
+			return fmt.Errorf("proto: MysqlStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MysqlCore: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MysqlStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyReplicas", wireType)
+			}
+			m.ReadyReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReplicas", wireType)
+			}
+			m.CurrentReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedReplicas", wireType)
+			}
+			m.UpdatedReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRevision", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1171,30 +5688,29 @@ func (m *MysqlCore) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CurrentRevision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateRevision", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1204,83 +5720,29 @@ func (m *MysqlCore) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UpdateRevision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MysqlOperator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MysqlOperator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MysqlOperator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollisionCount", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1290,30 +5752,17 @@ func (m *MysqlOperator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			m.CollisionCount = &v
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RestorePhase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1323,83 +5772,29 @@ func (m *MysqlOperator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RestorePhase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MysqlOperatorList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MysqlOperatorList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MysqlOperatorList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoredToTime", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1409,30 +5804,29 @@ func (m *MysqlOperatorList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RestoredToTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentMaster", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1442,84 +5836,29 @@ func (m *MysqlOperatorList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Items = append(m.Items, MysqlOperator{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MysqlOperatorSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MysqlOperatorSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MysqlOperatorSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.CurrentMaster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionSecret", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1529,28 +5868,47 @@ func (m *MysqlOperatorSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MasterSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConnectionSecret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlaveSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterLostSince", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1577,7 +5935,10 @@ func (m *MysqlOperatorSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlaveSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MasterLostSince == nil {
+				m.MasterLostSince = &v11.Time{}
+			}
+			if err := m.MasterLostSince.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1605,7 +5966,7 @@ func (m *MysqlOperatorSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MysqlSpec) Unmarshal(dAtA []byte) error {
+func (m *MysqlUser) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1628,17 +5989,17 @@ func (m *MysqlSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MysqlSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MysqlUser: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MysqlSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MysqlUser: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1648,79 +6009,28 @@ func (m *MysqlSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Replicas = &v
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1747,14 +6057,13 @@ func (m *MysqlSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImagePullSecrets = append(m.ImagePullSecrets, k8s_io_api_core_v1.LocalObjectReference{})
-			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1781,47 +6090,66 @@ func (m *MysqlSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, k8s_io_api_core_v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MysqlUserList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 7:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MysqlUserList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MysqlUserList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1848,14 +6176,13 @@ func (m *MysqlSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumeMounts = append(m.VolumeMounts, k8s_io_api_core_v1.VolumeMount{})
-			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerPorts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1882,48 +6209,67 @@ func (m *MysqlSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerPorts = append(m.ContainerPorts, k8s_io_api_core_v1.ContainerPort{})
-			if err := m.ContainerPorts[len(m.ContainerPorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, MysqlUser{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServicePorts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MysqlUserSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServicePorts = append(m.ServicePorts, k8s_io_api_core_v1.ServicePort{})
-			if err := m.ServicePorts[len(m.ServicePorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 10:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MysqlUserSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MysqlUserSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1951,11 +6297,11 @@ func (m *MysqlSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceType = k8s_io_api_core_v1.ServiceType(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumePath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1983,11 +6329,11 @@ func (m *MysqlSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumePath = string(dAtA[iNdEx:postIndex])
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2015,13 +6361,13 @@ func (m *MysqlSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2031,48 +6377,27 @@ func (m *MysqlSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PasswordSecret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceWhiteList", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ServiceWhiteList = bool(v != 0)
-		case 15:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2099,18 +6424,16 @@ func (m *MysqlSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Affinity == nil {
-				m.Affinity = &v11.Affinity{}
-			}
-			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Grants = append(m.Grants, MysqlGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropOnDelete", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2120,26 +6443,12 @@ func (m *MysqlSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tolerations = append(m.Tolerations, v11.Toleration{})
-			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.DropOnDelete = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -2164,7 +6473,7 @@ func (m *MysqlSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MysqlStatus) Unmarshal(dAtA []byte) error {
+func (m *MysqlUserStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2187,55 +6496,17 @@ func (m *MysqlStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MysqlStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MysqlUserStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MysqlStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MysqlUserStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
-			}
-			m.ObservedGeneration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
-			}
-			m.Replicas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Replicas |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadyReplicas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			m.ReadyReplicas = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2245,52 +6516,27 @@ func (m *MysqlStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadyReplicas |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReplicas", wireType)
-			}
-			m.CurrentReplicas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentReplicas |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedReplicas", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.UpdatedReplicas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpdatedReplicas |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRevision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2318,11 +6564,11 @@ func (m *MysqlStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentRevision = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateRevision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2350,28 +6596,8 @@ func (m *MysqlStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateRevision = string(dAtA[iNdEx:postIndex])
+			m.SecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollisionCount", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.CollisionCount = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -2632,6 +6858,7 @@ func (m *ServerConfig) Unmarshal(dAtA []byte) error {
 func skipGenerated(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -2663,10 +6890,8 @@ func skipGenerated(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -2687,55 +6912,30 @@ func skipGenerated(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthGenerated
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthGenerated
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipGenerated(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthGenerated
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenerated
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenerated
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthGenerated = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowGenerated   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthGenerated        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenerated          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenerated = fmt.Errorf("proto: unexpected end of group")
 )
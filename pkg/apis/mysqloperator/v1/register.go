@@ -42,6 +42,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		SchemeGroupVersion,
 		&MysqlOperator{},
 		&MysqlOperatorList{},
+		&MysqlDatabase{},
+		&MysqlDatabaseList{},
+		&MysqlUser{},
+		&MysqlUserList{},
 	)
 
 	// register the type in the scheme
@@ -10,7 +10,6 @@ import (
 )
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Mysql describes a MysqlOperator resource
@@ -28,12 +27,93 @@ type MysqlOperator struct {
 
 	// Spec is the custom resource spec
 	Spec MysqlOperatorSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status is the overall status which was served by the status and the scale subresources
+	// +optional
+	Status MysqlOperatorStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// MysqlOperatorStatus is the overall status for a MysqlOperator resource
+type MysqlOperatorStatus struct {
+	// phase is Pending until the StatefulSets were rolled out, Ready afterwards, or Paused.
+	// +optional
+	Phase string `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase"`
+	// replicas is the number of the slaves, which was scaled by the scale subresource.
+	Replicas int32 `json:"replicas" protobuf:"varint,2,opt,name=replicas"`
+	// readyReplicas is the number of the ready pods of the master and the slaves.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty" protobuf:"varint,3,opt,name=readyReplicas"`
+	// selector is the label selector of the pods of the slaves, which was required by the scale subresource.
+	// +optional
+	Selector string `json:"selector,omitempty" protobuf:"bytes,4,opt,name=selector"`
+
+	// conditions are the conditions of the v2 API, which were kept here so that they survive the status subresource.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" protobuf:"bytes,5,rep,name=conditions"`
+	// master is the status of the master in spec.masterSpec.status, which was mirrored for the status subresource.
+	// +optional
+	Master *MysqlStatus `json:"master,omitempty" protobuf:"bytes,6,opt,name=master"`
+	// slave is the status of the slaves in spec.slaveSpec.status, which was mirrored for the status subresource.
+	// +optional
+	Slave *MysqlStatus `json:"slave,omitempty" protobuf:"bytes,7,opt,name=slave"`
 }
 
 // MysqlSpec is the spec for a MysqlOperator resource
 type MysqlOperatorSpec struct {
 	MasterSpec MysqlCore `json:"masterSpec" protobuf:"bytes,1,rep,name=masterSpec"`
 	SlaveSpec  MysqlCore `json:"slaveSpec" protobuf:"bytes,2,rep,name=slaveSpec"`
+	// Backup enables the binlog archiving and the periodic full dumps of the master.
+	// +optional
+	Backup *MysqlBackupSpec `json:"backup,omitempty" protobuf:"bytes,3,opt,name=backup"`
+	// Failover enables the automatic failover of the master.
+	// +optional
+	Failover *MysqlFailoverSpec `json:"failover,omitempty" protobuf:"bytes,4,opt,name=failover"`
+	// Connection is the account which was published in the connection Secret.
+	// The account has no privileges but the Grants.
+	// +optional
+	Connection *MysqlConnectionSpec `json:"connection,omitempty" protobuf:"bytes,5,opt,name=connection"`
+}
+
+// MysqlConnectionSpec describes the account of the applications, which was published in the connection Secret
+type MysqlConnectionSpec struct {
+	// User is the name of the account.
+	// Defaults to "app".
+	// +optional
+	User string `json:"user,omitempty" protobuf:"bytes,1,opt,name=user"`
+	// Grants of the account.
+	// +optional
+	Grants []MysqlGrant `json:"grants,omitempty" protobuf:"bytes,2,rep,name=grants"`
+}
+
+// MysqlFailoverSpec describes when the most up-to-date slave would be promoted
+type MysqlFailoverSpec struct {
+	// GracePeriodSeconds is the duration which the master could be unavailable before the failover.
+	// Defaults to 30.
+	// +optional
+	GracePeriodSeconds *int32 `json:"gracePeriodSeconds,omitempty" protobuf:"varint,1,opt,name=gracePeriodSeconds"`
+}
+
+// MysqlBackupSpec describes how the binlogs and the full dumps of the master were archived
+type MysqlBackupSpec struct {
+	// Docker image of the sidecar which ships the binlogs and takes the full dumps.
+	// It was also used by the restore job.
+	Image string `json:"image" protobuf:"bytes,1,opt,name=image"`
+	// The path of the archive storage (e.g. nas disk) which was mounted on the machine
+	ArchivePath string `json:"archivePath" protobuf:"bytes,2,opt,name=archivePath"`
+	// FullDumpIntervalSeconds is the interval between two full dumps.
+	// Defaults to 86400.
+	// +optional
+	FullDumpIntervalSeconds *int32 `json:"fullDumpIntervalSeconds,omitempty" protobuf:"varint,3,opt,name=fullDumpIntervalSeconds"`
+	// RetentionCount is the number of the full dumps (with their binlogs) kept in the archive.
+	// Defaults to 7.
+	// +optional
+	RetentionCount *int32 `json:"retentionCount,omitempty" protobuf:"varint,4,opt,name=retentionCount"`
+	// RestoreToTime is a RFC3339 timestamp. Once it was set or changed, the operator
+	// restores the latest full dump taken before it and replays the binlogs up to it.
+	// +optional
+	RestoreToTime string `json:"restoreToTime,omitempty" protobuf:"bytes,5,opt,name=restoreToTime"`
+	// Resources of the sidecar and the restore job.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty" protobuf:"bytes,6,opt,name=resources"`
 }
 
 type MysqlCore struct {
@@ -122,6 +202,15 @@ type MysqlSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,16,opt,name=tolerations"`
+	// MyCnf is the options of the [mysqld] section, such as: max_connections: "1000".
+	// It was rendered into a ConfigMap which was mounted at /etc/mysql/conf.d.
+	// The dynamic variables were applied with SET GLOBAL, and the others by a rolling restart.
+	// +optional
+	MyCnf map[string]string `json:"myCnf,omitempty" protobuf:"bytes,17,rep,name=myCnf"`
+	// Image pull policy of the containers.
+	// One of Always, Never, IfNotPresent. Defaults to Always.
+	// +optional
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty" protobuf:"bytes,18,opt,name=imagePullPolicy,casttype=k8s.io/api/core/v1.PullPolicy"`
 }
 
 // ServerConfig is the configuration for a MysqlDeploymentSpec of a MysqlOperator resource
@@ -168,6 +257,32 @@ type MysqlStatus struct {
 	// newest ControllerRevision.
 	// +optional
 	CollisionCount *int32 `json:"collisionCount,omitempty" protobuf:"varint,9,opt,name=collisionCount"`
+
+	// restorePhase is the phase of the latest point-in-time recovery, such as: Running, Succeeded, Failed.
+	// +optional
+	RestorePhase string `json:"restorePhase,omitempty" protobuf:"bytes,10,opt,name=restorePhase"`
+
+	// restoredToTime is the RestoreToTime of the latest point-in-time recovery.
+	// +optional
+	RestoredToTime string `json:"restoredToTime,omitempty" protobuf:"bytes,11,opt,name=restoredToTime"`
+
+	// currentMaster is the name of the slave pod which was promoted by the latest failover or switchover.
+	// The pod of the master StatefulSet was the master if it was empty.
+	// +optional
+	CurrentMaster string `json:"currentMaster,omitempty" protobuf:"bytes,12,opt,name=currentMaster"`
+
+	// connectionSecret is the name of the Secret which holds the rw and ro endpoints and the credentials.
+	// +optional
+	ConnectionSecret string `json:"connectionSecret,omitempty" protobuf:"bytes,13,opt,name=connectionSecret"`
+
+	// paused is true while the sync was paused by the annotation nevercase.io/paused.
+	// +optional
+	Paused bool `json:"paused,omitempty" protobuf:"varint,14,opt,name=paused"`
+
+	// masterLostSince is when the current master was found unavailable, it was cleared once the master was back
+	// or replaced. The grace period of the failover was counted from it across the restarts of the controller.
+	// +optional
+	MasterLostSince *metav1.Time `json:"masterLostSince,omitempty" protobuf:"bytes,15,opt,name=masterLostSince"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -179,3 +294,128 @@ type MysqlOperatorList struct {
 	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
 	Items           []MysqlOperator `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MysqlDatabase describes a database which was created in the master of a MysqlOperator
+type MysqlDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   MysqlDatabaseSpec   `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	Status MysqlDatabaseStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// MysqlDatabaseSpec is the spec for a MysqlDatabase resource
+type MysqlDatabaseSpec struct {
+	// Operator is the name of the MysqlOperator in the same namespace
+	Operator string `json:"operator" protobuf:"bytes,1,opt,name=operator"`
+	// Name of the database.
+	// Defaults to the name of the MysqlDatabase.
+	// +optional
+	Name string `json:"name,omitempty" protobuf:"bytes,2,opt,name=name"`
+	// CharacterSet of the database, such as: utf8mb4.
+	// +optional
+	CharacterSet string `json:"characterSet,omitempty" protobuf:"bytes,3,opt,name=characterSet"`
+	// Collation of the database, such as: utf8mb4_general_ci.
+	// +optional
+	Collation string `json:"collation,omitempty" protobuf:"bytes,4,opt,name=collation"`
+	// DropOnDelete drops the database when the MysqlDatabase was deleted.
+	// The database was kept by default.
+	// +optional
+	DropOnDelete bool `json:"dropOnDelete,omitempty" protobuf:"varint,5,opt,name=dropOnDelete"`
+}
+
+// MysqlDatabaseStatus is the status for a MysqlDatabase resource
+type MysqlDatabaseStatus struct {
+	// phase is the result of the latest sync, such as: Ready, Failed.
+	// +optional
+	Phase string `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase"`
+	// message is the error of the latest failed sync.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MysqlDatabaseList is a list of MysqlDatabase resources
+type MysqlDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []MysqlDatabase `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MysqlUser describes an account and its grants which were created in the master of a MysqlOperator
+type MysqlUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   MysqlUserSpec   `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	Status MysqlUserStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// MysqlUserSpec is the spec for a MysqlUser resource
+type MysqlUserSpec struct {
+	// Operator is the name of the MysqlOperator in the same namespace
+	Operator string `json:"operator" protobuf:"bytes,1,opt,name=operator"`
+	// User is the name of the account.
+	// Defaults to the name of the MysqlUser.
+	// +optional
+	User string `json:"user,omitempty" protobuf:"bytes,2,opt,name=user"`
+	// Host which the account was allowed to connect from.
+	// Defaults to "%".
+	// +optional
+	Host string `json:"host,omitempty" protobuf:"bytes,3,opt,name=host"`
+	// PasswordSecret is the name of the Secret which holds the credentials of the account.
+	// The password would be generated if the Secret doesn't exist.
+	// Defaults to "<name>-mysql-user".
+	// +optional
+	PasswordSecret string `json:"passwordSecret,omitempty" protobuf:"bytes,4,opt,name=passwordSecret"`
+	// Grants of the account.
+	// +optional
+	Grants []MysqlGrant `json:"grants,omitempty" protobuf:"bytes,5,rep,name=grants"`
+	// DropOnDelete drops the account when the MysqlUser was deleted.
+	// The account was kept by default.
+	// +optional
+	DropOnDelete bool `json:"dropOnDelete,omitempty" protobuf:"varint,6,opt,name=dropOnDelete"`
+}
+
+// MysqlGrant describes the privileges on a database or a table
+type MysqlGrant struct {
+	// Database is the name of the database, "*" means all of the databases
+	Database string `json:"database" protobuf:"bytes,1,opt,name=database"`
+	// Table is the name of the table.
+	// Defaults to "*".
+	// +optional
+	Table string `json:"table,omitempty" protobuf:"bytes,2,opt,name=table"`
+	// Privileges such as: SELECT, INSERT, UPDATE, DELETE, ALL PRIVILEGES
+	Privileges []string `json:"privileges" protobuf:"bytes,3,rep,name=privileges"`
+}
+
+// MysqlUserStatus is the status for a MysqlUser resource
+type MysqlUserStatus struct {
+	// phase is the result of the latest sync, such as: Ready, Failed.
+	// +optional
+	Phase string `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase"`
+	// message is the error of the latest failed sync.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// secretName is the name of the Secret which holds the credentials of the account.
+	// +optional
+	SecretName string `json:"secretName,omitempty" protobuf:"bytes,3,opt,name=secretName"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MysqlUserList is a list of MysqlUser resources
+type MysqlUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []MysqlUser `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
@@ -23,9 +23,60 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MysqlBackupSpec) DeepCopyInto(out *MysqlBackupSpec) {
+	*out = *in
+	if in.FullDumpIntervalSeconds != nil {
+		in, out := &in.FullDumpIntervalSeconds, &out.FullDumpIntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetentionCount != nil {
+		in, out := &in.RetentionCount, &out.RetentionCount
+		*out = new(int32)
+		**out = **in
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MysqlBackupSpec.
+func (in *MysqlBackupSpec) DeepCopy() *MysqlBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MysqlBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MysqlConnectionSpec) DeepCopyInto(out *MysqlConnectionSpec) {
+	*out = *in
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]MysqlGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MysqlConnectionSpec.
+func (in *MysqlConnectionSpec) DeepCopy() *MysqlConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MysqlConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MysqlCore) DeepCopyInto(out *MysqlCore) {
 	*out = *in
@@ -44,12 +95,148 @@ func (in *MysqlCore) DeepCopy() *MysqlCore {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MysqlDatabase) DeepCopyInto(out *MysqlDatabase) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MysqlDatabase.
+func (in *MysqlDatabase) DeepCopy() *MysqlDatabase {
+	if in == nil {
+		return nil
+	}
+	out := new(MysqlDatabase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MysqlDatabase) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MysqlDatabaseList) DeepCopyInto(out *MysqlDatabaseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MysqlDatabase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MysqlDatabaseList.
+func (in *MysqlDatabaseList) DeepCopy() *MysqlDatabaseList {
+	if in == nil {
+		return nil
+	}
+	out := new(MysqlDatabaseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MysqlDatabaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MysqlDatabaseSpec) DeepCopyInto(out *MysqlDatabaseSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MysqlDatabaseSpec.
+func (in *MysqlDatabaseSpec) DeepCopy() *MysqlDatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MysqlDatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MysqlDatabaseStatus) DeepCopyInto(out *MysqlDatabaseStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MysqlDatabaseStatus.
+func (in *MysqlDatabaseStatus) DeepCopy() *MysqlDatabaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MysqlDatabaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MysqlFailoverSpec) DeepCopyInto(out *MysqlFailoverSpec) {
+	*out = *in
+	if in.GracePeriodSeconds != nil {
+		in, out := &in.GracePeriodSeconds, &out.GracePeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MysqlFailoverSpec.
+func (in *MysqlFailoverSpec) DeepCopy() *MysqlFailoverSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MysqlFailoverSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MysqlGrant) DeepCopyInto(out *MysqlGrant) {
+	*out = *in
+	if in.Privileges != nil {
+		in, out := &in.Privileges, &out.Privileges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MysqlGrant.
+func (in *MysqlGrant) DeepCopy() *MysqlGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(MysqlGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MysqlOperator) DeepCopyInto(out *MysqlOperator) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -109,6 +296,21 @@ func (in *MysqlOperatorSpec) DeepCopyInto(out *MysqlOperatorSpec) {
 	*out = *in
 	in.MasterSpec.DeepCopyInto(&out.MasterSpec)
 	in.SlaveSpec.DeepCopyInto(&out.SlaveSpec)
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(MysqlBackupSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(MysqlFailoverSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(MysqlConnectionSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -122,6 +324,39 @@ func (in *MysqlOperatorSpec) DeepCopy() *MysqlOperatorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MysqlOperatorStatus) DeepCopyInto(out *MysqlOperatorStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Master != nil {
+		in, out := &in.Master, &out.Master
+		*out = new(MysqlStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Slave != nil {
+		in, out := &in.Slave, &out.Slave
+		*out = new(MysqlStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MysqlOperatorStatus.
+func (in *MysqlOperatorStatus) DeepCopy() *MysqlOperatorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MysqlOperatorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MysqlSpec) DeepCopyInto(out *MysqlSpec) {
 	*out = *in
@@ -175,6 +410,13 @@ func (in *MysqlSpec) DeepCopyInto(out *MysqlSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MyCnf != nil {
+		in, out := &in.MyCnf, &out.MyCnf
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -196,6 +438,10 @@ func (in *MysqlStatus) DeepCopyInto(out *MysqlStatus) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.MasterLostSince != nil {
+		in, out := &in.MasterLostSince, &out.MasterLostSince
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -209,6 +455,106 @@ func (in *MysqlStatus) DeepCopy() *MysqlStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MysqlUser) DeepCopyInto(out *MysqlUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MysqlUser.
+func (in *MysqlUser) DeepCopy() *MysqlUser {
+	if in == nil {
+		return nil
+	}
+	out := new(MysqlUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MysqlUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MysqlUserList) DeepCopyInto(out *MysqlUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MysqlUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MysqlUserList.
+func (in *MysqlUserList) DeepCopy() *MysqlUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(MysqlUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MysqlUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MysqlUserSpec) DeepCopyInto(out *MysqlUserSpec) {
+	*out = *in
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]MysqlGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MysqlUserSpec.
+func (in *MysqlUserSpec) DeepCopy() *MysqlUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MysqlUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MysqlUserStatus) DeepCopyInto(out *MysqlUserStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MysqlUserStatus.
+func (in *MysqlUserStatus) DeepCopy() *MysqlUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MysqlUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerConfig) DeepCopyInto(out *ServerConfig) {
 	*out = *in
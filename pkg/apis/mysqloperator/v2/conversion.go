@@ -0,0 +1,205 @@
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+)
+
+// ConversionAnnotation keeps the fields of v2 which v1 couldn't express on the v1 objects,
+// so that the v2 objects round-trip through the stored v1 ones losslessly
+const ConversionAnnotation = "nevercase.io/v2-fields"
+
+// fields are the fields of v2 which were kept in the ConversionAnnotation
+type fields struct {
+	MasterStorage *storageFields `json:"masterStorage,omitempty"`
+	SlaveStorage  *storageFields `json:"slaveStorage,omitempty"`
+	// Conditions were read from the annotations which were written before status.conditions of v1
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+type storageFields struct {
+	StorageClassName *string            `json:"storageClassName,omitempty"`
+	Size             *resource.Quantity `json:"size,omitempty"`
+}
+
+func newStorageFields(s StorageSpec) *storageFields {
+	if s.StorageClassName == nil && s.Size == nil {
+		return nil
+	}
+	return &storageFields{StorageClassName: s.StorageClassName, Size: s.Size}
+}
+
+func (f fields) empty() bool {
+	return f.MasterStorage == nil && f.SlaveStorage == nil
+}
+
+// ConvertFromV1 returns the v2 object of in, the fields kept in the ConversionAnnotation were restored
+func ConvertFromV1(in *v1.MysqlOperator) (*MysqlOperator, error) {
+	in = in.DeepCopy()
+	out := &MysqlOperator{
+		TypeMeta:   metav1.TypeMeta{APIVersion: SchemeGroupVersion.String(), Kind: in.Kind},
+		ObjectMeta: in.ObjectMeta,
+	}
+	f := fields{}
+	if v, ok := out.Annotations[ConversionAnnotation]; ok {
+		if err := json.Unmarshal([]byte(v), &f); err != nil {
+			return nil, fmt.Errorf("invalid annotation %s of %s/%s: %v", ConversionAnnotation, in.Namespace, in.Name, err)
+		}
+		delete(out.Annotations, ConversionAnnotation)
+		if len(out.Annotations) == 0 {
+			out.Annotations = nil
+		}
+	}
+	out.Spec = MysqlOperatorSpec{
+		Master:   specFromV1(in.Spec.MasterSpec.Spec, f.MasterStorage),
+		Slave:    specFromV1(in.Spec.SlaveSpec.Spec, f.SlaveStorage),
+		Backup:   (*BackupSpec)(in.Spec.Backup),
+		Failover: (*FailoverSpec)(in.Spec.Failover),
+	}
+	if c := in.Spec.Connection; c != nil {
+		out.Spec.Connection = &ConnectionSpec{User: c.User}
+		for _, g := range c.Grants {
+			out.Spec.Connection.Grants = append(out.Spec.Connection.Grants, Grant(g))
+		}
+	}
+	out.Status = MysqlOperatorStatus{
+		Phase:         in.Status.Phase,
+		Replicas:      in.Status.Replicas,
+		ReadyReplicas: in.Status.ReadyReplicas,
+		Selector:      in.Status.Selector,
+		Conditions:    in.Status.Conditions,
+		Master:        MysqlStatus(in.Spec.MasterSpec.Status),
+		Slave:         MysqlStatus(in.Spec.SlaveSpec.Status),
+	}
+	if len(out.Status.Conditions) == 0 {
+		out.Status.Conditions = f.Conditions
+	}
+	// The mirrors in the status were what the status subresource has written
+	if in.Status.Master != nil {
+		out.Status.Master = MysqlStatus(*in.Status.Master)
+	}
+	if in.Status.Slave != nil {
+		out.Status.Slave = MysqlStatus(*in.Status.Slave)
+	}
+	return out, nil
+}
+
+// ConvertToV1 returns the v1 object of in, the fields which v1 couldn't express were kept in the ConversionAnnotation
+func ConvertToV1(in *MysqlOperator) (*v1.MysqlOperator, error) {
+	in = in.DeepCopy()
+	out := &v1.MysqlOperator{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1.SchemeGroupVersion.String(), Kind: in.Kind},
+		ObjectMeta: in.ObjectMeta,
+	}
+	f := fields{
+		MasterStorage: newStorageFields(in.Spec.Master.Storage),
+		SlaveStorage:  newStorageFields(in.Spec.Slave.Storage),
+	}
+	if f.empty() {
+		delete(out.Annotations, ConversionAnnotation)
+		if len(out.Annotations) == 0 {
+			out.Annotations = nil
+		}
+	} else {
+		b, err := json.Marshal(f)
+		if err != nil {
+			return nil, err
+		}
+		if out.Annotations == nil {
+			out.Annotations = map[string]string{}
+		}
+		out.Annotations[ConversionAnnotation] = string(b)
+	}
+	out.Spec = v1.MysqlOperatorSpec{
+		MasterSpec: v1.MysqlCore{Spec: specToV1(in.Spec.Master), Status: v1.MysqlStatus(in.Status.Master)},
+		SlaveSpec:  v1.MysqlCore{Spec: specToV1(in.Spec.Slave), Status: v1.MysqlStatus(in.Status.Slave)},
+		Backup:     (*v1.MysqlBackupSpec)(in.Spec.Backup),
+		Failover:   (*v1.MysqlFailoverSpec)(in.Spec.Failover),
+	}
+	if c := in.Spec.Connection; c != nil {
+		out.Spec.Connection = &v1.MysqlConnectionSpec{User: c.User}
+		for _, g := range c.Grants {
+			out.Spec.Connection.Grants = append(out.Spec.Connection.Grants, v1.MysqlGrant(g))
+		}
+	}
+	out.Status = v1.MysqlOperatorStatus{
+		Phase:         in.Status.Phase,
+		Replicas:      in.Status.Replicas,
+		ReadyReplicas: in.Status.ReadyReplicas,
+		Selector:      in.Status.Selector,
+		Conditions:    in.Status.Conditions,
+	}
+	// Both the spec and the status were set, since either of them was discarded by the main resource or the status subresource
+	master, slave := v1.MysqlStatus(in.Status.Master), v1.MysqlStatus(in.Status.Slave)
+	out.Status.Master, out.Status.Slave = &master, &slave
+	return out, nil
+}
+
+func specFromV1(in v1.MysqlSpec, storage *storageFields) MysqlSpec {
+	out := MysqlSpec{
+		Name:             in.Name,
+		Replicas:         in.Replicas,
+		Image:            in.Image,
+		ImagePullPolicy:  in.ImagePullPolicy,
+		ImagePullSecrets: in.ImagePullSecrets,
+		Env:              in.Env,
+		Resources:        in.Resources,
+		VolumeMounts:     in.VolumeMounts,
+		ContainerPorts:   in.ContainerPorts,
+		ServicePorts:     in.ServicePorts,
+		ServiceType:      in.ServiceType,
+		ServiceWhiteList: in.ServiceWhiteList,
+		Affinity:         in.Affinity,
+		Tolerations:      in.Tolerations,
+		MyCnf:            in.MyCnf,
+		ServerConfig: ServerConfig{
+			ServerID:    in.Config.ServerId,
+			Host:        in.Config.Host,
+			User:        in.Config.User,
+			Password:    in.Config.Password,
+			LogFile:     in.Config.LogFile,
+			LogPosition: in.Config.LogPosition,
+		},
+		Storage: StorageSpec{VolumePath: in.VolumePath},
+		Role:    in.Role,
+	}
+	if storage != nil {
+		out.Storage.StorageClassName, out.Storage.Size = storage.StorageClassName, storage.Size
+	}
+	return out
+}
+
+func specToV1(in MysqlSpec) v1.MysqlSpec {
+	return v1.MysqlSpec{
+		Name:             in.Name,
+		Replicas:         in.Replicas,
+		Image:            in.Image,
+		ImagePullPolicy:  in.ImagePullPolicy,
+		ImagePullSecrets: in.ImagePullSecrets,
+		Env:              in.Env,
+		Resources:        in.Resources,
+		VolumeMounts:     in.VolumeMounts,
+		ContainerPorts:   in.ContainerPorts,
+		ServicePorts:     in.ServicePorts,
+		ServiceType:      in.ServiceType,
+		ServiceWhiteList: in.ServiceWhiteList,
+		Affinity:         in.Affinity,
+		Tolerations:      in.Tolerations,
+		MyCnf:            in.MyCnf,
+		Config: v1.ServerConfig{
+			ServerId:    in.ServerConfig.ServerID,
+			Host:        in.ServerConfig.Host,
+			User:        in.ServerConfig.User,
+			Password:    in.ServerConfig.Password,
+			LogFile:     in.ServerConfig.LogFile,
+			LogPosition: in.ServerConfig.LogPosition,
+		},
+		VolumePath: in.Storage.VolumePath,
+		Role:       in.Role,
+	}
+}
@@ -0,0 +1,81 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/diff"
+)
+
+func TestConvertV2RoundTrip(t *testing.T) {
+	serverID, size := int32(1), resource.MustParse("20Gi")
+	in := &MysqlOperator{
+		TypeMeta:   metav1.TypeMeta{APIVersion: SchemeGroupVersion.String(), Kind: "MysqlOperator"},
+		ObjectMeta: metav1.ObjectMeta{Name: "example-mysql", Namespace: "default"},
+		Spec: MysqlOperatorSpec{
+			Master: MysqlSpec{
+				Name:         "master",
+				Image:        "mysql:5.7",
+				ServiceType:  corev1.ServiceTypeClusterIP,
+				MyCnf:        map[string]string{"max_connections": "500"},
+				ServerConfig: ServerConfig{ServerID: &serverID, Host: "127.0.0.1", User: "repl", LogFile: "mysql-bin.000001"},
+				Storage:      StorageSpec{VolumePath: "/var/lib/mysql", Size: &size},
+			},
+			Slave:  MysqlSpec{Name: "slave", Image: "mysql:5.7"},
+			Backup: &BackupSpec{Image: "mysql-backup:latest", ArchivePath: "/backup"},
+			Connection: &ConnectionSpec{
+				User:   "shop",
+				Grants: []Grant{{Database: "shop", Privileges: []string{"SELECT", "INSERT"}}},
+			},
+		},
+		Status: MysqlOperatorStatus{Phase: "Running", Master: MysqlStatus{CurrentMaster: "example-mysql-master-0"}},
+	}
+	stored, err := ConvertToV1(in)
+	if err != nil {
+		t.Fatalf("ConvertToV1() error = %v", err)
+	}
+	out, err := ConvertFromV1(stored)
+	if err != nil {
+		t.Fatalf("ConvertFromV1() error = %v", err)
+	}
+	if !apiequality.Semantic.DeepEqual(in, out) {
+		t.Errorf("v2 -> v1 -> v2 lost data: %s", diff.ObjectReflectDiff(in, out))
+	}
+}
+
+func TestConvertStatusSubresource(t *testing.T) {
+	created, err := ConvertToV1(&MysqlOperator{
+		TypeMeta:   metav1.TypeMeta{APIVersion: SchemeGroupVersion.String(), Kind: "MysqlOperator"},
+		ObjectMeta: metav1.ObjectMeta{Name: "example-mysql", Namespace: "default"},
+		Spec:       MysqlOperatorSpec{Master: MysqlSpec{Name: "master", Image: "mysql:5.7"}, Slave: MysqlSpec{Name: "slave", Image: "mysql:5.7"}},
+	})
+	if err != nil {
+		t.Fatalf("ConvertToV1() error = %v", err)
+	}
+	foo, err := ConvertFromV1(created)
+	if err != nil {
+		t.Fatalf("ConvertFromV1() error = %v", err)
+	}
+	lostSince := metav1.NewTime(time.Unix(1700000000, 0))
+	foo.Status.Conditions = []metav1.Condition{{Type: "Ready", Status: metav1.ConditionFalse, Reason: "MasterUnavailable", LastTransitionTime: lostSince}}
+	foo.Status.Master = MysqlStatus{Replicas: 1, CurrentMaster: "master-slave-0", MasterLostSince: &lostSince}
+	foo.Status.Slave = MysqlStatus{Replicas: 2, ReadyReplicas: 2}
+	update, err := ConvertToV1(foo)
+	if err != nil {
+		t.Fatalf("ConvertToV1() error = %v", err)
+	}
+	// The status subresource keeps all but the status of the stored object
+	stored := created.DeepCopy()
+	stored.Status = update.Status
+	out, err := ConvertFromV1(stored)
+	if err != nil {
+		t.Fatalf("ConvertFromV1() error = %v", err)
+	}
+	if !apiequality.Semantic.DeepEqual(foo.Status, out.Status) {
+		t.Errorf("the update of the status subresource lost data: %s", diff.ObjectReflectDiff(foo.Status, out.Status))
+	}
+}
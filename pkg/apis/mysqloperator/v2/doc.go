@@ -0,0 +1,7 @@
+// +k8s:deepcopy-gen=package
+
+// Package v2 is the nevercase.io/v2 API of the MysqlOperator, which was served alongside v1
+// and converted from and into the stored v1 objects by the conversion webhook.
+// The MysqlDatabase and the MysqlUser were only served in v1.
+// +groupName=nevercase.io
+package v2
@@ -0,0 +1,220 @@
+package v2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MysqlOperator describes a MysqlOperator resource
+type MysqlOperator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec MysqlOperatorSpec `json:"spec"`
+	// +optional
+	Status MysqlOperatorStatus `json:"status,omitempty"`
+}
+
+// MysqlOperatorSpec is the spec for a MysqlOperator resource
+type MysqlOperatorSpec struct {
+	// master is the spec of the master.
+	Master MysqlSpec `json:"master"`
+	// slave is the spec of the slaves, which were scaled by the scale subresource.
+	Slave MysqlSpec `json:"slave"`
+	// backup enables the binlog archiving and the periodic full dumps of the master.
+	// +optional
+	Backup *BackupSpec `json:"backup,omitempty"`
+	// failover enables the automatic failover of the master.
+	// +optional
+	Failover *FailoverSpec `json:"failover,omitempty"`
+	// connection is the account which was published in the connection Secret,
+	// it has no privileges but the grants.
+	// +optional
+	Connection *ConnectionSpec `json:"connection,omitempty"`
+}
+
+// ConnectionSpec describes the account of the applications, which was published in the connection Secret
+type ConnectionSpec struct {
+	// user is the name of the account, defaults to "app".
+	// +optional
+	User string `json:"user,omitempty"`
+	// +optional
+	Grants []Grant `json:"grants,omitempty"`
+}
+
+// Grant describes the privileges on a database or a table
+type Grant struct {
+	// database is the name of the database, "*" means all of the databases.
+	Database string `json:"database"`
+	// table is the name of the table, defaults to "*".
+	// +optional
+	Table string `json:"table,omitempty"`
+	// privileges such as: SELECT, INSERT, UPDATE, DELETE, ALL PRIVILEGES.
+	Privileges []string `json:"privileges"`
+}
+
+// FailoverSpec describes when the most up-to-date slave would be promoted
+type FailoverSpec struct {
+	// gracePeriodSeconds is how long the master must have been unready before the failover.
+	// +optional
+	GracePeriodSeconds *int32 `json:"gracePeriodSeconds,omitempty"`
+}
+
+// BackupSpec describes how the binlogs and the full dumps of the master were archived
+type BackupSpec struct {
+	Image string `json:"image"`
+	// archivePath is the path on the machines where the dumps and the binlogs were archived.
+	ArchivePath string `json:"archivePath"`
+	// +optional
+	FullDumpIntervalSeconds *int32 `json:"fullDumpIntervalSeconds,omitempty"`
+	// +optional
+	RetentionCount *int32 `json:"retentionCount,omitempty"`
+	// restoreToTime is the RFC3339 time which the master was restored to.
+	// +optional
+	RestoreToTime string `json:"restoreToTime,omitempty"`
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// MysqlSpec is the spec of the master or the slaves
+type MysqlSpec struct {
+	// name of the servers specified as a DNS_LABEL, the children were named after it with the suffix of the role.
+	Name string `json:"name"`
+	// replicas is the number of desired replicas.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	Image string `json:"image"`
+	// +optional
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	// +optional
+	ContainerPorts []corev1.ContainerPort `json:"containerPorts,omitempty"`
+	// servicePorts are the ports of the Service of the role, the empty ones mean no Service.
+	// +optional
+	ServicePorts []corev1.ServicePort `json:"servicePorts,omitempty"`
+	// +optional
+	ServiceType corev1.ServiceType `json:"serviceType,omitempty"`
+	// +optional
+	ServiceWhiteList bool `json:"serviceWhiteList,omitempty"`
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// myCnf is the options of the [mysqld] section, such as: max_connections: "1000".
+	// +optional
+	MyCnf map[string]string `json:"myCnf,omitempty"`
+	// serverConfig is the replication config of the mysql instance.
+	// +optional
+	ServerConfig ServerConfig `json:"serverConfig,omitempty"`
+	// storage is where the data was kept.
+	Storage StorageSpec `json:"storage"`
+	// role was set by the controller in v1, it was kept for the round trip.
+	// +optional
+	Role string `json:"role,omitempty"`
+}
+
+// ServerConfig is the replication config of the mysql instance
+type ServerConfig struct {
+	// +optional
+	ServerID *int32 `json:"serverID,omitempty"`
+	// +optional
+	Host string `json:"host,omitempty"`
+	// +optional
+	User string `json:"user,omitempty"`
+	// +optional
+	Password string `json:"password,omitempty"`
+	// +optional
+	LogFile string `json:"logFile,omitempty"`
+	// +optional
+	LogPosition string `json:"logPosition,omitempty"`
+}
+
+// StorageSpec is the storage of the data of the servers
+type StorageSpec struct {
+	// volumePath is the path of the nas disk which was mounted on the machines.
+	// +optional
+	VolumePath string `json:"volumePath,omitempty"`
+	// storageClassName is the StorageClass of the PersistentVolumeClaims instead of the volume path.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// size is the requested size of the PersistentVolumeClaims.
+	// +optional
+	Size *resource.Quantity `json:"size,omitempty"`
+}
+
+// MysqlOperatorStatus is the status for a MysqlOperator resource
+type MysqlOperatorStatus struct {
+	// +optional
+	Phase string `json:"phase,omitempty"`
+	// replicas is the number of the slaves, which was scaled by the scale subresource.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// +optional
+	Selector string `json:"selector,omitempty"`
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// master is the status of the StatefulSet of the master.
+	// +optional
+	Master MysqlStatus `json:"master,omitempty"`
+	// slave is the status of the StatefulSet of the slaves.
+	// +optional
+	Slave MysqlStatus `json:"slave,omitempty"`
+}
+
+// MysqlStatus is the status of the StatefulSet of the master or the slaves
+type MysqlStatus struct {
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// +optional
+	CurrentReplicas int32 `json:"currentReplicas,omitempty"`
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+	// +optional
+	CurrentRevision string `json:"currentRevision,omitempty"`
+	// +optional
+	UpdateRevision string `json:"updateRevision,omitempty"`
+	// +optional
+	CollisionCount *int32 `json:"collisionCount,omitempty"`
+	// restorePhase is the phase of the latest point-in-time recovery, such as: Running, Succeeded, Failed.
+	// +optional
+	RestorePhase string `json:"restorePhase,omitempty"`
+	// +optional
+	RestoredToTime string `json:"restoredToTime,omitempty"`
+	// currentMaster is the name of the slave pod which was promoted by the latest failover or switchover.
+	// +optional
+	CurrentMaster string `json:"currentMaster,omitempty"`
+	// +optional
+	ConnectionSecret string `json:"connectionSecret,omitempty"`
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+	// masterLostSince is when the current master was found unavailable.
+	// +optional
+	MasterLostSince *metav1.Time `json:"masterLostSince,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MysqlOperatorList is a list of MysqlOperator resources
+type MysqlOperatorList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata"`
+	Items           []MysqlOperator `json:"items"`
+}
@@ -27,6 +27,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
 	v11 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	math "math"
 	math_bits "math/bits"
@@ -43,7 +44,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 func (m *RedisCore) Reset()      { *m = RedisCore{} }
 func (*RedisCore) ProtoMessage() {}
@@ -157,10 +158,38 @@ func (m *RedisOperatorSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RedisOperatorSpec proto.InternalMessageInfo
 
+func (m *RedisOperatorStatus) Reset()      { *m = RedisOperatorStatus{} }
+func (*RedisOperatorStatus) ProtoMessage() {}
+func (*RedisOperatorStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8067ba9c1bab52, []int{4}
+}
+func (m *RedisOperatorStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedisOperatorStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RedisOperatorStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedisOperatorStatus.Merge(m, src)
+}
+func (m *RedisOperatorStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedisOperatorStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedisOperatorStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedisOperatorStatus proto.InternalMessageInfo
+
 func (m *RedisSpec) Reset()      { *m = RedisSpec{} }
 func (*RedisSpec) ProtoMessage() {}
 func (*RedisSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fb8067ba9c1bab52, []int{4}
+	return fileDescriptor_fb8067ba9c1bab52, []int{5}
 }
 func (m *RedisSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,7 +217,7 @@ var xxx_messageInfo_RedisSpec proto.InternalMessageInfo
 func (m *RedisStatus) Reset()      { *m = RedisStatus{} }
 func (*RedisStatus) ProtoMessage() {}
 func (*RedisStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fb8067ba9c1bab52, []int{5}
+	return fileDescriptor_fb8067ba9c1bab52, []int{6}
 }
 func (m *RedisStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,6 +247,7 @@ func init() {
 	proto.RegisterType((*RedisOperator)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.redisoperator.v1.RedisOperator")
 	proto.RegisterType((*RedisOperatorList)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.redisoperator.v1.RedisOperatorList")
 	proto.RegisterType((*RedisOperatorSpec)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.redisoperator.v1.RedisOperatorSpec")
+	proto.RegisterType((*RedisOperatorStatus)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.redisoperator.v1.RedisOperatorStatus")
 	proto.RegisterType((*RedisSpec)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.redisoperator.v1.RedisSpec")
 	proto.RegisterType((*RedisStatus)(nil), "github.com.nevercase.k8s_controller_custom_resource.pkg.apis.redisoperator.v1.RedisStatus")
 }
@@ -227,78 +257,90 @@ func init() {
 }
 
 var fileDescriptor_fb8067ba9c1bab52 = []byte{
-	// 1136 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x26, 0x76, 0x6a, 0x8f, 0x93, 0x34, 0x1d, 0x04, 0x2c, 0x11, 0x72, 0x82, 0x39, 0xe0,
-	0x03, 0x59, 0x93, 0x08, 0xaa, 0xa8, 0x48, 0x48, 0xdd, 0x50, 0x50, 0x11, 0x21, 0xd1, 0xb8, 0x49,
-	0xa1, 0x2a, 0x4a, 0x27, 0xeb, 0x17, 0x67, 0xc9, 0xee, 0x8e, 0x99, 0x99, 0xb5, 0x14, 0xb8, 0x00,
-	0x12, 0x87, 0x4a, 0x3d, 0x70, 0xe5, 0x4b, 0xf0, 0x39, 0x72, 0xec, 0xb1, 0xa7, 0x88, 0x98, 0xcf,
-	0x00, 0x87, 0x9e, 0xd0, 0xcc, 0x8e, 0xf7, 0x8f, 0xbd, 0x01, 0x0e, 0xe4, 0xe6, 0x37, 0xef, 0xf7,
-	0x7e, 0xbf, 0xf7, 0xde, 0xbc, 0x79, 0x5e, 0xf4, 0x75, 0xdf, 0x97, 0x27, 0xf1, 0x91, 0xe3, 0xb1,
-	0xb0, 0x13, 0xc1, 0x10, 0xb8, 0x47, 0x05, 0x74, 0x4e, 0xb7, 0xc4, 0xba, 0xc7, 0x22, 0xc9, 0x59,
-	0x10, 0x00, 0x5f, 0xf7, 0x62, 0x21, 0x59, 0xb8, 0xce, 0x41, 0xb0, 0x98, 0x7b, 0xd0, 0x19, 0x9c,
-	0xf6, 0x3b, 0x74, 0xe0, 0x8b, 0x0e, 0x87, 0x9e, 0x2f, 0xd8, 0x00, 0x38, 0x95, 0x8c, 0x77, 0x86,
-	0x1b, 0x9d, 0x3e, 0x44, 0xca, 0x80, 0x9e, 0x33, 0xe0, 0x4c, 0x32, 0xbc, 0x93, 0xd1, 0x3b, 0x29,
-	0xbd, 0x73, 0xba, 0x25, 0x0e, 0x33, 0xfa, 0xc3, 0x84, 0xfe, 0x70, 0x4c, 0xef, 0x0c, 0x4e, 0xfb,
-	0x8e, 0xa2, 0x77, 0x0a, 0xf4, 0xce, 0x70, 0x63, 0x65, 0x3d, 0x97, 0x6d, 0x9f, 0xf5, 0x59, 0x47,
-	0xab, 0x1c, 0xc5, 0xc7, 0xda, 0xd2, 0x86, 0xfe, 0x95, 0xa8, 0xaf, 0xb4, 0x4e, 0xb7, 0x84, 0xe3,
-	0x33, 0x95, 0x6b, 0xc7, 0x63, 0x1c, 0x4a, 0x32, 0x5c, 0x79, 0x3f, 0xc3, 0x84, 0xd4, 0x3b, 0xf1,
-	0x23, 0xe0, 0x67, 0x59, 0x81, 0x21, 0x48, 0x5a, 0x16, 0xd5, 0xb9, 0x2a, 0x8a, 0xc7, 0x91, 0xf4,
-	0x43, 0x98, 0x0a, 0xb8, 0xfd, 0x6f, 0x01, 0xc2, 0x3b, 0x81, 0x90, 0x4e, 0xc6, 0xb5, 0x9e, 0xcd,
-	0xa2, 0x3a, 0x51, 0x6d, 0xd8, 0x66, 0x1c, 0xf0, 0x77, 0xa8, 0x22, 0x06, 0xe0, 0xd9, 0xd6, 0x9a,
-	0xd5, 0x6e, 0x6c, 0x7e, 0xe9, 0xfc, 0xaf, 0xdd, 0x75, 0xb4, 0x4e, 0x77, 0x00, 0x9e, 0xbb, 0x70,
-	0x7e, 0xb1, 0x3a, 0x33, 0xba, 0x58, 0xad, 0x28, 0x8b, 0x68, 0x4d, 0xfc, 0x93, 0x85, 0xe6, 0x85,
-	0xa4, 0x32, 0x16, 0xf6, 0xac, 0x96, 0x7f, 0x74, 0x2d, 0xf2, 0x5a, 0xc1, 0x5d, 0x32, 0x09, 0xcc,
-	0x27, 0x36, 0x31, 0xca, 0xad, 0xbf, 0x2c, 0xb4, 0xa8, 0x71, 0xbb, 0x26, 0x10, 0x3f, 0x41, 0x35,
-	0x75, 0x49, 0x3d, 0x2a, 0xa9, 0x69, 0xcb, 0x7b, 0x4e, 0xd2, 0x6b, 0x27, 0xdf, 0xeb, 0x4c, 0x57,
-	0xa1, 0x95, 0xdc, 0xee, 0xd1, 0x37, 0xe0, 0xc9, 0x1d, 0x90, 0xd4, 0xc5, 0x46, 0x0d, 0x65, 0x67,
-	0x24, 0x65, 0x55, 0x85, 0x27, 0x5d, 0x4f, 0xca, 0x7e, 0x72, 0x1d, 0x65, 0x8f, 0xcb, 0xb9, 0xaa,
-	0xfb, 0xad, 0x3f, 0x2d, 0x74, 0xab, 0x80, 0xfc, 0xdc, 0x17, 0x12, 0x3f, 0x9e, 0x2a, 0xde, 0xf9,
-	0x6f, 0xc5, 0xab, 0x68, 0x5d, 0xfa, 0xb2, 0xd1, 0xaa, 0x8d, 0x4f, 0x72, 0x85, 0xff, 0x68, 0xa1,
-	0xaa, 0x2f, 0x21, 0x54, 0x17, 0x3e, 0xd7, 0x6e, 0x6c, 0x3e, 0xbe, 0xce, 0xca, 0xdd, 0x45, 0x93,
-	0x49, 0xf5, 0xbe, 0x92, 0x24, 0x89, 0x72, 0xeb, 0xb7, 0xd9, 0x89, 0xba, 0x55, 0x4f, 0xf0, 0x33,
-	0x0b, 0xa1, 0x90, 0x0a, 0x09, 0xda, 0xbc, 0xce, 0xe7, 0xa0, 0x9e, 0x5d, 0x36, 0x1f, 0x3b, 0xa9,
-	0x26, 0xc9, 0xe9, 0xe3, 0xa7, 0x16, 0xaa, 0x8b, 0x80, 0x0e, 0xa1, 0x9b, 0x8d, 0xc9, 0xf5, 0x65,
-	0x73, 0xcb, 0x64, 0x53, 0xef, 0x8e, 0x25, 0x49, 0xa6, 0xde, 0xba, 0xac, 0x9b, 0x85, 0xa1, 0x33,
-	0x5b, 0x43, 0x95, 0x88, 0x86, 0xa0, 0x3b, 0x54, 0xcf, 0x06, 0xeb, 0x0b, 0x1a, 0x02, 0xd1, 0x1e,
-	0xdc, 0x46, 0x35, 0x0e, 0x83, 0xc0, 0xf7, 0x68, 0xf2, 0xae, 0xab, 0xee, 0x82, 0x1a, 0x07, 0x62,
-	0xce, 0x48, 0xea, 0xc5, 0x6f, 0xa3, 0xaa, 0x1f, 0xd2, 0x3e, 0xd8, 0x73, 0x9a, 0x2c, 0xbb, 0x2f,
-	0x75, 0x48, 0x12, 0x1f, 0xfe, 0xd5, 0x42, 0xcb, 0xfa, 0xd7, 0x5e, 0x1c, 0x04, 0x5d, 0xf0, 0x38,
-	0x48, 0x61, 0x57, 0xf4, 0xf8, 0xb4, 0x73, 0xa3, 0xe9, 0xa8, 0x75, 0xac, 0x07, 0x91, 0x79, 0x34,
-	0x48, 0x9e, 0x1d, 0x81, 0x63, 0xe0, 0x10, 0x79, 0xe0, 0x6e, 0x1b, 0xea, 0xe5, 0xfb, 0x13, 0x4c,
-	0x2f, 0x2f, 0x56, 0xdf, 0x99, 0xde, 0xe9, 0xa5, 0x24, 0x64, 0x2a, 0x0d, 0x7c, 0x80, 0xe6, 0x20,
-	0x1a, 0xda, 0x55, 0x9d, 0xcd, 0x4a, 0x59, 0x36, 0xf7, 0xa2, 0xe1, 0x01, 0xe5, 0x6e, 0xdb, 0xe8,
-	0xcf, 0xdd, 0x8b, 0x86, 0x2f, 0x2f, 0x56, 0xdf, 0x28, 0x91, 0x4c, 0x90, 0x44, 0x11, 0xe2, 0xaf,
-	0x50, 0x7d, 0x7c, 0x81, 0xc2, 0x9e, 0xd7, 0xb7, 0x5f, 0x5a, 0x2b, 0x31, 0x20, 0x02, 0xdf, 0xc6,
-	0x3e, 0x87, 0x10, 0x22, 0x29, 0xb2, 0xdb, 0x1c, 0x7b, 0x05, 0xc9, 0xd8, 0xf0, 0xf7, 0x68, 0x61,
-	0xc8, 0x82, 0x38, 0x84, 0x1d, 0x16, 0x47, 0x52, 0xd8, 0x37, 0x74, 0xee, 0xab, 0x65, 0xec, 0x07,
-	0x19, 0xce, 0xbd, 0x6d, 0x48, 0x17, 0x72, 0x87, 0xaa, 0x79, 0xcd, 0x92, 0x4a, 0x72, 0x10, 0x52,
-	0x10, 0xc3, 0x3f, 0x5b, 0x68, 0x49, 0xcd, 0x2a, 0x55, 0x4b, 0x64, 0x8f, 0x71, 0x29, 0xec, 0x9a,
-	0xd6, 0x7f, 0xab, 0x4c, 0x7f, 0x3b, 0x8f, 0x74, 0xef, 0x98, 0x0c, 0x96, 0x0a, 0xc7, 0x2a, 0x87,
-	0xb5, 0x92, 0x1c, 0x0a, 0x20, 0x32, 0x21, 0xaa, 0x9a, 0x20, 0x80, 0x0f, 0x7d, 0x0f, 0x92, 0x24,
-	0xea, 0x57, 0x37, 0xa1, 0x9b, 0xe1, 0xb2, 0x26, 0xe4, 0x0e, 0xaf, 0x6a, 0x42, 0x0e, 0x42, 0x0a,
-	0x62, 0xf8, 0x21, 0x6a, 0x18, 0xfb, 0xc1, 0xd9, 0x00, 0x6c, 0xa4, 0x67, 0xff, 0x03, 0x43, 0xdd,
-	0xe8, 0x66, 0xae, 0x7f, 0x66, 0x56, 0x08, 0x92, 0x67, 0xc2, 0x9b, 0x08, 0x25, 0xdd, 0xde, 0xa3,
-	0xf2, 0xc4, 0x6e, 0x68, 0xde, 0x74, 0xd1, 0x1c, 0xa4, 0x1e, 0x92, 0x43, 0xa9, 0xe7, 0xcc, 0x59,
-	0x00, 0xf6, 0x42, 0xf1, 0x39, 0x13, 0x16, 0x00, 0xd1, 0x1e, 0xfc, 0x31, 0x5a, 0x36, 0x22, 0x0f,
-	0x4f, 0x7c, 0x09, 0x6a, 0xab, 0xdb, 0x8b, 0x6b, 0x56, 0xbb, 0xe6, 0xda, 0xe3, 0x47, 0xd5, 0x9d,
-	0xf0, 0x93, 0xa9, 0x08, 0xfc, 0x09, 0xaa, 0xd1, 0xe3, 0x63, 0x3f, 0xf2, 0xe5, 0x99, 0xbd, 0xa4,
-	0x07, 0xfa, 0xcd, 0xb2, 0x6e, 0xdf, 0x35, 0x98, 0x64, 0x65, 0x8c, 0x2d, 0x92, 0xc6, 0xe2, 0x7d,
-	0xd4, 0x90, 0x2c, 0x50, 0x4b, 0xcc, 0x67, 0x91, 0xb0, 0x6f, 0xea, 0x8b, 0x6b, 0x96, 0x51, 0x3d,
-	0x48, 0x61, 0xee, 0x2b, 0xe3, 0xe6, 0x66, 0x67, 0x82, 0xe4, 0x79, 0x5a, 0x4f, 0x2b, 0xa8, 0x91,
-	0xfb, 0x5a, 0xc0, 0x9f, 0x21, 0xcc, 0x8e, 0x54, 0x11, 0xd0, 0xfb, 0x34, 0xf9, 0x7e, 0xf2, 0x59,
-	0xa4, 0x77, 0xde, 0x9c, 0xbb, 0x62, 0xd8, 0xf0, 0xee, 0x14, 0x82, 0x94, 0x44, 0xe1, 0x77, 0xa7,
-	0xf6, 0x61, 0xfa, 0x17, 0x59, 0xb2, 0x13, 0x3f, 0x44, 0x8b, 0x1c, 0x68, 0xef, 0x6c, 0xec, 0xd2,
-	0xbb, 0xb1, 0xea, 0xbe, 0x6a, 0x42, 0x16, 0x49, 0xde, 0x49, 0x8a, 0x58, 0x7c, 0x17, 0xdd, 0xf4,
-	0x62, 0xce, 0x21, 0x92, 0x69, 0x78, 0x45, 0x87, 0xbf, 0x6e, 0xc2, 0x6f, 0x6e, 0x17, 0xdd, 0x64,
-	0x12, 0xaf, 0x28, 0xe2, 0x41, 0x4f, 0x7d, 0x2f, 0xa6, 0x14, 0xd5, 0x22, 0xc5, 0x7e, 0xd1, 0x4d,
-	0x26, 0xf1, 0x85, 0x2c, 0x86, 0xbe, 0x50, 0x9d, 0x9b, 0xd7, 0xe3, 0x35, 0x9d, 0x45, 0xe2, 0x26,
-	0x93, 0x78, 0xfc, 0x11, 0x5a, 0x4a, 0x58, 0x53, 0x86, 0x1b, 0x9a, 0xe1, 0xb5, 0xf1, 0x12, 0xd8,
-	0x2f, 0x78, 0xc9, 0x04, 0x1a, 0xdf, 0x51, 0x7b, 0x26, 0x08, 0xb4, 0xb1, 0xad, 0x76, 0x8f, 0x5d,
-	0xd7, 0x45, 0xe0, 0x64, 0x81, 0xe4, 0x3d, 0x64, 0x02, 0xe9, 0xb6, 0xcf, 0x2f, 0x9b, 0x33, 0xcf,
-	0x2f, 0x9b, 0x33, 0x2f, 0x2e, 0x9b, 0x33, 0x3f, 0x8c, 0x9a, 0xd6, 0xf9, 0xa8, 0x69, 0x3d, 0x1f,
-	0x35, 0xad, 0x17, 0xa3, 0xa6, 0xf5, 0xfb, 0xa8, 0x69, 0xfd, 0xf2, 0x47, 0x73, 0xe6, 0xd1, 0xec,
-	0x70, 0xe3, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd0, 0xe2, 0x0b, 0x46, 0xeb, 0x0c, 0x00, 0x00,
+	// 1323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5d, 0x6f, 0x1b, 0x45,
+	0x17, 0xce, 0xc6, 0x76, 0x6a, 0x8f, 0xf3, 0xd5, 0xa9, 0xde, 0xf7, 0xdd, 0x37, 0x02, 0x27, 0xb8,
+	0x12, 0xf8, 0x82, 0xac, 0x69, 0x05, 0x55, 0x54, 0x24, 0xa4, 0xae, 0x29, 0x28, 0x88, 0xd0, 0x68,
+	0xdc, 0xa6, 0x50, 0x15, 0xb5, 0x9b, 0xf5, 0x89, 0xb3, 0x64, 0x77, 0xc7, 0xcc, 0xcc, 0x5a, 0x0a,
+	0x95, 0x10, 0x1f, 0xe2, 0x02, 0xd4, 0x0b, 0x6e, 0xf9, 0x13, 0xdc, 0xf1, 0x13, 0x90, 0x7a, 0xd9,
+	0xcb, 0x5e, 0x45, 0x34, 0xfc, 0x06, 0x84, 0xd4, 0x2b, 0x34, 0xb3, 0xb3, 0x5f, 0xf6, 0xa6, 0xf4,
+	0x02, 0x73, 0x97, 0x39, 0xe7, 0x39, 0xcf, 0x73, 0xe6, 0x9c, 0x33, 0x67, 0x63, 0xf4, 0xe9, 0xd0,
+	0x13, 0x87, 0xd1, 0xbe, 0xe5, 0xd2, 0xa0, 0x1b, 0xc2, 0x18, 0x98, 0xeb, 0x70, 0xe8, 0x1e, 0x6d,
+	0xf1, 0x4d, 0x97, 0x86, 0x82, 0x51, 0xdf, 0x07, 0xb6, 0xe9, 0x46, 0x5c, 0xd0, 0x60, 0x93, 0x01,
+	0xa7, 0x11, 0x73, 0xa1, 0x3b, 0x3a, 0x1a, 0x76, 0x9d, 0x91, 0xc7, 0xbb, 0x0c, 0x06, 0x1e, 0xa7,
+	0x23, 0x60, 0x8e, 0xa0, 0xac, 0x3b, 0xbe, 0xd4, 0x1d, 0x42, 0x28, 0x0f, 0x30, 0xb0, 0x46, 0x8c,
+	0x0a, 0x8a, 0x77, 0x32, 0x7a, 0x2b, 0xa5, 0xb7, 0x8e, 0xb6, 0xf8, 0xbd, 0x8c, 0xfe, 0x5e, 0x4c,
+	0x7f, 0x2f, 0xa1, 0xb7, 0x46, 0x47, 0x43, 0x4b, 0xd2, 0x5b, 0x05, 0x7a, 0x6b, 0x7c, 0x69, 0x6d,
+	0x33, 0x97, 0xed, 0x90, 0x0e, 0x69, 0x57, 0xa9, 0xec, 0x47, 0x07, 0xea, 0xa4, 0x0e, 0xea, 0xaf,
+	0x58, 0x7d, 0xad, 0x7d, 0xb4, 0xc5, 0x2d, 0x8f, 0xca, 0x5c, 0xbb, 0x2e, 0x65, 0x50, 0x92, 0xe1,
+	0xda, 0x9b, 0x19, 0x26, 0x70, 0xdc, 0x43, 0x2f, 0x04, 0x76, 0x9c, 0x5d, 0x30, 0x00, 0xe1, 0x94,
+	0x45, 0x75, 0xcf, 0x8a, 0x62, 0x51, 0x28, 0xbc, 0x00, 0xa6, 0x02, 0xae, 0xfc, 0x5d, 0x00, 0x77,
+	0x0f, 0x21, 0x70, 0x26, 0xe3, 0xda, 0x0f, 0xe7, 0x51, 0x83, 0xc8, 0x32, 0xf4, 0x28, 0x03, 0xfc,
+	0x05, 0xaa, 0xf2, 0x11, 0xb8, 0xa6, 0xb1, 0x61, 0x74, 0x9a, 0x97, 0x3f, 0xb6, 0xfe, 0xd1, 0xea,
+	0x5a, 0x4a, 0xa7, 0x3f, 0x02, 0xd7, 0x5e, 0x7c, 0x74, 0xb2, 0x3e, 0x77, 0x7a, 0xb2, 0x5e, 0x95,
+	0x27, 0xa2, 0x34, 0xf1, 0x37, 0x06, 0x5a, 0xe0, 0xc2, 0x11, 0x11, 0x37, 0xe7, 0x95, 0xfc, 0x9d,
+	0x99, 0xc8, 0x2b, 0x05, 0x7b, 0x59, 0x27, 0xb0, 0x10, 0x9f, 0x89, 0x56, 0x6e, 0x7f, 0x5b, 0x41,
+	0x4b, 0x0a, 0x77, 0x43, 0x07, 0xe2, 0xfb, 0xa8, 0x2e, 0x9b, 0x34, 0x70, 0x84, 0xa3, 0xcb, 0xf2,
+	0x86, 0x15, 0xd7, 0xda, 0xca, 0xd7, 0x3a, 0xd3, 0x95, 0x68, 0x29, 0x77, 0x63, 0xff, 0x33, 0x70,
+	0xc5, 0x0e, 0x08, 0xc7, 0xc6, 0x5a, 0x0d, 0x65, 0x36, 0x92, 0xb2, 0xca, 0x8b, 0xc7, 0x55, 0x8f,
+	0xaf, 0x7d, 0x7f, 0x16, 0xd7, 0x4e, 0xae, 0x73, 0x66, 0xf5, 0x7f, 0xc8, 0xaa, 0x5f, 0x51, 0x69,
+	0xec, 0xcf, 0x34, 0x8d, 0xe7, 0x77, 0xe1, 0x0f, 0x03, 0x9d, 0x2f, 0xe0, 0x3f, 0xf4, 0xb8, 0xc0,
+	0x77, 0xa7, 0x3a, 0x61, 0xbd, 0x58, 0x27, 0x64, 0xb4, 0xea, 0xc3, 0xaa, 0xd6, 0xab, 0x27, 0x96,
+	0x5c, 0x17, 0xbe, 0x36, 0x50, 0xcd, 0x13, 0x10, 0xc8, 0xe9, 0xab, 0x74, 0x9a, 0x97, 0xef, 0xce,
+	0xf2, 0xfe, 0xf6, 0x92, 0xce, 0xa4, 0xb6, 0x2d, 0x25, 0x49, 0xac, 0xdc, 0xfe, 0x79, 0x7e, 0xe2,
+	0xde, 0xb2, 0x41, 0xf8, 0xa1, 0x81, 0x50, 0xe0, 0x70, 0x01, 0xea, 0x38, 0xcb, 0xb7, 0x29, 0x77,
+	0x40, 0x36, 0xac, 0x3b, 0xa9, 0x26, 0xc9, 0xe9, 0xe3, 0xef, 0x0d, 0xd4, 0xe0, 0xbe, 0x33, 0x86,
+	0x7e, 0x36, 0xb3, 0xb3, 0xcb, 0xe6, 0xbc, 0xce, 0xa6, 0xd1, 0x4f, 0x24, 0x49, 0xa6, 0xde, 0xfe,
+	0xa5, 0x8a, 0x2e, 0x94, 0x0c, 0x16, 0xbe, 0x88, 0x6a, 0xa3, 0x43, 0x87, 0x83, 0x2a, 0x56, 0x23,
+	0xab, 0xf6, 0xae, 0x34, 0x92, 0xd8, 0x87, 0x5f, 0x47, 0x75, 0x06, 0x23, 0xdf, 0x73, 0x9d, 0x78,
+	0xe3, 0xd4, 0xb2, 0xf9, 0x20, 0xda, 0x4e, 0x52, 0x04, 0x7e, 0x1b, 0x2d, 0x31, 0x70, 0x06, 0xc7,
+	0x89, 0x4b, 0x3d, 0x93, 0x9a, 0xfd, 0x1f, 0x1d, 0xb2, 0x44, 0xf2, 0x4e, 0x52, 0xc4, 0x4a, 0x29,
+	0x0e, 0x3e, 0xb8, 0x82, 0x32, 0xb3, 0xaa, 0x52, 0x4a, 0xa5, 0xfa, 0xda, 0x4e, 0x52, 0x04, 0x76,
+	0x11, 0x72, 0x69, 0x38, 0xf0, 0x84, 0x47, 0x43, 0x6e, 0xd6, 0xd4, 0x38, 0x76, 0x5f, 0x6c, 0xd4,
+	0x7b, 0x49, 0x5c, 0xd6, 0xc6, 0xd4, 0xc4, 0x49, 0x8e, 0x16, 0x7f, 0x89, 0x16, 0xe2, 0xa6, 0x9a,
+	0x0b, 0x33, 0xdf, 0xb6, 0x48, 0xbe, 0xf1, 0x78, 0x9c, 0x88, 0x56, 0xc5, 0x0f, 0x50, 0x4d, 0xf5,
+	0xd1, 0x3c, 0x37, 0x73, 0xf9, 0x86, 0x6c, 0xbd, 0x9a, 0x1f, 0x12, 0x6b, 0xb6, 0x7f, 0x45, 0xfa,
+	0xab, 0xa7, 0x26, 0x7a, 0x03, 0x55, 0x43, 0x27, 0x48, 0x86, 0x25, 0xdd, 0x8e, 0x1f, 0x39, 0x01,
+	0x10, 0xe5, 0xc1, 0x9d, 0xa9, 0x51, 0x59, 0x3c, 0x63, 0x4c, 0x2e, 0xa2, 0x9a, 0x17, 0x38, 0x43,
+	0x30, 0x2b, 0xc5, 0xc9, 0xdb, 0x96, 0x46, 0x12, 0xfb, 0xf0, 0x4f, 0x06, 0x5a, 0x55, 0x7f, 0xed,
+	0x46, 0xbe, 0xdf, 0x07, 0x97, 0x81, 0xe0, 0x66, 0x55, 0xf5, 0xb9, 0x93, 0xeb, 0xb3, 0xe5, 0x52,
+	0x06, 0x6a, 0x81, 0x51, 0xd7, 0xf1, 0xe3, 0x6f, 0x07, 0x81, 0x03, 0x60, 0x10, 0xba, 0x60, 0xf7,
+	0x34, 0xf5, 0xea, 0xf6, 0x04, 0xd3, 0xb3, 0x93, 0xf5, 0xd7, 0xa6, 0xff, 0x31, 0x29, 0x25, 0x21,
+	0x53, 0x69, 0xe0, 0x3d, 0x54, 0x81, 0x70, 0xac, 0xa7, 0x6e, 0xad, 0x2c, 0x9b, 0xeb, 0xe1, 0x78,
+	0xcf, 0x61, 0x76, 0x47, 0xeb, 0x57, 0xae, 0x87, 0xe3, 0x67, 0x27, 0xeb, 0xff, 0x2f, 0x91, 0x8c,
+	0x91, 0x44, 0x12, 0xe2, 0x4f, 0x50, 0x23, 0x69, 0x1b, 0xd7, 0x23, 0x57, 0x7a, 0x57, 0xa2, 0x41,
+	0x04, 0x3e, 0x8f, 0x3c, 0x06, 0x01, 0x84, 0x82, 0x67, 0x5b, 0x20, 0xf1, 0x72, 0x92, 0xb1, 0xe1,
+	0x07, 0x68, 0x71, 0x4c, 0xfd, 0x28, 0x80, 0x1d, 0x1a, 0x85, 0x82, 0x9b, 0xe7, 0x54, 0xee, 0xeb,
+	0x65, 0xec, 0x7b, 0x19, 0xce, 0xbe, 0xa2, 0x49, 0x17, 0x73, 0x46, 0x59, 0xbc, 0x56, 0xc9, 0x4d,
+	0x72, 0x10, 0x52, 0x10, 0xc3, 0xdf, 0x19, 0x68, 0x59, 0x4e, 0xa8, 0x23, 0x5f, 0xe4, 0x2e, 0x65,
+	0x82, 0x9b, 0x75, 0xa5, 0xff, 0x4a, 0x99, 0x7e, 0x2f, 0x8f, 0xb4, 0xaf, 0xea, 0x0c, 0x96, 0x0b,
+	0x66, 0x99, 0xc3, 0x46, 0x49, 0x0e, 0x05, 0x10, 0x99, 0x10, 0x95, 0x45, 0xe0, 0xc0, 0xc6, 0x9e,
+	0x0b, 0x71, 0x12, 0x8d, 0xb3, 0x8b, 0xd0, 0xcf, 0x70, 0x59, 0x11, 0x72, 0xc6, 0xb3, 0x8a, 0x90,
+	0x83, 0x90, 0x82, 0x18, 0xbe, 0x8d, 0x9a, 0xfa, 0x7c, 0xf3, 0x78, 0x04, 0x26, 0x52, 0xb3, 0xff,
+	0x96, 0xa6, 0x6e, 0xf6, 0x33, 0xd7, 0xf3, 0x99, 0x25, 0x82, 0xe4, 0x99, 0xf0, 0x65, 0x84, 0xe2,
+	0x6a, 0xef, 0x3a, 0xe2, 0xd0, 0x6c, 0x2a, 0xde, 0x74, 0xb3, 0xed, 0xa5, 0x1e, 0x92, 0x43, 0xc9,
+	0xe7, 0xcc, 0xa8, 0x0f, 0xe6, 0x62, 0xf1, 0x39, 0x13, 0xea, 0x03, 0x51, 0x1e, 0xfc, 0x2e, 0x5a,
+	0xd5, 0x22, 0xb7, 0x0f, 0x3d, 0x01, 0xf2, 0xbf, 0x01, 0x73, 0x69, 0xc3, 0xe8, 0xd4, 0x6d, 0x33,
+	0x79, 0x54, 0xfd, 0x09, 0x3f, 0x99, 0x8a, 0xc0, 0xef, 0xa1, 0xba, 0x73, 0x70, 0xe0, 0x85, 0x9e,
+	0x38, 0x36, 0x97, 0xd5, 0x40, 0xbf, 0x54, 0x56, 0xed, 0x6b, 0x1a, 0x13, 0xaf, 0x8c, 0xe4, 0x44,
+	0xd2, 0x58, 0x7c, 0x0b, 0x35, 0x05, 0xf5, 0x81, 0x39, 0xf1, 0xbe, 0x5f, 0x51, 0x8d, 0x6b, 0x95,
+	0x51, 0xdd, 0x4c, 0x61, 0xf6, 0x85, 0xa4, 0xb8, 0x99, 0x8d, 0x93, 0x3c, 0x0f, 0xde, 0x47, 0x2b,
+	0xe9, 0xe3, 0xde, 0xa5, 0xbe, 0xe7, 0x1e, 0x9b, 0xab, 0xaa, 0x22, 0x5b, 0x3a, 0x74, 0x65, 0xbb,
+	0xe8, 0x7e, 0x76, 0xb2, 0xfe, 0x72, 0x49, 0x6f, 0x32, 0x00, 0x99, 0x24, 0x6c, 0xff, 0x59, 0x45,
+	0xcd, 0xdc, 0xa6, 0xc5, 0x1f, 0x20, 0x4c, 0xf7, 0x65, 0xa1, 0x60, 0xf0, 0x7e, 0xfc, 0x43, 0xc3,
+	0xa3, 0xa1, 0xda, 0xab, 0x15, 0x7b, 0x4d, 0xcb, 0xe2, 0x1b, 0x53, 0x08, 0x52, 0x12, 0xf5, 0x6f,
+	0x7e, 0x9e, 0xaf, 0xa1, 0x15, 0x37, 0x62, 0x0c, 0x42, 0x91, 0x86, 0x57, 0x55, 0xf8, 0xff, 0x92,
+	0x52, 0xf5, 0x8a, 0x6e, 0x32, 0x89, 0x97, 0x14, 0xd1, 0x68, 0x20, 0x7f, 0x58, 0xa5, 0x14, 0xb5,
+	0x22, 0xc5, 0xad, 0xa2, 0x9b, 0x4c, 0xe2, 0x0b, 0x59, 0x8c, 0x3d, 0x2e, 0x2b, 0xb7, 0xa0, 0x1a,
+	0x36, 0x9d, 0x45, 0xec, 0x26, 0x93, 0x78, 0xfc, 0x0e, 0x5a, 0x8e, 0x59, 0x53, 0x86, 0x73, 0x8a,
+	0xe1, 0xbf, 0xc9, 0xa2, 0xb9, 0x55, 0xf0, 0x92, 0x09, 0x34, 0xbe, 0x2a, 0x77, 0x99, 0xef, 0xab,
+	0x43, 0x4f, 0xee, 0x37, 0xb3, 0xa1, 0x2e, 0x81, 0xe3, 0x25, 0x95, 0xf7, 0x90, 0x09, 0xa4, 0x7c,
+	0x54, 0x2e, 0x0d, 0x43, 0x70, 0x65, 0xf7, 0xe2, 0xaf, 0x89, 0x5e, 0x04, 0xe9, 0xa3, 0xea, 0x4d,
+	0xf8, 0xc9, 0x54, 0x04, 0x7e, 0x15, 0x2d, 0x8c, 0x9c, 0x88, 0xc3, 0x40, 0x3d, 0xf6, 0x7a, 0xf6,
+	0x13, 0x61, 0x57, 0x59, 0x89, 0xf6, 0xda, 0x9d, 0x47, 0x4f, 0x5b, 0x73, 0x8f, 0x9f, 0xb6, 0xe6,
+	0x9e, 0x3c, 0x6d, 0xcd, 0x7d, 0x75, 0xda, 0x32, 0x1e, 0x9d, 0xb6, 0x8c, 0xc7, 0xa7, 0x2d, 0xe3,
+	0xc9, 0x69, 0xcb, 0xf8, 0xed, 0xb4, 0x65, 0xfc, 0xf8, 0x7b, 0x6b, 0xee, 0xce, 0xfc, 0xf8, 0xd2,
+	0x5f, 0x03, 0x00, 0xe9, 0xe7, 0xd5, 0x51, 0x82, 0x10, 0x00, 0x00,
 }
 
 func (m *RedisCore) Marshal() (dAtA []byte, err error) {
@@ -364,6 +406,16 @@ func (m *RedisOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -477,6 +529,83 @@ func (m *RedisOperatorSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RedisOperatorStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedisOperatorStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedisOperatorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Slave != nil {
+		{
+			size, err := m.Slave.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Master != nil {
+		{
+			size, err := m.Master.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i -= len(m.Selector)
+	copy(dAtA[i:], m.Selector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Selector)))
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ReadyReplicas))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Replicas))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RedisSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -497,6 +626,13 @@ func (m *RedisSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.ImagePullPolicy)
+	copy(dAtA[i:], m.ImagePullPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ImagePullPolicy)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
 	if len(m.Tolerations) > 0 {
 		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -664,6 +800,19 @@ func (m *RedisStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Paused {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x58
+	i -= len(m.ConnectionSecret)
+	copy(dAtA[i:], m.ConnectionSecret)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConnectionSecret)))
+	i--
+	dAtA[i] = 0x52
 	if m.CollisionCount != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.CollisionCount))
 		i--
@@ -731,6 +880,8 @@ func (m *RedisOperator) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -764,6 +915,35 @@ func (m *RedisOperatorSpec) Size() (n int) {
 	return n
 }
 
+func (m *RedisOperatorStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Replicas))
+	n += 1 + sovGenerated(uint64(m.ReadyReplicas))
+	l = len(m.Selector)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Master != nil {
+		l = m.Master.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Slave != nil {
+		l = m.Slave.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *RedisSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -826,6 +1006,8 @@ func (m *RedisSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.ImagePullPolicy)
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -847,6 +1029,9 @@ func (m *RedisStatus) Size() (n int) {
 	if m.CollisionCount != nil {
 		n += 1 + sovGenerated(uint64(*m.CollisionCount))
 	}
+	l = len(m.ConnectionSecret)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -874,6 +1059,7 @@ func (this *RedisOperator) String() string {
 	s := strings.Join([]string{`&RedisOperator{`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "RedisOperatorSpec", "RedisOperatorSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "RedisOperatorStatus", "RedisOperatorStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -905,6 +1091,27 @@ func (this *RedisOperatorSpec) String() string {
 	}, "")
 	return s
 }
+func (this *RedisOperatorStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForConditions += "}"
+	s := strings.Join([]string{`&RedisOperatorStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Replicas:` + fmt.Sprintf("%v", this.Replicas) + `,`,
+		`ReadyReplicas:` + fmt.Sprintf("%v", this.ReadyReplicas) + `,`,
+		`Selector:` + fmt.Sprintf("%v", this.Selector) + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`Master:` + strings.Replace(this.Master.String(), "RedisStatus", "RedisStatus", 1) + `,`,
+		`Slave:` + strings.Replace(this.Slave.String(), "RedisStatus", "RedisStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RedisSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -955,6 +1162,7 @@ func (this *RedisSpec) String() string {
 		`ServiceWhiteList:` + fmt.Sprintf("%v", this.ServiceWhiteList) + `,`,
 		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v11.Affinity", 1) + `,`,
 		`Tolerations:` + repeatedStringForTolerations + `,`,
+		`ImagePullPolicy:` + fmt.Sprintf("%v", this.ImagePullPolicy) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -972,6 +1180,8 @@ func (this *RedisStatus) String() string {
 		`CurrentRevision:` + fmt.Sprintf("%v", this.CurrentRevision) + `,`,
 		`UpdateRevision:` + fmt.Sprintf("%v", this.UpdateRevision) + `,`,
 		`CollisionCount:` + valueToStringGenerated(this.CollisionCount) + `,`,
+		`ConnectionSecret:` + fmt.Sprintf("%v", this.ConnectionSecret) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1198,6 +1408,39 @@ func (m *RedisOperator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -1461,6 +1704,267 @@ func (m *RedisOperatorSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RedisOperatorStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedisOperatorStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedisOperatorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyReplicas", wireType)
+			}
+			m.ReadyReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, v1.Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Master == nil {
+				m.Master = &RedisStatus{}
+			}
+			if err := m.Master.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slave", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Slave == nil {
+				m.Slave = &RedisStatus{}
+			}
+			if err := m.Slave.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RedisSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1963,6 +2467,38 @@ func (m *RedisSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImagePullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -2195,6 +2731,58 @@ func (m *RedisStatus) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.CollisionCount = &v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -2222,6 +2810,7 @@ func (m *RedisStatus) Unmarshal(dAtA []byte) error {
 func skipGenerated(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -2253,10 +2842,8 @@ func skipGenerated(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -2277,55 +2864,30 @@ func skipGenerated(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthGenerated
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthGenerated
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipGenerated(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthGenerated
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenerated
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenerated
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthGenerated = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowGenerated   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthGenerated        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenerated          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenerated = fmt.Errorf("proto: unexpected end of group")
 )
@@ -6,7 +6,6 @@ import (
 )
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Redis describes a RedisOperator resource
@@ -24,6 +23,34 @@ type RedisOperator struct {
 
 	// Spec is the custom resource spec
 	Spec RedisOperatorSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status is the overall status which was served by the status and the scale subresources
+	// +optional
+	Status RedisOperatorStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// RedisOperatorStatus is the overall status for a RedisOperator resource
+type RedisOperatorStatus struct {
+	// phase is Pending until the StatefulSets were rolled out, Ready afterwards, or Paused.
+	// +optional
+	Phase string `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase"`
+	// replicas is the number of the slaves, which was scaled by the scale subresource.
+	Replicas int32 `json:"replicas" protobuf:"varint,2,opt,name=replicas"`
+	// readyReplicas is the number of the ready pods of the master and the slaves.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty" protobuf:"varint,3,opt,name=readyReplicas"`
+	// selector is the label selector of the pods of the slaves, which was required by the scale subresource.
+	// +optional
+	Selector string `json:"selector,omitempty" protobuf:"bytes,4,opt,name=selector"`
+
+	// conditions are the conditions of the v2 API, which were kept here so that they survive the status subresource.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" protobuf:"bytes,5,rep,name=conditions"`
+	// master is the status of the master in spec.masterSpec.status, which was mirrored for the status subresource.
+	// +optional
+	Master *RedisStatus `json:"master,omitempty" protobuf:"bytes,6,opt,name=master"`
+	// slave is the status of the slaves in spec.slaveSpec.status, which was mirrored for the status subresource.
+	// +optional
+	Slave *RedisStatus `json:"slave,omitempty" protobuf:"bytes,7,opt,name=slave"`
 }
 
 // RedisSpec is the spec for a RedisOperator resource
@@ -117,6 +144,10 @@ type RedisSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,15,opt,name=tolerations"`
+	// Image pull policy of the containers.
+	// One of Always, Never, IfNotPresent. Defaults to Always.
+	// +optional
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty" protobuf:"bytes,16,opt,name=imagePullPolicy,casttype=k8s.io/api/core/v1.PullPolicy"`
 }
 
 // RedisSpecStatus is the status for a RedisOperator resource
@@ -153,6 +184,14 @@ type RedisStatus struct {
 	// newest ControllerRevision.
 	// +optional
 	CollisionCount *int32 `json:"collisionCount,omitempty" protobuf:"varint,9,opt,name=collisionCount"`
+
+	// connectionSecret is the name of the Secret which holds the rw and ro endpoints and the credentials.
+	// +optional
+	ConnectionSecret string `json:"connectionSecret,omitempty" protobuf:"bytes,10,opt,name=connectionSecret"`
+
+	// paused is true while the sync was paused by the annotation nevercase.io/paused.
+	// +optional
+	Paused bool `json:"paused,omitempty" protobuf:"varint,11,opt,name=paused"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -23,6 +23,7 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -50,6 +51,7 @@ func (in *RedisOperator) DeepCopyInto(out *RedisOperator) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -122,6 +124,39 @@ func (in *RedisOperatorSpec) DeepCopy() *RedisOperatorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisOperatorStatus) DeepCopyInto(out *RedisOperatorStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Master != nil {
+		in, out := &in.Master, &out.Master
+		*out = new(RedisStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Slave != nil {
+		in, out := &in.Slave, &out.Slave
+		*out = new(RedisStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisOperatorStatus.
+func (in *RedisOperatorStatus) DeepCopy() *RedisOperatorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisOperatorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedisSpec) DeepCopyInto(out *RedisSpec) {
 	*out = *in
@@ -0,0 +1,180 @@
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/redisoperator/v1"
+)
+
+// ConversionAnnotation keeps the fields of v2 which v1 couldn't express on the v1 objects,
+// so that the v2 objects round-trip through the stored v1 ones losslessly
+const ConversionAnnotation = "nevercase.io/v2-fields"
+
+// fields are the fields of v2 which were kept in the ConversionAnnotation
+type fields struct {
+	Mode          RedisMode      `json:"mode,omitempty"`
+	Sentinel      *SentinelSpec  `json:"sentinel,omitempty"`
+	Cluster       *ClusterSpec   `json:"cluster,omitempty"`
+	MasterStorage *storageFields `json:"masterStorage,omitempty"`
+	SlaveStorage  *storageFields `json:"slaveStorage,omitempty"`
+	// Conditions were read from the annotations which were written before status.conditions of v1
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+type storageFields struct {
+	StorageClassName *string            `json:"storageClassName,omitempty"`
+	Size             *resource.Quantity `json:"size,omitempty"`
+}
+
+func newStorageFields(s StorageSpec) *storageFields {
+	if s.StorageClassName == nil && s.Size == nil {
+		return nil
+	}
+	return &storageFields{StorageClassName: s.StorageClassName, Size: s.Size}
+}
+
+func (f fields) empty() bool {
+	return f.Mode == "" && f.Sentinel == nil && f.Cluster == nil && f.MasterStorage == nil && f.SlaveStorage == nil
+}
+
+// ConvertFromV1 returns the v2 object of in, the fields kept in the ConversionAnnotation were restored
+func ConvertFromV1(in *v1.RedisOperator) (*RedisOperator, error) {
+	in = in.DeepCopy()
+	out := &RedisOperator{
+		TypeMeta:   metav1.TypeMeta{APIVersion: SchemeGroupVersion.String(), Kind: in.Kind},
+		ObjectMeta: in.ObjectMeta,
+	}
+	f := fields{}
+	if v, ok := out.Annotations[ConversionAnnotation]; ok {
+		if err := json.Unmarshal([]byte(v), &f); err != nil {
+			return nil, fmt.Errorf("invalid annotation %s of %s/%s: %v", ConversionAnnotation, in.Namespace, in.Name, err)
+		}
+		delete(out.Annotations, ConversionAnnotation)
+		if len(out.Annotations) == 0 {
+			out.Annotations = nil
+		}
+	}
+	out.Spec = RedisOperatorSpec{
+		Mode:     f.Mode,
+		Master:   specFromV1(in.Spec.MasterSpec.Spec, f.MasterStorage),
+		Slave:    specFromV1(in.Spec.SlaveSpec.Spec, f.SlaveStorage),
+		Sentinel: f.Sentinel,
+		Cluster:  f.Cluster,
+	}
+	out.Status = RedisOperatorStatus{
+		Phase:         in.Status.Phase,
+		Replicas:      in.Status.Replicas,
+		ReadyReplicas: in.Status.ReadyReplicas,
+		Selector:      in.Status.Selector,
+		Conditions:    in.Status.Conditions,
+		Master:        RedisStatus(in.Spec.MasterSpec.Status),
+		Slave:         RedisStatus(in.Spec.SlaveSpec.Status),
+	}
+	if len(out.Status.Conditions) == 0 {
+		out.Status.Conditions = f.Conditions
+	}
+	// The mirrors in the status were what the status subresource has written
+	if in.Status.Master != nil {
+		out.Status.Master = RedisStatus(*in.Status.Master)
+	}
+	if in.Status.Slave != nil {
+		out.Status.Slave = RedisStatus(*in.Status.Slave)
+	}
+	return out, nil
+}
+
+// ConvertToV1 returns the v1 object of in, the fields which v1 couldn't express were kept in the ConversionAnnotation
+func ConvertToV1(in *RedisOperator) (*v1.RedisOperator, error) {
+	in = in.DeepCopy()
+	out := &v1.RedisOperator{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1.SchemeGroupVersion.String(), Kind: in.Kind},
+		ObjectMeta: in.ObjectMeta,
+	}
+	f := fields{
+		Mode:          in.Spec.Mode,
+		Sentinel:      in.Spec.Sentinel,
+		Cluster:       in.Spec.Cluster,
+		MasterStorage: newStorageFields(in.Spec.Master.Storage),
+		SlaveStorage:  newStorageFields(in.Spec.Slave.Storage),
+	}
+	if f.empty() {
+		delete(out.Annotations, ConversionAnnotation)
+		if len(out.Annotations) == 0 {
+			out.Annotations = nil
+		}
+	} else {
+		b, err := json.Marshal(f)
+		if err != nil {
+			return nil, err
+		}
+		if out.Annotations == nil {
+			out.Annotations = map[string]string{}
+		}
+		out.Annotations[ConversionAnnotation] = string(b)
+	}
+	out.Spec = v1.RedisOperatorSpec{
+		MasterSpec: v1.RedisCore{Spec: specToV1(in.Spec.Master), Status: v1.RedisStatus(in.Status.Master)},
+		SlaveSpec:  v1.RedisCore{Spec: specToV1(in.Spec.Slave), Status: v1.RedisStatus(in.Status.Slave)},
+	}
+	out.Status = v1.RedisOperatorStatus{
+		Phase:         in.Status.Phase,
+		Replicas:      in.Status.Replicas,
+		ReadyReplicas: in.Status.ReadyReplicas,
+		Selector:      in.Status.Selector,
+		Conditions:    in.Status.Conditions,
+	}
+	// Both the spec and the status were set, since either of them was discarded by the main resource or the status subresource
+	master, slave := v1.RedisStatus(in.Status.Master), v1.RedisStatus(in.Status.Slave)
+	out.Status.Master, out.Status.Slave = &master, &slave
+	return out, nil
+}
+
+func specFromV1(in v1.RedisSpec, storage *storageFields) RedisSpec {
+	out := RedisSpec{
+		Name:             in.Name,
+		Replicas:         in.Replicas,
+		Image:            in.Image,
+		ImagePullPolicy:  in.ImagePullPolicy,
+		ImagePullSecrets: in.ImagePullSecrets,
+		Env:              in.Env,
+		Resources:        in.Resources,
+		VolumeMounts:     in.VolumeMounts,
+		ContainerPorts:   in.ContainerPorts,
+		ServicePorts:     in.ServicePorts,
+		ServiceType:      in.ServiceType,
+		ServiceWhiteList: in.ServiceWhiteList,
+		Affinity:         in.Affinity,
+		Tolerations:      in.Tolerations,
+		Storage:          StorageSpec{VolumePath: in.VolumePath},
+		Role:             in.Role,
+	}
+	if storage != nil {
+		out.Storage.StorageClassName, out.Storage.Size = storage.StorageClassName, storage.Size
+	}
+	return out
+}
+
+func specToV1(in RedisSpec) v1.RedisSpec {
+	return v1.RedisSpec{
+		Name:             in.Name,
+		Replicas:         in.Replicas,
+		Image:            in.Image,
+		ImagePullPolicy:  in.ImagePullPolicy,
+		ImagePullSecrets: in.ImagePullSecrets,
+		Env:              in.Env,
+		Resources:        in.Resources,
+		VolumeMounts:     in.VolumeMounts,
+		ContainerPorts:   in.ContainerPorts,
+		ServicePorts:     in.ServicePorts,
+		ServiceType:      in.ServiceType,
+		ServiceWhiteList: in.ServiceWhiteList,
+		Affinity:         in.Affinity,
+		Tolerations:      in.Tolerations,
+		VolumePath:       in.Storage.VolumePath,
+		Role:             in.Role,
+	}
+}
@@ -0,0 +1,128 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/diff"
+
+	v1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/redisoperator/v1"
+)
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func newRedisSpec(name string) RedisSpec {
+	storageClassName, size := "ssd", resource.MustParse("10Gi")
+	return RedisSpec{
+		Name:            name,
+		Replicas:        int32Ptr(2),
+		Image:           "redis:5.0",
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Env:             []corev1.EnvVar{{Name: "TZ", Value: "UTC"}},
+		ContainerPorts:  []corev1.ContainerPort{{ContainerPort: 6379, Protocol: corev1.ProtocolTCP}},
+		ServicePorts:    []corev1.ServicePort{{Port: 6379, Protocol: corev1.ProtocolTCP}},
+		ServiceType:     corev1.ServiceTypeNodePort,
+		Storage:         StorageSpec{VolumePath: "/data", StorageClassName: &storageClassName, Size: &size},
+		Role:            "slave",
+	}
+}
+
+func TestConvertV2RoundTrip(t *testing.T) {
+	in := &RedisOperator{
+		TypeMeta:   metav1.TypeMeta{APIVersion: SchemeGroupVersion.String(), Kind: "RedisOperator"},
+		ObjectMeta: metav1.ObjectMeta{Name: "example-redis", Namespace: "default", Annotations: map[string]string{"owner": "ops"}},
+		Spec: RedisOperatorSpec{
+			Mode:     RedisModeSentinel,
+			Master:   newRedisSpec("master"),
+			Slave:    newRedisSpec("slave"),
+			Sentinel: &SentinelSpec{Replicas: int32Ptr(3), Image: "redis:5.0", Quorum: 2},
+		},
+		Status: RedisOperatorStatus{
+			Phase:      "Running",
+			Replicas:   2,
+			Conditions: []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Synced", LastTransitionTime: metav1.NewTime(time.Unix(1700000000, 0))}},
+			Slave:      RedisStatus{ObservedGeneration: 3, ReadyReplicas: 2},
+		},
+	}
+	stored, err := ConvertToV1(in)
+	if err != nil {
+		t.Fatalf("ConvertToV1() error = %v", err)
+	}
+	if _, ok := stored.Annotations[ConversionAnnotation]; !ok {
+		t.Errorf("the fields of v2 were not kept in the annotation %s", ConversionAnnotation)
+	}
+	out, err := ConvertFromV1(stored)
+	if err != nil {
+		t.Fatalf("ConvertFromV1() error = %v", err)
+	}
+	if !apiequality.Semantic.DeepEqual(in, out) {
+		t.Errorf("v2 -> v1 -> v2 lost data: %s", diff.ObjectReflectDiff(in, out))
+	}
+}
+
+func TestConvertV1RoundTrip(t *testing.T) {
+	in := &v1.RedisOperator{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1.SchemeGroupVersion.String(), Kind: "RedisOperator"},
+		ObjectMeta: metav1.ObjectMeta{Name: "example-redis", Namespace: "default"},
+		Spec: v1.RedisOperatorSpec{
+			MasterSpec: v1.RedisCore{Spec: specToV1(newRedisSpec("master")), Status: v1.RedisStatus{Replicas: 1}},
+			SlaveSpec:  v1.RedisCore{Spec: specToV1(newRedisSpec("slave"))},
+		},
+		Status: v1.RedisOperatorStatus{
+			Phase:    "Running",
+			Selector: "app=example-redis",
+			// The statuses of the spec were mirrored by the controller
+			Master: &v1.RedisStatus{Replicas: 1},
+			Slave:  &v1.RedisStatus{},
+		},
+	}
+	out, err := ConvertFromV1(in)
+	if err != nil {
+		t.Fatalf("ConvertFromV1() error = %v", err)
+	}
+	stored, err := ConvertToV1(out)
+	if err != nil {
+		t.Fatalf("ConvertToV1() error = %v", err)
+	}
+	if !apiequality.Semantic.DeepEqual(in, stored) {
+		t.Errorf("v1 -> v2 -> v1 lost data: %s", diff.ObjectReflectDiff(in, stored))
+	}
+}
+
+func TestConvertStatusSubresource(t *testing.T) {
+	created, err := ConvertToV1(&RedisOperator{
+		TypeMeta:   metav1.TypeMeta{APIVersion: SchemeGroupVersion.String(), Kind: "RedisOperator"},
+		ObjectMeta: metav1.ObjectMeta{Name: "example-redis", Namespace: "default"},
+		Spec:       RedisOperatorSpec{Master: newRedisSpec("master"), Slave: newRedisSpec("slave")},
+	})
+	if err != nil {
+		t.Fatalf("ConvertToV1() error = %v", err)
+	}
+	foo, err := ConvertFromV1(created)
+	if err != nil {
+		t.Fatalf("ConvertFromV1() error = %v", err)
+	}
+	foo.Status.Conditions = []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Synced", LastTransitionTime: metav1.NewTime(time.Unix(1700000000, 0))}}
+	foo.Status.Master = RedisStatus{ObservedGeneration: 2, Replicas: 1, ReadyReplicas: 1}
+	foo.Status.Slave = RedisStatus{ObservedGeneration: 2, Replicas: 2, ReadyReplicas: 1}
+	update, err := ConvertToV1(foo)
+	if err != nil {
+		t.Fatalf("ConvertToV1() error = %v", err)
+	}
+	// The status subresource keeps all but the status of the stored object
+	stored := created.DeepCopy()
+	stored.Status = update.Status
+	out, err := ConvertFromV1(stored)
+	if err != nil {
+		t.Fatalf("ConvertFromV1() error = %v", err)
+	}
+	if !apiequality.Semantic.DeepEqual(foo.Status, out.Status) {
+		t.Errorf("the update of the status subresource lost data: %s", diff.ObjectReflectDiff(foo.Status, out.Status))
+	}
+}
@@ -0,0 +1,6 @@
+// +k8s:deepcopy-gen=package
+
+// Package v2 is the nevercase.io/v2 API of the RedisOperator, which was served alongside v1
+// and converted from and into the stored v1 objects by the conversion webhook.
+// +groupName=nevercase.io
+package v2
@@ -0,0 +1,172 @@
+package v2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RedisMode is the topology of the redis servers
+type RedisMode string
+
+// The modes of the RedisOperator, only MasterSlave was reconciled by now
+const (
+	RedisModeMasterSlave RedisMode = "MasterSlave"
+	RedisModeSentinel    RedisMode = "Sentinel"
+	RedisModeCluster     RedisMode = "Cluster"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RedisOperator describes a RedisOperator resource
+type RedisOperator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec RedisOperatorSpec `json:"spec"`
+	// +optional
+	Status RedisOperatorStatus `json:"status,omitempty"`
+}
+
+// RedisOperatorSpec is the spec for a RedisOperator resource
+type RedisOperatorSpec struct {
+	// mode is the topology of the servers.
+	// +optional
+	Mode RedisMode `json:"mode,omitempty"`
+	// master is the spec of the master.
+	Master RedisSpec `json:"master"`
+	// slave is the spec of the slaves, which were scaled by the scale subresource.
+	Slave RedisSpec `json:"slave"`
+	// sentinel is the spec of the sentinels of the Sentinel mode.
+	// +optional
+	Sentinel *SentinelSpec `json:"sentinel,omitempty"`
+	// cluster is the spec of the shards of the Cluster mode.
+	// +optional
+	Cluster *ClusterSpec `json:"cluster,omitempty"`
+}
+
+// RedisSpec is the spec of the master or the slaves
+type RedisSpec struct {
+	// name of the servers specified as a DNS_LABEL, the children were named after it with the suffix of the role.
+	Name string `json:"name"`
+	// replicas is the number of desired replicas.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	Image string `json:"image"`
+	// +optional
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	// +optional
+	ContainerPorts []corev1.ContainerPort `json:"containerPorts,omitempty"`
+	// servicePorts are the ports of the Service of the role, the empty ones mean no Service.
+	// +optional
+	ServicePorts []corev1.ServicePort `json:"servicePorts,omitempty"`
+	// +optional
+	ServiceType corev1.ServiceType `json:"serviceType,omitempty"`
+	// +optional
+	ServiceWhiteList bool `json:"serviceWhiteList,omitempty"`
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// storage is where the data was kept.
+	Storage StorageSpec `json:"storage"`
+	// role was set by the controller in v1, it was kept for the round trip.
+	// +optional
+	Role string `json:"role,omitempty"`
+}
+
+// StorageSpec is the storage of the data of the servers
+type StorageSpec struct {
+	// volumePath is the path of the nas disk which was mounted on the machines.
+	// +optional
+	VolumePath string `json:"volumePath,omitempty"`
+	// storageClassName is the StorageClass of the PersistentVolumeClaims instead of the volume path.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// size is the requested size of the PersistentVolumeClaims.
+	// +optional
+	Size *resource.Quantity `json:"size,omitempty"`
+}
+
+// SentinelSpec is the spec of the sentinels which monitor the master
+type SentinelSpec struct {
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// image defaults to the image of the master.
+	// +optional
+	Image string `json:"image,omitempty"`
+	// quorum is the number of the sentinels which agree the master was down.
+	// +optional
+	Quorum int32 `json:"quorum,omitempty"`
+}
+
+// ClusterSpec is the spec of the shards of the redis cluster
+type ClusterSpec struct {
+	Shards int32 `json:"shards"`
+	// +optional
+	ReplicasPerShard int32 `json:"replicasPerShard,omitempty"`
+}
+
+// RedisOperatorStatus is the status for a RedisOperator resource
+type RedisOperatorStatus struct {
+	// +optional
+	Phase string `json:"phase,omitempty"`
+	// replicas is the number of the slaves, which was scaled by the scale subresource.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// +optional
+	Selector string `json:"selector,omitempty"`
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// master is the status of the StatefulSet of the master.
+	// +optional
+	Master RedisStatus `json:"master,omitempty"`
+	// slave is the status of the StatefulSet of the slaves.
+	// +optional
+	Slave RedisStatus `json:"slave,omitempty"`
+}
+
+// RedisStatus is the status of the StatefulSet of the master or the slaves
+type RedisStatus struct {
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// +optional
+	CurrentReplicas int32 `json:"currentReplicas,omitempty"`
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+	// +optional
+	CurrentRevision string `json:"currentRevision,omitempty"`
+	// +optional
+	UpdateRevision string `json:"updateRevision,omitempty"`
+	// +optional
+	CollisionCount *int32 `json:"collisionCount,omitempty"`
+	// +optional
+	ConnectionSecret string `json:"connectionSecret,omitempty"`
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RedisOperatorList is a list of RedisOperator resources
+type RedisOperatorList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata"`
+	Items           []RedisOperator `json:"items"`
+}
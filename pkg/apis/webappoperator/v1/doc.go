@@ -0,0 +1,6 @@
+// +k8s:deepcopy-gen=package
+
+// Package v1 is the nevercase.io/v1 API of the WebAppOperator, the stateless HTTP services
+// which were deployed as a Deployment with its Service, Ingress and HorizontalPodAutoscaler.
+// +groupName=nevercase.io
+package v1
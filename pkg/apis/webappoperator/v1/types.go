@@ -0,0 +1,123 @@
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WebAppOperator describes a stateless HTTP service
+type WebAppOperator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec WebAppOperatorSpec `json:"spec"`
+	// +optional
+	Status WebAppOperatorStatus `json:"status,omitempty"`
+}
+
+// WebAppOperatorSpec is the spec for a WebAppOperator resource, the children were named after the WebAppOperator
+type WebAppOperatorSpec struct {
+	// replicas is the number of the pods, it was left to the HorizontalPodAutoscaler once autoscaling was specified.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	Image string `json:"image"`
+	// +optional
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// command overrides the entrypoint of the image.
+	// +optional
+	Command []string `json:"command,omitempty"`
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	// containerPorts defaults to 8080/TCP.
+	// +optional
+	ContainerPorts []corev1.ContainerPort `json:"containerPorts,omitempty"`
+	// servicePorts default to the port 80 targeting the first container port.
+	// +optional
+	ServicePorts []corev1.ServicePort `json:"servicePorts,omitempty"`
+	// +optional
+	ServiceType corev1.ServiceType `json:"serviceType,omitempty"`
+	// +optional
+	LivenessProbe *corev1.Probe `json:"livenessProbe,omitempty"`
+	// +optional
+	ReadinessProbe *corev1.Probe `json:"readinessProbe,omitempty"`
+	// +optional
+	StartupProbe *corev1.Probe `json:"startupProbe,omitempty"`
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// ingress exposes the first service port on the host, no Ingress was created without it.
+	// +optional
+	Ingress *IngressSpec `json:"ingress,omitempty"`
+	// autoscaling scales the Deployment by the HorizontalPodAutoscaler, no HorizontalPodAutoscaler was created without it.
+	// +optional
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
+}
+
+// IngressSpec is the spec of the Ingress of the WebAppOperator
+type IngressSpec struct {
+	Host string `json:"host"`
+	// path defaults to /, which was matched by the prefix.
+	// +optional
+	Path string `json:"path,omitempty"`
+	// +optional
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+	// tlsSecretName enables the TLS of the host with the certificate of the Secret.
+	// +optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+	// annotations were copied onto the Ingress, e.g. the ones of the ingress controller.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// AutoscalingSpec is the spec of the HorizontalPodAutoscaler of the WebAppOperator
+type AutoscalingSpec struct {
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	MaxReplicas int32 `json:"maxReplicas"`
+	// targetCPUUtilizationPercentage defaults to 80 unless the memory target was specified.
+	// +optional
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+	// +optional
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+}
+
+// WebAppOperatorStatus is the status for a WebAppOperator resource, which was copied from the Deployment
+type WebAppOperatorStatus struct {
+	// +optional
+	Phase string `json:"phase,omitempty"`
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+	// +optional
+	Selector string `json:"selector,omitempty"`
+	// url is where the Ingress serves the WebAppOperator.
+	// +optional
+	URL string `json:"url,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WebAppOperatorList is a list of WebAppOperator resources
+type WebAppOperatorList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata"`
+	Items           []WebAppOperator `json:"items"`
+}
@@ -0,0 +1,299 @@
+// Package config reads the YAML configuration file of the multiplex controller, which describes the enabled
+// operators, their workers and namespaces, the Harbor hubs, the service load balancer and the leader election.
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/Shanghai-Lunara/helixsaga-operator/pkg/serviceloadbalancer"
+	harbor "github.com/nevercase/harbor-api"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/leaderelection"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DefaultLeaderElectionName is the name of the Lease which was held by the leader
+	DefaultLeaderElectionName = "multiplex-controller"
+)
+
+// Config is the configuration file of the multiplex controller.
+// The operators, the workers, the Harbor hubs, the leader election and the dry run were structural, they were read
+// at startup only. The namespaces and the load balancer were applied again once the file was reloaded.
+type Config struct {
+	// Operators were the enabled operators, the empty list enables all of them
+	Operators []Operator `json:"operators,omitempty"`
+	// Workers is the number of the workers of each operator which has no Workers of its own
+	Workers int `json:"workers,omitempty"`
+	// Namespaces restricts the operators to the custom resources of the namespaces, the empty list means all of them
+	Namespaces     []string       `json:"namespaces,omitempty"`
+	Harbor         []Harbor       `json:"harbor,omitempty"`
+	LoadBalancer   LoadBalancer   `json:"loadBalancer,omitempty"`
+	LeaderElection LeaderElection `json:"leaderElection,omitempty"`
+	DryRun         bool           `json:"dryRun,omitempty"`
+}
+
+// Operator enables the operator of Kind, e.g. RedisOperator
+type Operator struct {
+	Kind    string `json:"kind"`
+	Workers int    `json:"workers,omitempty"`
+}
+
+// Harbor is a Harbor hub which the HelixSaga pulls the tags of the images from
+type Harbor struct {
+	URL      string     `json:"url"`
+	Admin    Credential `json:"admin"`
+	Password Credential `json:"password"`
+}
+
+// Credential was read from exactly one of the inline Value, the File and the environment variable Env,
+// so that the secrets could be mounted rather than written in the config
+type Credential struct {
+	Value string `json:"value,omitempty"`
+	File  string `json:"file,omitempty"`
+	Env   string `json:"env,omitempty"`
+}
+
+// LoadBalancer holds the annotations of the Services of the type LoadBalancer, either inline or in the ConfigFile
+// of the format of the -serviceloadbalacnerConfig flag
+type LoadBalancer struct {
+	ConfigFile   string            `json:"configFile,omitempty"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+	WhiteListOn  map[string]string `json:"whiteListOn,omitempty"`
+	WhiteListOff map[string]string `json:"whiteListOff,omitempty"`
+}
+
+// LeaderElection configures the Lease which keeps a single replica running the workers,
+// the zero durations default to the ones of the kube-controller-manager
+type LeaderElection struct {
+	Enabled       bool            `json:"enabled,omitempty"`
+	Namespace     string          `json:"namespace,omitempty"`
+	Name          string          `json:"name,omitempty"`
+	LeaseDuration metav1.Duration `json:"leaseDuration,omitempty"`
+	RenewDeadline metav1.Duration `json:"renewDeadline,omitempty"`
+	RetryPeriod   metav1.Duration `json:"retryPeriod,omitempty"`
+}
+
+// Load reads the Config of path, whose defaults were filled and which was validated against the known operator kinds
+func Load(path string, kinds []string) (*Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c := &Config{}
+	if err = yaml.UnmarshalStrict(data, c); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %v", path, err)
+	}
+	c.setDefaults()
+	if err = c.Validate(kinds); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %v", path, err)
+	}
+	return c, nil
+}
+
+func (c *Config) setDefaults() {
+	le := &c.LeaderElection
+	if le.Name == "" {
+		le.Name = DefaultLeaderElectionName
+	}
+	if le.LeaseDuration.Duration == 0 {
+		le.LeaseDuration.Duration = 15 * time.Second
+	}
+	if le.RenewDeadline.Duration == 0 {
+		le.RenewDeadline.Duration = 10 * time.Second
+	}
+	if le.RetryPeriod.Duration == 0 {
+		le.RetryPeriod.Duration = 2 * time.Second
+	}
+}
+
+// Validate returns the first problem of c, the operators must be of kinds
+func (c *Config) Validate(kinds []string) error {
+	known := make(map[string]bool, len(kinds))
+	for _, k := range kinds {
+		known[k] = true
+	}
+	seen := make(map[string]bool, len(c.Operators))
+	for _, o := range c.Operators {
+		if !known[o.Kind] {
+			return fmt.Errorf("unknown operator %q, expected one of %s", o.Kind, strings.Join(kinds, ", "))
+		}
+		if seen[o.Kind] {
+			return fmt.Errorf("duplicate operator %s", o.Kind)
+		}
+		seen[o.Kind] = true
+		if o.Workers < 0 {
+			return fmt.Errorf("the workers of %s must not be negative", o.Kind)
+		}
+	}
+	if c.Workers < 0 {
+		return fmt.Errorf("the workers must not be negative")
+	}
+	for _, ns := range c.Namespaces {
+		if errs := validation.IsDNS1123Label(ns); len(errs) > 0 {
+			return fmt.Errorf("invalid namespace %q: %s", ns, strings.Join(errs, ", "))
+		}
+	}
+	for i, h := range c.Harbor {
+		if h.URL == "" {
+			return fmt.Errorf("the url of the harbor %d must be specified", i)
+		}
+		if err := h.Admin.validate(); err != nil {
+			return fmt.Errorf("invalid admin of the harbor %s: %v", h.URL, err)
+		}
+		if err := h.Password.validate(); err != nil {
+			return fmt.Errorf("invalid password of the harbor %s: %v", h.URL, err)
+		}
+	}
+	lb := c.LoadBalancer
+	if lb.ConfigFile != "" && (len(lb.Annotations) > 0 || len(lb.WhiteListOn) > 0 || len(lb.WhiteListOff) > 0) {
+		return fmt.Errorf("the configFile and the inline annotations of the loadBalancer are mutually exclusive")
+	}
+	return c.LeaderElection.validate()
+}
+
+// Enabled returns true if the operator of kind was enabled
+func (c *Config) Enabled(kind string) bool {
+	if len(c.Operators) == 0 {
+		return true
+	}
+	for _, o := range c.Operators {
+		if o.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// HarborConfigs resolves the credentials of the Harbor hubs
+func (c *Config) HarborConfigs() ([]harbor.Config, error) {
+	res := make([]harbor.Config, 0, len(c.Harbor))
+	for _, h := range c.Harbor {
+		admin, err := h.Admin.Resolve()
+		if err != nil {
+			return nil, fmt.Errorf("the admin of the harbor %s: %v", h.URL, err)
+		}
+		password, err := h.Password.Resolve()
+		if err != nil {
+			return nil, fmt.Errorf("the password of the harbor %s: %v", h.URL, err)
+		}
+		res = append(res, harbor.Config{Url: h.URL, Admin: admin, Password: password})
+	}
+	return res, nil
+}
+
+// RestartRequired returns the structural fields which were changed from c to next,
+// the changes of them were not applied until the controller was restarted
+func (c *Config) RestartRequired(next *Config) []string {
+	res := make([]string, 0)
+	if !reflect.DeepEqual(c.Operators, next.Operators) {
+		res = append(res, "operators")
+	}
+	if c.Workers != next.Workers {
+		res = append(res, "workers")
+	}
+	if !reflect.DeepEqual(c.Harbor, next.Harbor) {
+		res = append(res, "harbor")
+	}
+	if c.LeaderElection != next.LeaderElection {
+		res = append(res, "leaderElection")
+	}
+	if c.DryRun != next.DryRun {
+		res = append(res, "dryRun")
+	}
+	return res
+}
+
+func (c Credential) validate() error {
+	n := 0
+	for _, v := range []string{c.Value, c.File, c.Env} {
+		if v != "" {
+			n++
+		}
+	}
+	if n != 1 {
+		return fmt.Errorf("exactly one of the value, the file and the env must be specified")
+	}
+	return nil
+}
+
+// Resolve returns the value of c, the trailing newline of the File was trimmed
+func (c Credential) Resolve() (string, error) {
+	switch {
+	case c.File != "":
+		data, err := ioutil.ReadFile(c.File)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	case c.Env != "":
+		v, ok := os.LookupEnv(c.Env)
+		if !ok || v == "" {
+			return "", fmt.Errorf("the environment variable %s was not set", c.Env)
+		}
+		return v, nil
+	default:
+		return c.Value, nil
+	}
+}
+
+// Resolve returns the annotations of lb, which were read from the ConfigFile if it was specified
+func (lb LoadBalancer) Resolve() (*serviceloadbalancer.Annotations, error) {
+	res := &serviceloadbalancer.Annotations{
+		Annotations:  lb.Annotations,
+		WhiteListOn:  lb.WhiteListOn,
+		WhiteListOff: lb.WhiteListOff,
+	}
+	if lb.ConfigFile != "" {
+		data, err := ioutil.ReadFile(lb.ConfigFile)
+		if err != nil {
+			return nil, err
+		}
+		file := LoadBalancer{}
+		if err = yaml.UnmarshalStrict(data, &file); err != nil {
+			return nil, fmt.Errorf("invalid load balancer config %s: %v", lb.ConfigFile, err)
+		}
+		if file.ConfigFile != "" {
+			return nil, fmt.Errorf("invalid load balancer config %s: the configFile could not be nested", lb.ConfigFile)
+		}
+		res = &serviceloadbalancer.Annotations{
+			Annotations:  file.Annotations,
+			WhiteListOn:  file.WhiteListOn,
+			WhiteListOff: file.WhiteListOff,
+		}
+	}
+	for _, m := range []*map[string]string{&res.Annotations, &res.WhiteListOn, &res.WhiteListOff} {
+		if *m == nil {
+			*m = make(map[string]string)
+		}
+	}
+	return res, nil
+}
+
+// IsZero returns true if lb was left out of the config
+func (lb LoadBalancer) IsZero() bool {
+	return lb.ConfigFile == "" && len(lb.Annotations) == 0 && len(lb.WhiteListOn) == 0 && len(lb.WhiteListOff) == 0
+}
+
+// validate checks the durations like leaderelection.NewLeaderElector does
+func (le LeaderElection) validate() error {
+	if !le.Enabled {
+		return nil
+	}
+	if le.Namespace == "" {
+		return fmt.Errorf("the namespace of the leaderElection must be specified")
+	}
+	if le.LeaseDuration.Duration <= le.RenewDeadline.Duration {
+		return fmt.Errorf("the leaseDuration of the leaderElection must be greater than the renewDeadline")
+	}
+	if le.RenewDeadline.Duration <= time.Duration(leaderelection.JitterFactor*float64(le.RetryPeriod.Duration)) {
+		return fmt.Errorf("the renewDeadline of the leaderElection must be greater than %v times the retryPeriod", leaderelection.JitterFactor)
+	}
+	return nil
+}
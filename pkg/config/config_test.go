@@ -0,0 +1,145 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	harbor "github.com/nevercase/harbor-api"
+)
+
+var kinds = []string{"MysqlOperator", "RedisOperator", "HelixSaga"}
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	passwordFile := writeFile(t, dir, "password", "secret\n")
+	lbFile := writeFile(t, dir, "lb.yaml", "annotations:\n  a: b\nwhiteListOn:\n  c: d\n")
+	os.Setenv("TEST_HARBOR_ADMIN", "admin")
+	defer os.Unsetenv("TEST_HARBOR_ADMIN")
+	path := writeFile(t, dir, "config.yaml", `
+operators:
+  - kind: RedisOperator
+    workers: 20
+  - kind: HelixSaga
+workers: 5
+namespaces: [default, games]
+harbor:
+  - url: https://harbor.example.com
+    admin:
+      env: TEST_HARBOR_ADMIN
+    password:
+      file: `+passwordFile+`
+loadBalancer:
+  configFile: `+lbFile+`
+leaderElection:
+  enabled: true
+  namespace: kube-api
+  leaseDuration: 30s
+dryRun: true
+`)
+	c, err := Load(path, kinds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.Enabled("RedisOperator") || !c.Enabled("HelixSaga") || c.Enabled("MysqlOperator") {
+		t.Errorf("unexpected enabled operators %v", c.Operators)
+	}
+	if c.Workers != 5 || !c.DryRun || !reflect.DeepEqual(c.Namespaces, []string{"default", "games"}) {
+		t.Errorf("unexpected config %+v", c)
+	}
+	le := c.LeaderElection
+	if le.Name != DefaultLeaderElectionName || le.LeaseDuration.Duration != 30*time.Second || le.RenewDeadline.Duration != 10*time.Second {
+		t.Errorf("the defaults of the leader election were not filled %+v", le)
+	}
+	hubs, err := c.HarborConfigs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []harbor.Config{{Url: "https://harbor.example.com", Admin: "admin", Password: "secret"}}; !reflect.DeepEqual(hubs, want) {
+		t.Errorf("HarborConfigs() = %v, want %v", hubs, want)
+	}
+	a, err := c.LoadBalancer.Resolve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Annotations["a"] != "b" || a.WhiteListOn["c"] != "d" || a.WhiteListOff == nil {
+		t.Errorf("unexpected annotations %+v", a)
+	}
+}
+
+func TestLoadRefusesUnknownFields(t *testing.T) {
+	path := writeFile(t, t.TempDir(), "config.yaml", "worker: 5\n")
+	if _, err := Load(path, kinds); err == nil {
+		t.Error("expected the unknown field being refused")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		want   string
+	}{
+		{"unknown operator", Config{Operators: []Operator{{Kind: "Memcached"}}}, "unknown operator"},
+		{"duplicate operator", Config{Operators: []Operator{{Kind: "HelixSaga"}, {Kind: "HelixSaga"}}}, "duplicate operator"},
+		{"negative workers", Config{Operators: []Operator{{Kind: "HelixSaga", Workers: -1}}}, "must not be negative"},
+		{"invalid namespace", Config{Namespaces: []string{"Games"}}, "invalid namespace"},
+		{"missing harbor url", Config{Harbor: []Harbor{{Admin: Credential{Value: "a"}, Password: Credential{Value: "b"}}}}, "url"},
+		{"ambiguous credential", Config{Harbor: []Harbor{{URL: "h", Admin: Credential{Value: "a", Env: "A"}, Password: Credential{Value: "b"}}}}, "exactly one"},
+		{"missing credential", Config{Harbor: []Harbor{{URL: "h", Admin: Credential{Value: "a"}}}}, "exactly one"},
+		{"inline and file load balancer", Config{LoadBalancer: LoadBalancer{ConfigFile: "lb.yaml", Annotations: map[string]string{"a": "b"}}}, "mutually exclusive"},
+		{"leader election without namespace", Config{LeaderElection: LeaderElection{Enabled: true}}, "namespace"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.setDefaults()
+			err := tt.config.Validate(kinds)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Validate() = %v, want %q", err, tt.want)
+			}
+		})
+	}
+
+	c := Config{LeaderElection: LeaderElection{Enabled: true, Namespace: "kube-api"}}
+	c.setDefaults()
+	c.LeaderElection.RenewDeadline.Duration = c.LeaderElection.LeaseDuration.Duration
+	if err := c.Validate(kinds); err == nil {
+		t.Error("expected the renewDeadline which was not less than the leaseDuration being refused")
+	}
+}
+
+func TestCredentialResolve(t *testing.T) {
+	if _, err := (Credential{Env: "TEST_HARBOR_MISSING"}).Resolve(); err == nil {
+		t.Error("expected the missing environment variable being refused")
+	}
+	if _, err := (Credential{File: filepath.Join(t.TempDir(), "missing")}).Resolve(); err == nil {
+		t.Error("expected the missing file being refused")
+	}
+	if v, err := (Credential{Value: "inline"}).Resolve(); err != nil || v != "inline" {
+		t.Errorf("Resolve() = %q, %v", v, err)
+	}
+}
+
+func TestRestartRequired(t *testing.T) {
+	c := &Config{Operators: []Operator{{Kind: "HelixSaga"}}, Workers: 5, Namespaces: []string{"default"}}
+	next := &Config{Operators: []Operator{{Kind: "HelixSaga", Workers: 2}}, Workers: 5, Namespaces: []string{"games"}, DryRun: true,
+		LeaderElection: LeaderElection{Enabled: true, Namespace: "kube-api"}}
+	if got, want := c.RestartRequired(next), []string{"operators", "leaderElection", "dryRun"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RestartRequired() = %v, want %v", got, want)
+	}
+	if got := c.RestartRequired(c); len(got) != 0 {
+		t.Errorf("RestartRequired() of the same config = %v", got)
+	}
+}
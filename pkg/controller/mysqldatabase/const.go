@@ -0,0 +1,11 @@
+package mysqldatabase
+
+const OperatorKindName = "MysqlDatabase"
+
+const (
+	// SuccessSynced is used as part of the Event 'reason' when a MysqlDatabase is synced
+	SuccessSynced = "Synced"
+	// MessageResourceSynced is the message used for an Event fired when a MysqlDatabase
+	// is synced successfully
+	MessageResourceSynced = "Database %s was created in the master of %s"
+)
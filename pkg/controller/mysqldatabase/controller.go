@@ -0,0 +1,175 @@
+package mysqldatabase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	mysqlOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/controller/mysqloperator"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/env"
+	mysqlOperatorClientSet "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned"
+	mysqlOperatorScheme "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned/scheme"
+)
+
+// Reconciler creates the databases of the MysqlDatabases in the master of the referenced MysqlOperator
+type Reconciler struct {
+	clientSet mysqlOperatorClientSet.Interface
+}
+
+// NewReconciler returns the Reconciler which updates the MysqlDatabases with clientSet
+func NewReconciler(clientSet mysqlOperatorClientSet.Interface) *Reconciler {
+	return &Reconciler{clientSet: clientSet}
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, foo *mysqlOperatorV1.MysqlDatabase) (k8sCoreV1.Result, error) {
+	return k8sCoreV1.Result{}, Sync(ctx, foo, r.clientSet, k8sCoreV1.ResourceFromContext(ctx), k8sCoreV1.RecorderFromContext(ctx))
+}
+
+// Finalize drops the database if the MysqlDatabase was deleted with DropOnDelete
+func (r *Reconciler) Finalize(ctx context.Context, foo *mysqlOperatorV1.MysqlDatabase) error {
+	return finalize(ctx, foo, r.clientSet, k8sCoreV1.ResourceFromContext(ctx), k8sCoreV1.RecorderFromContext(ctx))
+}
+
+// ReconcilePaused sets the phase to Paused, the phase was refreshed by the sync once it was resumed
+func (r *Reconciler) ReconcilePaused(ctx context.Context, foo *mysqlOperatorV1.MysqlDatabase, paused bool) error {
+	if !paused {
+		return nil
+	}
+	return updateStatus(ctx, foo, r.clientSet, mysqloperator.MysqlPhasePaused, "")
+}
+
+func NewOption(controllerName string, cfg *rest.Config, stopCh <-chan struct{}) k8sCoreV1.Option {
+	c, err := mysqlOperatorClientSet.NewForConfig(cfg)
+	if err != nil {
+		klog.Fatalf("Error building clientSet: %s", err.Error())
+	}
+	return newOption(controllerName, c, stopCh)
+}
+
+func newOption(controllerName string, clientSet mysqlOperatorClientSet.Interface, stopCh <-chan struct{}) k8sCoreV1.Option {
+	return k8sCoreV1.NewBuilder[mysqlOperatorV1.MysqlDatabase, *mysqlOperatorV1.MysqlDatabaseList](clientSet.NevercaseV1().MysqlDatabases).
+		AgentName(controllerName).
+		Scheme(mysqlOperatorScheme.AddToScheme).
+		Reconciler(NewReconciler(clientSet)).
+		Build(stopCh)
+}
+
+func Sync(ctx context.Context, foo *mysqlOperatorV1.MysqlDatabase, clientSet mysqlOperatorClientSet.Interface, ks k8sCoreV1.KubernetesResource, recorder record.EventRecorder) error {
+	if foo.DeletionTimestamp != nil {
+		return finalize(ctx, foo, clientSet, ks, recorder)
+	}
+	// The finalizer was added or removed by the update, and the update event would bring the MysqlDatabase back
+	if updated, err := ensureFinalizer(ctx, foo, clientSet); err != nil || updated {
+		return err
+	}
+	operator, err := mysqloperator.GetOperator(ctx, clientSet, foo.Namespace, foo.Spec.Operator)
+	if err == nil {
+		err = mysqloperator.ExecMaster(ctx, ks, operator, createStatement(foo))
+	}
+	if err != nil {
+		recorder.Eventf(foo, coreV1.EventTypeWarning, mysqloperator.ErrMysqlExec, mysqloperator.MessageErrMysqlExec, foo.Spec.Operator, err)
+		if updateErr := updateStatus(ctx, foo, clientSet, mysqloperator.MysqlPhaseFailed, err.Error()); updateErr != nil {
+			klog.V(2).Info(updateErr)
+		}
+		return err
+	}
+	if err = updateStatus(ctx, foo, clientSet, mysqloperator.MysqlPhaseReady, ""); err != nil {
+		return err
+	}
+	recorder.Eventf(foo, coreV1.EventTypeNormal, SuccessSynced, MessageResourceSynced, databaseName(foo), foo.Spec.Operator)
+	return nil
+}
+
+func databaseName(foo *mysqlOperatorV1.MysqlDatabase) string {
+	if foo.Spec.Name != "" {
+		return foo.Spec.Name
+	}
+	return foo.Name
+}
+
+// createStatement returns the idempotent statement which creates the database.
+// The character set and the collation of an existing database were kept as they were.
+func createStatement(foo *mysqlOperatorV1.MysqlDatabase) mysqloperator.Statement {
+	query := fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", mysqloperator.QuoteIdentifier(databaseName(foo)))
+	if foo.Spec.CharacterSet != "" {
+		query += fmt.Sprintf(" CHARACTER SET %s", mysqloperator.QuoteIdentifier(foo.Spec.CharacterSet))
+	}
+	if foo.Spec.Collation != "" {
+		query += fmt.Sprintf(" COLLATE %s", mysqloperator.QuoteIdentifier(foo.Spec.Collation))
+	}
+	return mysqloperator.Statement{Query: query}
+}
+
+// finalize drops the database if the MysqlDatabase was deleted with DropOnDelete,
+// and then releases the MysqlDatabase by removing the finalizer
+func finalize(ctx context.Context, foo *mysqlOperatorV1.MysqlDatabase, clientSet mysqlOperatorClientSet.Interface, ks k8sCoreV1.KubernetesResource, recorder record.EventRecorder) error {
+	if !k8sCoreV1.HasFinalizer(foo, mysqloperator.MysqlDropFinalizer) {
+		return nil
+	}
+	if foo.Spec.DropOnDelete {
+		operator, err := mysqloperator.GetOperator(ctx, clientSet, foo.Namespace, foo.Spec.Operator)
+		if err == nil {
+			err = mysqloperator.ExecMaster(ctx, ks, operator, mysqloperator.Statement{
+				Query: fmt.Sprintf("DROP DATABASE IF EXISTS %s", mysqloperator.QuoteIdentifier(databaseName(foo))),
+			})
+		}
+		if err != nil {
+			recorder.Eventf(foo, coreV1.EventTypeWarning, mysqloperator.ErrMysqlExec, mysqloperator.MessageErrMysqlExec, foo.Spec.Operator, err)
+			return err
+		}
+		recorder.Eventf(foo, coreV1.EventTypeNormal, mysqloperator.MysqlDropped, mysqloperator.MessageMysqlDropped, databaseName(foo), foo.Spec.Operator)
+	}
+	fooCopy := foo.DeepCopy()
+	k8sCoreV1.RemoveFinalizer(fooCopy, mysqloperator.MysqlDropFinalizer)
+	return update(ctx, fooCopy, clientSet, false)
+}
+
+// ensureFinalizer keeps the finalizer in line with the DropOnDelete, it reports whether the MysqlDatabase was updated
+func ensureFinalizer(ctx context.Context, foo *mysqlOperatorV1.MysqlDatabase, clientSet mysqlOperatorClientSet.Interface) (bool, error) {
+	has := k8sCoreV1.HasFinalizer(foo, mysqloperator.MysqlDropFinalizer)
+	if has == foo.Spec.DropOnDelete {
+		return false, nil
+	}
+	fooCopy := foo.DeepCopy()
+	if foo.Spec.DropOnDelete {
+		k8sCoreV1.AddFinalizer(fooCopy, mysqloperator.MysqlDropFinalizer)
+	} else {
+		k8sCoreV1.RemoveFinalizer(fooCopy, mysqloperator.MysqlDropFinalizer)
+	}
+	return true, update(ctx, fooCopy, clientSet, false)
+}
+
+func updateStatus(ctx context.Context, foo *mysqlOperatorV1.MysqlDatabase, clientSet mysqlOperatorClientSet.Interface, phase, message string) error {
+	// The update would bring the MysqlDatabase back, so that it was skipped if nothing changed
+	if foo.Status.Phase == phase && foo.Status.Message == message {
+		return nil
+	}
+	fooCopy := foo.DeepCopy()
+	fooCopy.Status.Phase = phase
+	fooCopy.Status.Message = message
+	return update(ctx, fooCopy, clientSet, true)
+}
+
+// update writes foo, or only its status through the status subresource if status was true
+func update(ctx context.Context, foo *mysqlOperatorV1.MysqlDatabase, clientSet mysqlOperatorClientSet.Interface, status bool) error {
+	if k8sCoreV1.SuppressedByDryRun(OperatorKindName, foo) {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	var err error
+	if status {
+		_, err = clientSet.NevercaseV1().MysqlDatabases(foo.Namespace).UpdateStatus(ctx, foo, metaV1.UpdateOptions{})
+	} else {
+		_, err = clientSet.NevercaseV1().MysqlDatabases(foo.Namespace).Update(ctx, foo, metaV1.UpdateOptions{})
+	}
+	return err
+}
@@ -0,0 +1,39 @@
+package mysqldatabase
+
+import (
+	"testing"
+
+	mysqlOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+)
+
+func TestCreateStatement(t *testing.T) {
+	tests := []struct {
+		name string
+		spec mysqlOperatorV1.MysqlDatabaseSpec
+		want string
+	}{
+		{
+			name: "defaults to the name of the MysqlDatabase",
+			want: "CREATE DATABASE IF NOT EXISTS `shop`",
+		},
+		{
+			name: "sets the character set and the collation",
+			spec: mysqlOperatorV1.MysqlDatabaseSpec{Name: "orders", CharacterSet: "utf8mb4", Collation: "utf8mb4_general_ci"},
+			want: "CREATE DATABASE IF NOT EXISTS `orders` CHARACTER SET `utf8mb4` COLLATE `utf8mb4_general_ci`",
+		},
+		{
+			name: "quotes the backticks of the name",
+			spec: mysqlOperatorV1.MysqlDatabaseSpec{Name: "a`b"},
+			want: "CREATE DATABASE IF NOT EXISTS `a``b`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			foo := &mysqlOperatorV1.MysqlDatabase{Spec: tt.spec}
+			foo.Name = "shop"
+			if got := createStatement(foo); got.Query != tt.want || len(got.Args) != 0 {
+				t.Errorf("createStatement() = %+v, want %s", got, tt.want)
+			}
+		})
+	}
+}
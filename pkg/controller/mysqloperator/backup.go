@@ -0,0 +1,363 @@
+package mysqloperator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	appsV1 "k8s.io/api/apps/v1"
+	batchV1 "k8s.io/api/batch/v1"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	mysqlOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/env"
+	mysqlOperatorClientSet "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned"
+)
+
+// archiveVolume returns the volume where the binlogs and the full dumps of the master were stored
+func archiveVolume(foo *mysqlOperatorV1.MysqlOperator) coreV1.Volume {
+	t := coreV1.HostPathDirectoryOrCreate
+	masterName := fmt.Sprintf("%s-%s", foo.Spec.MasterSpec.Spec.Name, k8sCoreV1.MasterName)
+	return coreV1.Volume{
+		Name: MysqlBackupArchiveVolume,
+		VolumeSource: coreV1.VolumeSource{
+			HostPath: &coreV1.HostPathVolumeSource{
+				Type: &t,
+				Path: fmt.Sprintf("%s/%s/mysql-archive/%s", foo.Spec.Backup.ArchivePath, foo.Namespace, masterName),
+			},
+		},
+	}
+}
+
+// backupEnv returns the environment of the backup sidecar and the restore job, they connect to host with the root account of the Secret
+func backupEnv(foo *mysqlOperatorV1.MysqlOperator, host, port string) []coreV1.EnvVar {
+	return []coreV1.EnvVar{
+		{
+			Name:  MysqlBackupHost,
+			Value: host,
+		},
+		{
+			Name:  MysqlBackupPort,
+			Value: port,
+		},
+		rootEnv(foo, MysqlBackupUser, k8sCoreV1.ConnectionUsername),
+		rootEnv(foo, MysqlBackupPassword, k8sCoreV1.ConnectionPassword),
+		{
+			Name:  MysqlBackupArchive,
+			Value: MysqlBackupArchiveDir,
+		},
+		{
+			// mysqlbinlog interprets --stop-datetime in the local time zone
+			Name:  "TZ",
+			Value: "UTC",
+		},
+	}
+}
+
+// NewBackupContainer returns the container which ships the binlogs of the master at host
+// to the archive storage continuously and takes the full dumps periodically.
+func NewBackupContainer(foo *mysqlOperatorV1.MysqlOperator, host, port string) coreV1.Container {
+	interval := int32(MysqlBackupDefaultFullDumpIntervalSeconds)
+	if foo.Spec.Backup.FullDumpIntervalSeconds != nil {
+		interval = *foo.Spec.Backup.FullDumpIntervalSeconds
+	}
+	retention := int32(MysqlBackupDefaultRetentionCount)
+	if foo.Spec.Backup.RetentionCount != nil {
+		retention = *foo.Spec.Backup.RetentionCount
+	}
+	envs := append(backupEnv(foo, host, port),
+		coreV1.EnvVar{
+			Name:  MysqlBackupFullDumpInterval,
+			Value: strconv.Itoa(int(interval)),
+		},
+		coreV1.EnvVar{
+			Name:  MysqlBackupRetentionCount,
+			Value: strconv.Itoa(int(retention)),
+		},
+	)
+	return coreV1.Container{
+		Name:      MysqlBackupContainerName,
+		Image:     foo.Spec.Backup.Image,
+		Command:   []string{"bash", "/backup.sh"},
+		Env:       envs,
+		Resources: foo.Spec.Backup.Resources,
+		VolumeMounts: []coreV1.VolumeMount{
+			{
+				MountPath: MysqlBackupArchiveDir,
+				Name:      MysqlBackupArchiveVolume,
+			},
+		},
+		ImagePullPolicy: foo.Spec.MasterSpec.Spec.ImagePullPolicy,
+	}
+}
+
+// masterServicePort returns the port of the read-write Service, which was the first one of the master
+func masterServicePort(foo *mysqlOperatorV1.MysqlOperator) string {
+	if len(foo.Spec.MasterSpec.Spec.ServicePorts) > 0 {
+		return strconv.Itoa(int(foo.Spec.MasterSpec.Spec.ServicePorts[0].Port))
+	}
+	return strconv.Itoa(MysqlDefaultPort)
+}
+
+func backupDeploymentName(foo *mysqlOperatorV1.MysqlOperator) string {
+	return fmt.Sprintf(MysqlBackupDeploymentTemplate, masterRdsName(foo))
+}
+
+// NewBackupDeployment returns the Deployment of the backup container. It connects to the read-write Service
+// rather than running beside the master, so that the archiving goes on with the promoted slave after a failover.
+// The single replica was recreated rather than surged, two of them would ship the same binlogs into the archive.
+func NewBackupDeployment(foo *mysqlOperatorV1.MysqlOperator) *appsV1.Deployment {
+	labels := map[string]string{
+		k8sCoreV1.LabelApp:        OperatorKindName,
+		k8sCoreV1.LabelController: foo.Name,
+		k8sCoreV1.LabelRole:       MysqlBackupRole,
+	}
+	replicas := int32(1)
+	return &appsV1.Deployment{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      backupDeploymentName(foo),
+			Namespace: foo.Namespace,
+			OwnerReferences: []metaV1.OwnerReference{
+				*metaV1.NewControllerRef(foo, mysqlOperatorV1.SchemeGroupVersion.WithKind(OperatorKindName)),
+			},
+			Labels: labels,
+		},
+		Spec: appsV1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metaV1.LabelSelector{MatchLabels: labels},
+			Strategy: appsV1.DeploymentStrategy{Type: appsV1.RecreateDeploymentStrategyType},
+			Template: coreV1.PodTemplateSpec{
+				ObjectMeta: metaV1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: coreV1.PodSpec{
+					Volumes:          []coreV1.Volume{archiveVolume(foo)},
+					Containers:       []coreV1.Container{NewBackupContainer(foo, k8sCoreV1.GetReadWriteServiceName(foo.Name), masterServicePort(foo))},
+					ImagePullSecrets: foo.Spec.MasterSpec.Spec.ImagePullSecrets,
+					Affinity:         foo.Spec.MasterSpec.Spec.Affinity,
+					Tolerations:      foo.Spec.MasterSpec.Spec.Tolerations,
+				},
+			},
+		},
+	}
+}
+
+// backupDeployment creates the Deployment of the backup, updates it once its container changed,
+// and deletes it once the backup was disabled
+func backupDeployment(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator, recorder record.EventRecorder) error {
+	name := backupDeploymentName(foo)
+	d, err := ks.Deployment().Get(foo.Namespace, name)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if errors.IsNotFound(err) {
+		if foo.Spec.Backup == nil {
+			return nil
+		}
+		klog.Info("new backup deployment:", name)
+		_, err = ks.Deployment().Create(foo.Namespace, NewBackupDeployment(foo))
+		return err
+	}
+	if d, err = k8sCoreV1.ClaimDeployment(ks.Deployment(), foo, ownerKind, d, recorder); err != nil {
+		return err
+	}
+	if foo.Spec.Backup == nil {
+		return ks.Deployment().Delete(foo.Namespace, name)
+	}
+	desired := NewBackupDeployment(foo)
+	if equality.Semantic.DeepEqual(d.Spec.Template.Spec.Containers, desired.Spec.Template.Spec.Containers) {
+		return nil
+	}
+	desired.ResourceVersion = d.ResourceVersion
+	_, err = ks.Deployment().Update(foo.Namespace, desired)
+	return err
+}
+
+// hasBackupContainer reports whether the StatefulSet still runs the backup beside the master as the former releases did,
+// the StatefulSet was updated without it
+func hasBackupContainer(ss *appsV1.StatefulSet) bool {
+	for _, c := range ss.Spec.Template.Spec.Containers {
+		if c.Name == MysqlBackupContainerName {
+			return true
+		}
+	}
+	return false
+}
+
+func restoreJobName(foo *mysqlOperatorV1.MysqlOperator, t time.Time) string {
+	masterName := fmt.Sprintf("%s-%s", foo.Spec.MasterSpec.Spec.Name, k8sCoreV1.MasterName)
+	return fmt.Sprintf(MysqlBackupRestoreTemplate, masterName, t.Unix())
+}
+
+// NewRestoreJob returns the job which restores the latest full dump taken before t
+// into the master and replays the archived binlogs up to t without logging them,
+// and then reseeds the slaves of the addresses in the same way.
+func NewRestoreJob(foo *mysqlOperatorV1.MysqlOperator, t time.Time, slaves []string) *batchV1.Job {
+	labels := map[string]string{
+		k8sCoreV1.LabelApp:        OperatorKindName,
+		k8sCoreV1.LabelController: foo.Name,
+		k8sCoreV1.LabelRole:       MysqlBackupRestoreRole,
+	}
+	// The read-write Service follows the current master once a slave was promoted
+	envs := append(backupEnv(foo, k8sCoreV1.GetReadWriteServiceName(foo.Name), masterServicePort(foo)),
+		coreV1.EnvVar{
+			Name:  MysqlBackupRestoreToTime,
+			Value: t.UTC().Format(time.RFC3339),
+		},
+		coreV1.EnvVar{
+			Name:  MysqlBackupSlaveHosts,
+			Value: strings.Join(slaves, " "),
+		},
+	)
+	backoffLimit := int32(0)
+	return &batchV1.Job{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      restoreJobName(foo, t),
+			Namespace: foo.Namespace,
+			OwnerReferences: []metaV1.OwnerReference{
+				*metaV1.NewControllerRef(foo, mysqlOperatorV1.SchemeGroupVersion.WithKind(OperatorKindName)),
+			},
+			Labels: labels,
+		},
+		Spec: batchV1.JobSpec{
+			// A half-replayed restore must be inspected by a human instead of being retried
+			BackoffLimit: &backoffLimit,
+			Template: coreV1.PodTemplateSpec{
+				ObjectMeta: metaV1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: coreV1.PodSpec{
+					RestartPolicy: coreV1.RestartPolicyNever,
+					Volumes:       []coreV1.Volume{archiveVolume(foo)},
+					Containers: []coreV1.Container{
+						{
+							Name:      k8sCoreV1.GetContainerName(MysqlBackupRestoreRole),
+							Image:     foo.Spec.Backup.Image,
+							Command:   []string{"bash", "/restore.sh"},
+							Env:       envs,
+							Resources: foo.Spec.Backup.Resources,
+							VolumeMounts: []coreV1.VolumeMount{
+								{
+									MountPath: MysqlBackupArchiveDir,
+									Name:      MysqlBackupArchiveVolume,
+								},
+							},
+							ImagePullPolicy: foo.Spec.MasterSpec.Spec.ImagePullPolicy,
+						},
+					},
+					ImagePullSecrets: foo.Spec.MasterSpec.Spec.ImagePullSecrets,
+					Affinity:         foo.Spec.MasterSpec.Spec.Affinity,
+					Tolerations:      foo.Spec.MasterSpec.Spec.Tolerations,
+				},
+			},
+		},
+	}
+}
+
+// restoreSlaves returns the addresses of the running slaves which were reseeded by the restore job
+func restoreSlaves(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator) ([]string, error) {
+	pods, err := listPods(ks, foo, k8sCoreV1.SlaveName)
+	if err != nil {
+		return nil, err
+	}
+	current := currentMasterPod(foo)
+	res := make([]string, 0, len(pods))
+	for i := range pods {
+		if pods[i].Name != current && pods[i].Status.PodIP != "" && pods[i].DeletionTimestamp == nil {
+			res = append(res, podAddr(&pods[i]))
+		}
+	}
+	return res, nil
+}
+
+func restoreJobPhase(job *batchV1.Job) string {
+	for _, c := range job.Status.Conditions {
+		if c.Status != coreV1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchV1.JobComplete:
+			return RestorePhaseSucceeded
+		case batchV1.JobFailed:
+			return RestorePhaseFailed
+		}
+	}
+	return RestorePhaseRunning
+}
+
+// restore drives the point-in-time recovery of the master when the RestoreToTime was set or changed
+func restore(ks k8sCoreV1.KubernetesResource,
+	foo *mysqlOperatorV1.MysqlOperator,
+	clientSet mysqlOperatorClientSet.Interface,
+	recorder record.EventRecorder) error {
+	if foo.Spec.Backup == nil || foo.Spec.Backup.RestoreToTime == "" {
+		return nil
+	}
+	restoreToTime := foo.Spec.Backup.RestoreToTime
+	status := foo.Spec.MasterSpec.Status
+	if status.RestoredToTime == restoreToTime && status.RestorePhase != RestorePhaseRunning {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, restoreToTime)
+	if err != nil {
+		// There is no need to requeue the invalid spec, the next update would bring it back
+		recorder.Eventf(foo, coreV1.EventTypeWarning, ErrRestoreToTime, MessageErrRestoreToTime, restoreToTime, err)
+		return nil
+	}
+	name := restoreJobName(foo, t)
+	job, err := ks.Job().Get(foo.Namespace, name)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		slaves, err := restoreSlaves(ks, foo)
+		if err != nil {
+			return err
+		}
+		klog.Info("new restore job:", name)
+		if job, err = ks.Job().Create(foo.Namespace, NewRestoreJob(foo, t, slaves)); err != nil {
+			return err
+		}
+		recorder.Eventf(foo, coreV1.EventTypeNormal, RestoreStarted, MessageRestoreStarted, restoreToTime, name)
+	}
+	phase := restoreJobPhase(job)
+	if status.RestoredToTime == restoreToTime && status.RestorePhase == phase {
+		return nil
+	}
+	if err = updateRestoreStatus(ks.Context(), foo, clientSet, restoreToTime, phase); err != nil {
+		return err
+	}
+	switch phase {
+	case RestorePhaseSucceeded:
+		recorder.Eventf(foo, coreV1.EventTypeNormal, RestoreSucceeded, MessageRestoreSucceeded, restoreToTime)
+	case RestorePhaseFailed:
+		recorder.Eventf(foo, coreV1.EventTypeWarning, RestoreFailed, MessageRestoreFailed, restoreToTime, name)
+	}
+	return nil
+}
+
+func updateRestoreStatus(ctx context.Context, foo *mysqlOperatorV1.MysqlOperator, clientSet mysqlOperatorClientSet.Interface, restoreToTime, phase string) error {
+	if k8sCoreV1.SuppressedByDryRun(OperatorKindName, foo) {
+		return nil
+	}
+	// The object in the store may have been outdated by updateFooStatus, so that we get the latest one
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	latest, err := clientSet.NevercaseV1().MysqlOperators(foo.Namespace).Get(ctx, foo.Name, metaV1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	fooCopy := latest.DeepCopy()
+	fooCopy.Spec.MasterSpec.Status.RestoredToTime = restoreToTime
+	fooCopy.Spec.MasterSpec.Status.RestorePhase = phase
+	_, err = clientSet.NevercaseV1().MysqlOperators(foo.Namespace).Update(ctx, fooCopy, metaV1.UpdateOptions{})
+	return err
+}
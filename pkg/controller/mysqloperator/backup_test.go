@@ -0,0 +1,133 @@
+package mysqloperator
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	appsV1 "k8s.io/api/apps/v1"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	k8sTesting "github.com/nevercase/k8s-controller-custom-resource/core/v1/testing"
+	mysqlOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned/fake"
+)
+
+func newTestPod(foo *mysqlOperatorV1.MysqlOperator, name, role, ip string) *coreV1.Pod {
+	return &coreV1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      name,
+			Namespace: foo.Namespace,
+			Labels: map[string]string{
+				k8sCoreV1.LabelApp:        OperatorKindName,
+				k8sCoreV1.LabelController: foo.Name,
+				k8sCoreV1.LabelRole:       role,
+			},
+		},
+		Status: coreV1.PodStatus{PodIP: ip},
+	}
+}
+
+func TestNewRestoreJob(t *testing.T) {
+	foo := newTestMysqlOperator(1, 2)
+	foo.Spec.Backup = &mysqlOperatorV1.MysqlBackupSpec{Image: "mysql-backup", ArchivePath: "/mnt/nas"}
+	foo.Spec.MasterSpec.Status.CurrentMaster = "cn1-slave-0"
+	f := k8sTesting.NewFixture(t, []runtime.Object{
+		newTestPod(foo, "cn1-slave-0", k8sCoreV1.SlaveName, "10.0.0.1"),
+		newTestPod(foo, "cn1-slave-1", k8sCoreV1.SlaveName, "10.0.0.2"),
+		newTestPod(foo, "cn1-slave-2", k8sCoreV1.SlaveName, ""),
+	}...)
+	f.Start()
+
+	slaves, err := restoreSlaves(f.Resource(), foo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"10.0.0.2:3306"}; !reflect.DeepEqual(slaves, want) {
+		t.Errorf("restoreSlaves() = %v, want %v without the promoted master and the pending pod", slaves, want)
+	}
+	job := NewRestoreJob(foo, time.Date(2021, 6, 1, 8, 0, 0, 0, time.UTC), slaves)
+	env := make(map[string]string)
+	for _, e := range job.Spec.Template.Spec.Containers[0].Env {
+		env[e.Name] = e.Value
+	}
+	if env[MysqlBackupHost] != k8sCoreV1.GetReadWriteServiceName(foo.Name) {
+		t.Errorf("the restore job connected to %s rather than the current master", env[MysqlBackupHost])
+	}
+	if env[MysqlBackupSlaveHosts] != "10.0.0.2:3306" || env[MysqlBackupRestoreToTime] != "2021-06-01T08:00:00Z" {
+		t.Errorf("unexpected env of the restore job %v", env)
+	}
+}
+
+func TestSyncBackupAfterFailover(t *testing.T) {
+	foo := newTestMysqlOperator(1, 2)
+	foo.Spec.Backup = &mysqlOperatorV1.MysqlBackupSpec{Image: "mysql-backup", ArchivePath: "/mnt/nas"}
+	// The former releases ran the backup beside the master
+	legacy := &appsV1.StatefulSet{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:            "cn1-master",
+			Namespace:       k8sTesting.Namespace,
+			OwnerReferences: []metaV1.OwnerReference{*metaV1.NewControllerRef(foo, ownerKind)},
+		},
+		Spec: appsV1.StatefulSetSpec{
+			Replicas: k8sTesting.Int32Ptr(1),
+			Template: coreV1.PodTemplateSpec{Spec: coreV1.PodSpec{Containers: []coreV1.Container{
+				{Name: "cn1-master", Image: "mysql:5.7"},
+				{Name: MysqlBackupContainerName, Image: "mysql-backup"},
+			}}},
+		},
+	}
+	f := k8sTesting.NewFixture(t, legacy)
+	clientSet := fake.NewSimpleClientset(foo)
+	f.Start(newOption(k8sTesting.AgentName, clientSet, f.StopCh()))
+	if _, err := f.Reconcile(foo); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if ss := f.StatefulSet(k8sTesting.Namespace, "cn1-master"); hasBackupContainer(ss) {
+		t.Error("the backup container was kept beside the master")
+	}
+
+	// The slave pod cn1-slave-0 was promoted, and the master StatefulSet was fenced
+	current, err := clientSet.NevercaseV1().MysqlOperators(k8sTesting.Namespace).Get(f.Context(), foo.Name, metaV1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	current.Spec.MasterSpec.Status.CurrentMaster = "cn1-slave-0"
+	if current, err = clientSet.NevercaseV1().MysqlOperators(k8sTesting.Namespace).Update(f.Context(), current, metaV1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	f.Recorder.Reset()
+	if _, err = f.Reconcile(current); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if got := *f.StatefulSet(k8sTesting.Namespace, "cn1-master").Spec.Replicas; got != 0 {
+		t.Errorf("master replicas = %d, want the fenced master", got)
+	}
+	d := f.Deployment(k8sTesting.Namespace, backupDeploymentName(foo))
+	if d.Spec.Replicas == nil || *d.Spec.Replicas != 1 || !metaV1.IsControlledBy(d, foo) {
+		t.Errorf("backup deployment = %+v, want a single replica controlled by %s", d.Spec, foo.Name)
+	}
+	env := make(map[string]string)
+	for _, e := range d.Spec.Template.Spec.Containers[0].Env {
+		env[e.Name] = e.Value
+	}
+	if env[MysqlBackupHost] != k8sCoreV1.GetReadWriteServiceName(foo.Name) {
+		t.Errorf("the backup connected to %s rather than the current master", env[MysqlBackupHost])
+	}
+
+	// The backup was deleted once it was disabled
+	current.Spec.Backup = nil
+	if current, err = clientSet.NevercaseV1().MysqlOperators(k8sTesting.Namespace).Update(f.Context(), current, metaV1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.Reconcile(current); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if _, err = f.KubeClientSet.AppsV1().Deployments(k8sTesting.Namespace).Get(f.Context(), d.Name, metaV1.GetOptions{}); !errors.IsNotFound(err) {
+		t.Errorf("backup deployment was kept after the backup was disabled: %v", err)
+	}
+}
@@ -21,8 +21,28 @@ const (
 )
 
 const (
-	MysqlDefaultPort         = 3306
-	MysqlDefaultRootPassword = "root"
+	MysqlDefaultPort = 3306
+	// MysqlPasswordLength is the length of the generated passwords
+	MysqlPasswordLength = 24
+)
+
+const (
+	// MysqlRootSecretTemplate is the name of the Secret of the root account, which the operator,
+	// the backup sidecar and the restore job connect with
+	MysqlRootSecretTemplate = "%s-root"
+	MysqlRootUser           = "root"
+	// MysqlLegacyRootPassword was the password of the root account before the Secret,
+	// it was kept for the MysqlOperators whose master was running by then
+	MysqlLegacyRootPassword = "root"
+)
+
+const (
+	// MysqlReplicationSecretTemplate is the name of the Secret of the account which the slaves replicate with
+	MysqlReplicationSecretTemplate = "%s-replication"
+	MysqlReplicationUser           = "repl"
+	// MysqlLegacyReplicationPassword was the password of the replication account before the Secret,
+	// it was kept for the MysqlOperators whose master was running by then
+	MysqlLegacyReplicationPassword = "root"
 )
 
 const (
@@ -33,7 +53,142 @@ const (
 	MysqlMasterHost        = "MYSQL_MASTER_HOST"
 	MysqlMasterPort        = "MYSQL_MASTER_PORT"
 	MysqlMasterUser        = "MYSQL_MASTER_USER"
-	MysqlMasterPassword    = "MYSQL_MASTER_USER"
+	MysqlMasterPassword    = "MYSQL_MASTER_PASSWORD"
 	MysqlMasterLogFile     = "MYSQL_MASTER_LOG_FILE"
 	MysqlMasterLogPosition = "MYSQL_MASTER_LOG_POS"
 )
+
+const (
+	MysqlBackupContainerName      = "binlog-archiver"
+	MysqlBackupArchiveVolume      = "backup-archive"
+	MysqlBackupArchiveDir         = "/archive"
+	MysqlBackupRole               = "backup"
+	MysqlBackupDeploymentTemplate = "%s-backup"
+	MysqlBackupRestoreRole        = "restore"
+	MysqlBackupRestoreTemplate    = "%s-restore-%d"
+
+	MysqlBackupDefaultFullDumpIntervalSeconds = 86400
+	MysqlBackupDefaultRetentionCount          = 7
+
+	MysqlBackupHost             = "MYSQL_HOST"
+	MysqlBackupPort             = "MYSQL_PORT"
+	MysqlBackupUser             = "MYSQL_USER"
+	MysqlBackupPassword         = "MYSQL_PASSWORD"
+	MysqlBackupArchive          = "MYSQL_BACKUP_ARCHIVE_DIR"
+	MysqlBackupFullDumpInterval = "MYSQL_BACKUP_FULL_DUMP_INTERVAL"
+	MysqlBackupRetentionCount   = "MYSQL_BACKUP_RETENTION_COUNT"
+	MysqlBackupRestoreToTime    = "MYSQL_RESTORE_TO_TIME"
+	MysqlBackupSlaveHosts       = "MYSQL_SLAVE_HOSTS"
+)
+
+const (
+	RestorePhaseRunning   = "Running"
+	RestorePhaseSucceeded = "Succeeded"
+	RestorePhaseFailed    = "Failed"
+
+	// RestoreStarted is used as part of the Event 'reason' when a point-in-time recovery job was created
+	RestoreStarted = "RestoreStarted"
+	// RestoreSucceeded is used as part of the Event 'reason' when a point-in-time recovery job was completed
+	RestoreSucceeded = "RestoreSucceeded"
+	// RestoreFailed is used as part of the Event 'reason' when a point-in-time recovery job was failed
+	RestoreFailed = "RestoreFailed"
+	// ErrRestoreToTime is used as part of the Event 'reason' when the RestoreToTime couldn't be parsed
+	ErrRestoreToTime = "ErrRestoreToTime"
+
+	MessageRestoreStarted   = "Restoring the master to %s with job %s"
+	MessageRestoreSucceeded = "Restored the master to %s"
+	MessageRestoreFailed    = "Failed to restore the master to %s, see job %s"
+	MessageErrRestoreToTime = "RestoreToTime %q must be a RFC3339 timestamp: %v"
+)
+
+const (
+	// MysqlDropFinalizer blocks the deletion of a MysqlDatabase or a MysqlUser until it was dropped from the master
+	MysqlDropFinalizer = "nevercase.io/mysql-drop"
+
+	MysqlPhasePending = "Pending"
+	MysqlPhaseReady   = "Ready"
+	MysqlPhaseFailed  = "Failed"
+	MysqlPhasePaused  = "Paused"
+
+	// ErrMysqlExec is used as part of the Event 'reason' when the statements couldn't be executed on the master
+	ErrMysqlExec = "ErrMysqlExec"
+	// MysqlDropped is used as part of the Event 'reason' when a database or an account was dropped from the master
+	MysqlDropped = "Dropped"
+
+	MessageErrMysqlExec = "Failed to apply to the master of %s: %v"
+	MessageMysqlDropped = "Dropped %s from the master of %s"
+
+	// UserSecretKeyUsername and UserSecretKeyPassword are the keys of the credentials in the Secret of a MysqlUser
+	UserSecretKeyUsername = "username"
+	UserSecretKeyPassword = "password"
+)
+
+const (
+	// MysqlConnectionUserTemplate is the name of the MysqlUser of the account in the connection Secret
+	MysqlConnectionUserTemplate = "%s-connection"
+	// MysqlConnectionCredentialsTemplate is the name of the Secret of that MysqlUser
+	MysqlConnectionCredentialsTemplate = "%s-connection-credentials"
+	MysqlConnectionDefaultUser         = "app"
+)
+
+const (
+	MysqlConfigVolume         = "my-cnf"
+	MysqlConfigDir            = "/etc/mysql/conf.d"
+	MysqlConfigFile           = "operator.cnf"
+	MysqlConfigMapTemplate    = "%s-my-cnf"
+	MysqlConfigHashAnnotation = "nevercase.io/my-cnf-hash"
+
+	// MyCnfApplied is used as part of the Event 'reason' when the changed options were applied with SET GLOBAL
+	MyCnfApplied = "MyCnfApplied"
+	// MyCnfRestart is used as part of the Event 'reason' when the changed options need a rolling restart
+	MyCnfRestart = "MyCnfRestart"
+	// ErrMyCnf is used as part of the Event 'reason' when the myCnf couldn't be rendered
+	ErrMyCnf = "ErrMyCnf"
+
+	MessageMyCnfApplied = "Applied %v to %s with SET GLOBAL"
+	MessageMyCnfRestart = "Restarting %s to apply the my.cnf: %v"
+	MessageErrMyCnf     = "Invalid myCnf of %s: %v"
+)
+
+const (
+	// MysqlSwitchoverAnnotation names the slave pod which would be promoted by a planned switchover
+	MysqlSwitchoverAnnotation = "nevercase.io/switchover"
+
+	MysqlFailoverDefaultGracePeriodSeconds = 30
+	MysqlCatchUpTimeoutSeconds             = 30
+
+	// MasterUnavailable is used as part of the Event 'reason' when the master was found unavailable
+	MasterUnavailable = "MasterUnavailable"
+	// FailoverStarted is used as part of the Event 'reason' when the grace period of the master was exceeded
+	FailoverStarted = "FailoverStarted"
+	// SwitchoverStarted is used as part of the Event 'reason' when a planned switchover was requested
+	SwitchoverStarted = "SwitchoverStarted"
+	// MasterReadOnly is used as part of the Event 'reason' when the master was set read only for a switchover
+	MasterReadOnly = "MasterReadOnly"
+	// SlavePromoted is used as part of the Event 'reason' when a slave was made writable
+	SlavePromoted = "SlavePromoted"
+	// MasterServiceSwitched is used as part of the Event 'reason' when the master Service was pointed to the promoted slave
+	MasterServiceSwitched = "MasterServiceSwitched"
+	// SlaveRepointed is used as part of the Event 'reason' when a slave was replicating from the promoted slave
+	SlaveRepointed = "SlaveRepointed"
+	// SlaveLeftOut is used as part of the Event 'reason' when a slave couldn't be pointed to the promoted slave
+	SlaveLeftOut = "SlaveLeftOut"
+	// MasterFenced is used as part of the Event 'reason' when the master StatefulSet was scaled to 0
+	MasterFenced = "MasterFenced"
+	// FailoverSucceeded is used as part of the Event 'reason' when a failover or a switchover was completed
+	FailoverSucceeded = "FailoverSucceeded"
+	// FailoverFailed is used as part of the Event 'reason' when a failover or a switchover couldn't be completed
+	FailoverFailed = "FailoverFailed"
+
+	MessageMasterUnavailable     = "Master %s is unavailable, failing over in %v"
+	MessageFailoverStarted       = "Master %s has been unavailable for %v, failing over to %s at %s:%d"
+	MessageSwitchoverStarted     = "Switching over from %s to %s"
+	MessageMasterReadOnly        = "Set %s read only at %s:%d"
+	MessageSlavePromoted         = "Promoted %s to master"
+	MessageMasterServiceSwitched = "Pointed Service %s to %s"
+	MessageSlaveRepointed        = "Pointed %s to the master at %s"
+	MessageSlaveLeftOut          = "Left %s out of the replication of %s, it must be reseeded: %v"
+	MessageMasterFenced          = "Scaling StatefulSet %s to 0, %s is the master"
+	MessageFailoverSucceeded     = "%s is the master"
+	MessageFailoverFailed        = "Failed to promote %s: %v"
+)
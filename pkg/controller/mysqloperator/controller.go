@@ -10,8 +10,8 @@ import (
 	coreV1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
@@ -20,38 +20,76 @@ import (
 	mysqlOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
 	mysqlOperatorClientSet "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned"
 	mysqlOperatorScheme "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned/scheme"
-	informersext "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/informers/externalversions"
-	informers "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/informers/externalversions/mysqloperator/v1"
 )
 
+// ownerKind is the kind of the controller ownerRef of the children
+var ownerKind = mysqlOperatorV1.SchemeGroupVersion.WithKind(OperatorKindName)
+
+// Reconciler reconciles the MysqlOperators with the StatefulSets and the Services of the master and the slaves
+type Reconciler struct {
+	clientSet mysqlOperatorClientSet.Interface
+}
+
+// NewReconciler returns the Reconciler which updates the MysqlOperators with clientSet
+func NewReconciler(clientSet mysqlOperatorClientSet.Interface) *Reconciler {
+	return &Reconciler{clientSet: clientSet}
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, foo *mysqlOperatorV1.MysqlOperator) (k8sCoreV1.Result, error) {
+	return Sync(ctx, foo, r.clientSet, k8sCoreV1.ResourceFromContext(ctx), k8sCoreV1.RecorderFromContext(ctx))
+}
+
+// Finalize does nothing, the children were collected by their owner references
+func (r *Reconciler) Finalize(ctx context.Context, foo *mysqlOperatorV1.MysqlOperator) error {
+	return nil
+}
+
+// ReconcilePaused reflects the annotation nevercase.io/paused in masterSpec.status.paused
+func (r *Reconciler) ReconcilePaused(ctx context.Context, foo *mysqlOperatorV1.MysqlOperator, paused bool) error {
+	if foo.Spec.MasterSpec.Status.Paused == paused || k8sCoreV1.SuppressedByDryRun(OperatorKindName, foo) {
+		return nil
+	}
+	fooCopy := foo.DeepCopy()
+	fooCopy.Spec.MasterSpec.Status.Paused = paused
+	updateCtx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	if _, err := r.clientSet.NevercaseV1().MysqlOperators(foo.Namespace).Update(updateCtx, fooCopy, metaV1.UpdateOptions{}); err != nil {
+		return err
+	}
+	return syncOverallStatus(ctx, r.clientSet, foo.Namespace, foo.Name)
+}
+
+// Priority puts foo ahead of the routine resyncs while its master was lost or a switchover was requested
+func (r *Reconciler) Priority(foo *mysqlOperatorV1.MysqlOperator) bool {
+	if target := foo.Annotations[MysqlSwitchoverAnnotation]; target != "" && target != currentMasterPod(foo) {
+		return true
+	}
+	return foo.Spec.MasterSpec.Status.MasterLostSince != nil
+}
+
+func (r *Reconciler) ReconcileStatus(ctx context.Context, foo *mysqlOperatorV1.MysqlOperator, child metaV1.Object) error {
+	ss, ok := child.(*appsV1.StatefulSet)
+	if !ok {
+		return nil
+	}
+	// The status was synced on the events of the pods as well, a restarted promoted pod came back read only
+	if err := labelPods(k8sCoreV1.ResourceFromContext(ctx), foo, currentMasterPod(foo)); err != nil {
+		return err
+	}
+	return SyncStatus(ctx, ss, r.clientSet, k8sCoreV1.RecorderFromContext(ctx))
+}
+
 func NewController(
 	controllerName string,
 	k8sClientSet kubernetes.Interface,
 	clientSet mysqlOperatorClientSet.Interface,
 	stopCh <-chan struct{}) k8sCoreV1.KubernetesControllerV1 {
-
-	informerFactory := informersext.NewSharedInformerFactory(clientSet, time.Second*30)
-	fooInformer := informerFactory.Nevercase().V1().MysqlOperators()
-
-	opt := k8sCoreV1.NewOption(&mysqlOperatorV1.MysqlOperator{},
-		controllerName,
-		OperatorKindName,
-		mysqlOperatorScheme.AddToScheme(scheme.Scheme),
-		clientSet,
-		fooInformer,
-		fooInformer.Informer(),
-		CompareResourceVersion,
-		Get,
-		Sync,
-		SyncStatus)
 	opts := k8sCoreV1.NewOptions()
-	if err := opts.Add(opt); err != nil {
+	if err := opts.Add(newOption(controllerName, clientSet, stopCh)); err != nil {
 		klog.Fatal(err)
 	}
 	op := k8sCoreV1.NewKubernetesOperator(k8sClientSet, stopCh, controllerName, opts)
-	kc := k8sCoreV1.NewKubernetesController(op)
-	informerFactory.Start(stopCh)
-	return kc
+	return k8sCoreV1.NewKubernetesController(op)
 }
 
 func NewOption(controllerName string, cfg *rest.Config, stopCh <-chan struct{}) k8sCoreV1.Option {
@@ -59,60 +97,142 @@ func NewOption(controllerName string, cfg *rest.Config, stopCh <-chan struct{})
 	if err != nil {
 		klog.Fatalf("Error building clientSet: %s", err.Error())
 	}
-	informerFactory := informersext.NewSharedInformerFactory(c, time.Second*30)
-	fooInformer := informerFactory.Nevercase().V1().MysqlOperators()
-	opt := k8sCoreV1.NewOption(&mysqlOperatorV1.MysqlOperator{},
-		controllerName,
-		OperatorKindName,
-		mysqlOperatorScheme.AddToScheme(scheme.Scheme),
-		c,
-		fooInformer,
-		fooInformer.Informer(),
-		CompareResourceVersion,
-		Get,
-		Sync,
-		SyncStatus)
-	informerFactory.Start(stopCh)
-	return opt
+	return newOption(controllerName, c, stopCh)
 }
 
-func CompareResourceVersion(old, new interface{}) bool {
-	newResource := new.(*mysqlOperatorV1.MysqlOperator)
-	oldResource := old.(*mysqlOperatorV1.MysqlOperator)
-	if newResource.ResourceVersion == oldResource.ResourceVersion {
-		// Periodic resync will send update events for all known Deployments.
-		// Two different versions of the same Deployment will always have different RVs.
-		return true
-	}
-	return false
+func newOption(controllerName string, clientSet mysqlOperatorClientSet.Interface, stopCh <-chan struct{}) k8sCoreV1.Option {
+	return k8sCoreV1.NewBuilder[mysqlOperatorV1.MysqlOperator, *mysqlOperatorV1.MysqlOperatorList](clientSet.NevercaseV1().MysqlOperators).
+		AgentName(controllerName).
+		Scheme(mysqlOperatorScheme.AddToScheme).
+		Reconciler(NewReconciler(clientSet)).
+		Build(stopCh)
 }
 
-func Get(foo interface{}, nameSpace, ownerRefName string) (obj interface{}, err error) {
-	kc := foo.(informers.MysqlOperatorInformer)
-	return kc.Lister().MysqlOperators(nameSpace).Get(ownerRefName)
-}
-
-func Sync(obj interface{}, clientObj interface{}, ks k8sCoreV1.KubernetesResource, recorder record.EventRecorder) error {
-	foo := obj.(*mysqlOperatorV1.MysqlOperator)
-	clientSet := clientObj.(mysqlOperatorClientSet.Interface)
+func Sync(ctx context.Context, foo *mysqlOperatorV1.MysqlOperator, clientSet mysqlOperatorClientSet.Interface, ks k8sCoreV1.KubernetesResource, recorder record.EventRecorder) (k8sCoreV1.Result, error) {
+	// The invalid spec would fail again on each retry, the MysqlOperator was synced again once it was fixed
+	foo = withDefaults(foo)
+	if errs := validateSpec(foo); len(errs) > 0 {
+		recorder.Event(foo, coreV1.EventTypeWarning, k8sCoreV1.ErrInvalidSpec, errs.ToAggregate().Error())
+		return k8sCoreV1.Result{}, nil
+	}
 	//defer recorder.Event(foo, coreV1.EventTypeNormal, SuccessSynced, MessageResourceSynced)
+	// The master and the slaves read the credentials of the replication and the root account from the Secrets
+	if err := ensureReplicationSecret(ks, foo); err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	if err := ensureRootSecret(ks, foo); err != nil {
+		return k8sCoreV1.Result{}, err
+	}
 	// Create the Deployment of master with MasterSpec
-	err := createStatefulSetAndService(ks, foo, clientSet, true)
+	err := createStatefulSetAndService(ks, foo, clientSet, recorder, true)
 	//err := createMysqlDeploymentAndService(ks, foo, clientSet, true)
 	if err != nil {
-		return err
+		return k8sCoreV1.Result{}, err
+	}
+	// Restore the master to the RestoreToTime with the archived full dumps and binlogs
+	if err = restore(ks, foo, clientSet, recorder); err != nil {
+		return k8sCoreV1.Result{}, err
 	}
 	// Create the Deployment of slave with SlaveSpec
-	err = createStatefulSetAndService(ks, foo, clientSet, false)
+	err = createStatefulSetAndService(ks, foo, clientSet, recorder, false)
 	//err = createMysqlDeploymentAndService(ks, foo, clientSet, false)
 	if err != nil {
-		return err
+		return k8sCoreV1.Result{}, err
+	}
+	// Create the Services which were named after the MysqlOperator
+	if err = accessServices(ks, foo, recorder); err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	// Archive the binlogs of the current master through the read-write Service
+	if err = backupDeployment(ks, foo, recorder); err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	// Delete the children of the former names of the master and the slaves
+	if err = collectOrphans(ks, foo, recorder); err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	// Publish the endpoints and the credentials of the account of the applications
+	user, err := applyConnectionUser(ctx, clientSet, foo)
+	if err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	username, password, err := connectionCredentials(ks, user)
+	if err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	if err = k8sCoreV1.ApplySecret(ks.Secret(), NewConnectionSecret(foo, username, password)); err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	// Promote a slave if the master was lost or a switchover was requested
+	result, err := failover(ks, foo, clientSet, recorder)
+	if err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	// The pods which couldn't pull their images keep the MysqlOperator being requeued
+	selector := map[string]string{
+		k8sCoreV1.LabelApp:        OperatorKindName,
+		k8sCoreV1.LabelController: foo.Name,
+	}
+	if err = k8sCoreV1.CheckImagePull(ks, foo.Namespace, selector); err != nil {
+		return k8sCoreV1.Result{}, err
 	}
 	recorder.Event(foo, coreV1.EventTypeNormal, SuccessSynced, MessageResourceSynced)
-	return nil
+	if err = syncOverallStatus(ctx, clientSet, foo.Namespace, foo.Name); err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	// The status follows the rollout without waiting for the events of the StatefulSets
+	rollout, err := rolloutResult(ks, foo)
+	if err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	return result.Merge(rollout), nil
 }
 
-func createStatefulSetAndService(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator, clientSet mysqlOperatorClientSet.Interface, isMaster bool) (err error) {
+// rolloutResult requeues the MysqlOperator while any of its StatefulSets was being rolled out
+func rolloutResult(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator) (k8sCoreV1.Result, error) {
+	for _, name := range []string{masterRdsName(foo), slaveRdsName(foo)} {
+		ss, err := ks.StatefulSet().Get(foo.Namespace, name)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return k8sCoreV1.Result{}, err
+		}
+		if !k8sCoreV1.StatefulSetRolledOut(ss) {
+			return k8sCoreV1.RequeueAfter(k8sCoreV1.RolloutRequeueAfter), nil
+		}
+	}
+	return k8sCoreV1.Result{}, nil
+}
+
+// collectOrphans deletes the StatefulSets, the Services and the ConfigMaps of my.cnf which the MysqlOperator no longer desires
+func collectOrphans(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator, recorder record.EventRecorder) error {
+	master, slave := masterRdsName(foo), slaveRdsName(foo)
+	desired := k8sCoreV1.Children{
+		StatefulSets: []string{master, slave},
+		Services:     []string{k8sCoreV1.GetServiceName(master), k8sCoreV1.GetServiceName(slave)},
+		ConfigMaps: []string{
+			myCnfName(&mysqlOperatorV1.MysqlSpec{Name: master}),
+			myCnfName(&mysqlOperatorV1.MysqlSpec{Name: slave}),
+		},
+	}
+	for _, svc := range NewAccessServices(foo) {
+		desired.Services = append(desired.Services, svc.Name)
+	}
+	// The StatefulSet of the promoted slave runs the live master, it was kept after the slaves were renamed
+	if promoted := promotedStatefulSet(foo); promoted != "" {
+		desired.StatefulSets = append(desired.StatefulSets, promoted)
+		desired.Services = append(desired.Services, k8sCoreV1.GetServiceName(promoted))
+		desired.ConfigMaps = append(desired.ConfigMaps, myCnfName(&mysqlOperatorV1.MysqlSpec{Name: promoted}))
+	}
+	selector := map[string]string{
+		k8sCoreV1.LabelApp:        OperatorKindName,
+		k8sCoreV1.LabelController: foo.Name,
+	}
+	return k8sCoreV1.CollectOrphans(ks, foo, selector, desired, recorder)
+}
+
+func createStatefulSetAndService(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator, clientSet mysqlOperatorClientSet.Interface, recorder record.EventRecorder, isMaster bool) (err error) {
 	//klog.Info("createMysqlDeploymentAndService2:")
 	if isMaster == true {
 		a := int32(1)
@@ -122,10 +242,16 @@ func createStatefulSetAndService(ks k8sCoreV1.KubernetesResource, foo *mysqlOper
 		klog.Info("master-rds:", rds)
 		rds.Config.ServerId = &a
 		//klog.Info("rds:", rds)
-		if err = statefulSet(ks, foo, &rds, clientSet, isMaster); err != nil {
+		ssRds := rds
+		if foo.Spec.MasterSpec.Status.CurrentMaster != "" {
+			// The master StatefulSet was fenced since a slave had been promoted
+			fenced := int32(0)
+			ssRds.Replicas = &fenced
+		}
+		if err = statefulSet(ks, foo, &ssRds, clientSet, recorder, isMaster); err != nil {
 			return err
 		}
-		if err = service(ks, foo, &rds, clientSet, isMaster); err != nil {
+		if err = service(ks, foo, &rds, clientSet, recorder, isMaster); err != nil {
 			return err
 		}
 		return nil
@@ -138,10 +264,10 @@ func createStatefulSetAndService(ks k8sCoreV1.KubernetesResource, foo *mysqlOper
 	b := int32(0)
 	rds.Config.ServerId = &b
 	klog.Info("slave-rds:", rds)
-	if err = statefulSet(ks, foo, &rds, clientSet, isMaster); err != nil {
+	if err = statefulSet(ks, foo, &rds, clientSet, recorder, isMaster); err != nil {
 		return err
 	}
-	if err = service(ks, foo, &rds, clientSet, isMaster); err != nil {
+	if err = service(ks, foo, &rds, clientSet, recorder, isMaster); err != nil {
 		return err
 	}
 	return nil
@@ -151,6 +277,7 @@ func statefulSet(ks k8sCoreV1.KubernetesResource,
 	foo *mysqlOperatorV1.MysqlOperator,
 	rds *mysqlOperatorV1.MysqlSpec,
 	clientSet mysqlOperatorClientSet.Interface,
+	recorder record.EventRecorder,
 	isMaster bool) error {
 	ss, err := ks.StatefulSet().Get(foo.Namespace, rds.Name)
 	if err != nil {
@@ -158,26 +285,41 @@ func statefulSet(ks k8sCoreV1.KubernetesResource,
 		if !errors.IsNotFound(err) {
 			return err
 		}
+		ss = nil
+	} else if ss, err = k8sCoreV1.ClaimStatefulSet(ks.StatefulSet(), foo, ownerKind, ss, recorder); err != nil {
+		return err
+	}
+	hash, err := syncMyCnf(ks, foo, rds, ss, recorder)
+	if err != nil {
+		return err
+	}
+	if ss == nil {
 		klog.Info("new statefulSet")
-		if ss, err = ks.StatefulSet().Create(foo.Namespace, NewStatefulSet(foo, rds)); err != nil {
+		if ss, err = ks.StatefulSet().Create(foo.Namespace, newStatefulSetWithMyCnfHash(foo, rds, hash)); err != nil {
 			return err
 		}
 	}
 	klog.Info("rds:", *rds.Replicas)
 	klog.Info("statefulSet:", *ss.Spec.Replicas)
-	if rds.Replicas != nil && *rds.Replicas != *ss.Spec.Replicas || rds.Image != ss.Spec.Template.Spec.Containers[0].Image {
-		if ss, err = ks.StatefulSet().Update(foo.Namespace, NewStatefulSet(foo, rds)); err != nil {
+	if rds.Replicas != nil && *rds.Replicas != *ss.Spec.Replicas || rds.Image != ss.Spec.Template.Spec.Containers[0].Image || hasBackupContainer(ss) || currentMyCnfHash(ss) != hash {
+		newSS := newStatefulSetWithMyCnfHash(foo, rds, hash)
+		// The serviceName of an existing StatefulSet couldn't be changed
+		newSS.Spec.ServiceName = ss.Spec.ServiceName
+		if ss, err = ks.StatefulSet().Update(foo.Namespace, newSS); err != nil {
 			klog.V(2).Info(err)
 			return err
 		}
 	}
-	if err = updateFooStatus(foo, clientSet, ss, isMaster); err != nil {
+	if err = updateFooStatus(ks.Context(), foo, clientSet, ss, isMaster); err != nil {
 		return err
 	}
 	return nil
 }
 
-func updateFooStatus(foo *mysqlOperatorV1.MysqlOperator, clientSet mysqlOperatorClientSet.Interface, statefulSet *appsV1.StatefulSet, isMaster bool) error {
+func updateFooStatus(ctx context.Context, foo *mysqlOperatorV1.MysqlOperator, clientSet mysqlOperatorClientSet.Interface, statefulSet *appsV1.StatefulSet, isMaster bool) error {
+	if k8sCoreV1.SuppressedByDryRun(OperatorKindName, foo) {
+		return nil
+	}
 	// NEVER modify objects from the store. It's a read-only, local cache.
 	// You can use DeepCopy() to make a deep copy of original object and modify this copy
 	// Or create a copy manually for better performance
@@ -191,6 +333,7 @@ func updateFooStatus(foo *mysqlOperatorV1.MysqlOperator, clientSet mysqlOperator
 		fooCopy.Spec.MasterSpec.Status.CurrentRevision = statefulSet.Status.CurrentRevision
 		fooCopy.Spec.MasterSpec.Status.UpdateRevision = statefulSet.Status.UpdateRevision
 		fooCopy.Spec.MasterSpec.Status.CollisionCount = statefulSet.Status.CollisionCount
+		fooCopy.Spec.MasterSpec.Status.ConnectionSecret = k8sCoreV1.GetConnectionSecretName(foo.Name)
 	} else {
 		fooCopy.Spec.SlaveSpec.Status.ObservedGeneration = statefulSet.Status.ObservedGeneration
 		fooCopy.Spec.SlaveSpec.Status.Replicas = statefulSet.Status.Replicas
@@ -206,7 +349,7 @@ func updateFooStatus(foo *mysqlOperatorV1.MysqlOperator, clientSet mysqlOperator
 	// UpdateStatus will not allow changes to the Spec of the resource,
 	// which is ideal for ensuring nothing other than resource status has been updated.
 	opt := metaV1.UpdateOptions{}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(env.DefaultExecutionDuration))
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
 	_, err := clientSet.NevercaseV1().MysqlOperators(foo.Namespace).Update(ctx, fooCopy, opt)
 	cancel()
 	return err
@@ -216,8 +359,9 @@ func service(ks k8sCoreV1.KubernetesResource,
 	foo *mysqlOperatorV1.MysqlOperator,
 	rds *mysqlOperatorV1.MysqlSpec,
 	clientSet mysqlOperatorClientSet.Interface,
+	recorder record.EventRecorder,
 	isMaster bool) error {
-	_, err := ks.Service().Get(foo.Namespace, rds.Name)
+	svc, err := ks.Service().Get(foo.Namespace, rds.Name)
 	if err != nil {
 		klog.Info("service err:", err)
 		if !errors.IsNotFound(err) {
@@ -231,6 +375,12 @@ func service(ks k8sCoreV1.KubernetesResource,
 			return err
 		}
 	} else {
+		// The Service which isn't ours would neither be updated nor deleted,
+		// the adopted one would be updated by the sync which was triggered by the adoption
+		adopted, err := k8sCoreV1.ClaimService(ks.Service(), foo, ownerKind, svc, recorder)
+		if err != nil || adopted {
+			return err
+		}
 		klog.Info("update service no action!")
 		//if _, err = ks.Service().Update(foo.Namespace, newService(foo, rds)); err != nil {
 		//	klog.Info(err)
@@ -241,14 +391,63 @@ func service(ks k8sCoreV1.KubernetesResource,
 				klog.V(2).Info(err)
 				return err
 			}
+			return nil
+		}
+		// The selector of the master Service follows the promoted slave
+		if selector := NewService(foo, rds).Spec.Selector; !labels.Equals(svc.Spec.Selector, selector) {
+			svc = svc.DeepCopy()
+			svc.Spec.Selector = selector
+			if _, err = ks.Service().Update(foo.Namespace, svc); err != nil {
+				klog.V(2).Info(err)
+				return err
+			}
 		}
 	}
 	return nil
 }
 
-func SyncStatus(obj interface{}, clientObj interface{}, ks k8sCoreV1.KubernetesResource, recorder record.EventRecorder) error {
-	clientSet := clientObj.(mysqlOperatorClientSet.Interface)
-	ss := obj.(*appsV1.StatefulSet)
+// accessServices applies the `-rw`, `-ro` and headless Services and labels the pods with their access
+func accessServices(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator, recorder record.EventRecorder) error {
+	for _, svc := range NewAccessServices(foo) {
+		current, err := ks.Service().Get(svc.Namespace, svc.Name)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if err == nil {
+			adopted, err := k8sCoreV1.ClaimService(ks.Service(), foo, ownerKind, current, recorder)
+			if err != nil {
+				return err
+			}
+			if adopted {
+				continue
+			}
+		}
+		if err = k8sCoreV1.ApplyService(ks.Service(), svc); err != nil {
+			return err
+		}
+	}
+	return labelPods(ks, foo, currentMasterPod(foo))
+}
+
+// labelPods marks the master pod as the only one which accepts writes
+func labelPods(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator, master string) error {
+	selector := map[string]string{
+		k8sCoreV1.LabelApp:        OperatorKindName,
+		k8sCoreV1.LabelController: foo.Name,
+	}
+	return k8sCoreV1.EnsurePodLabel(ks, foo.Namespace, selector, k8sCoreV1.LabelAccess, func(pod *coreV1.Pod) string {
+		switch {
+		case pod.Name == master:
+			return k8sCoreV1.AccessReadWrite
+		case pod.Labels[k8sCoreV1.LabelRole] == k8sCoreV1.MasterName || pod.Labels[k8sCoreV1.LabelRole] == k8sCoreV1.SlaveName:
+			return k8sCoreV1.AccessReadOnly
+		default:
+			return ""
+		}
+	})
+}
+
+func SyncStatus(ctx context.Context, ss *appsV1.StatefulSet, clientSet mysqlOperatorClientSet.Interface, recorder record.EventRecorder) error {
 	var isMaster bool
 	if t, ok := ss.Labels[k8sCoreV1.LabelRole]; ok {
 		if t == k8sCoreV1.MasterName {
@@ -265,13 +464,16 @@ func SyncStatus(obj interface{}, clientObj interface{}, ks k8sCoreV1.KubernetesR
 	} else {
 		return fmt.Errorf(ErrResourceNotMatch, "no controller")
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(env.DefaultExecutionDuration))
-	mysql, err := clientSet.NevercaseV1().MysqlOperators(ss.Namespace).Get(ctx, specName, metaV1.GetOptions{})
+	getCtx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	mysql, err := clientSet.NevercaseV1().MysqlOperators(ss.Namespace).Get(getCtx, specName, metaV1.GetOptions{})
 	cancel()
 	if err != nil {
 		return err
 	}
-	if err := updateFooStatus(mysql, clientSet, ss, isMaster); err != nil {
+	if err := updateFooStatus(ctx, mysql, clientSet, ss, isMaster); err != nil {
+		return err
+	}
+	if err := syncOverallStatus(ctx, clientSet, mysql.Namespace, mysql.Name); err != nil {
 		return err
 	}
 	recorder.Event(mysql, coreV1.EventTypeNormal, SuccessSynced, MessageResourceSynced)
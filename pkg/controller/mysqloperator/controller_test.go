@@ -0,0 +1,211 @@
+package mysqloperator
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	k8sTesting "github.com/nevercase/k8s-controller-custom-resource/core/v1/testing"
+	mysqlOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned/fake"
+)
+
+func newTestMysqlOperator(masterReplicas, slaveReplicas int32) *mysqlOperatorV1.MysqlOperator {
+	spec := func(replicas int32) mysqlOperatorV1.MysqlCore {
+		return mysqlOperatorV1.MysqlCore{
+			Spec: mysqlOperatorV1.MysqlSpec{
+				Name:         "cn1",
+				Replicas:     k8sTesting.Int32Ptr(replicas),
+				Image:        "mysql:5.7",
+				ServicePorts: []coreV1.ServicePort{{Port: MysqlDefaultPort}},
+				VolumePath:   "/mnt/nas",
+				Config: mysqlOperatorV1.ServerConfig{
+					User:     "root",
+					Password: "secret",
+				},
+			},
+		}
+	}
+	return &mysqlOperatorV1.MysqlOperator{
+		TypeMeta:   metaV1.TypeMeta{APIVersion: mysqlOperatorV1.SchemeGroupVersion.String(), Kind: OperatorKindName},
+		ObjectMeta: k8sTesting.ObjectMeta("example-mysql"),
+		Spec: mysqlOperatorV1.MysqlOperatorSpec{
+			MasterSpec: spec(masterReplicas),
+			SlaveSpec:  spec(slaveReplicas),
+		},
+	}
+}
+
+func TestSync(t *testing.T) {
+	withMyCnf := newTestMysqlOperator(1, 2)
+	withMyCnf.Spec.MasterSpec.Spec.MyCnf = map[string]string{"max_connections": "500"}
+
+	failedOver := newTestMysqlOperator(1, 2)
+	failedOver.Spec.MasterSpec.Status.CurrentMaster = "cn1-slave-0"
+
+	unowned := &coreV1.Service{
+		ObjectMeta: metaV1.ObjectMeta{Name: "cn1-master", Namespace: k8sTesting.Namespace},
+	}
+
+	tests := []struct {
+		k8sTesting.SyncCase
+		foo               *mysqlOperatorV1.MysqlOperator
+		wantStatefulSets  []string
+		wantServices      []string
+		wantConfigMaps    []string
+		wantMasterReplica int32
+	}{
+		{
+			SyncCase:          k8sTesting.SyncCase{Name: "creates the children", WantReasons: []string{SuccessSynced}},
+			foo:               newTestMysqlOperator(1, 2),
+			wantStatefulSets:  []string{"cn1-master", "cn1-slave"},
+			wantServices:      []string{"cn1-master", "cn1-slave", "example-mysql-headless", "example-mysql-ro", "example-mysql-rw"},
+			wantConfigMaps:    []string{},
+			wantMasterReplica: 1,
+		},
+		{
+			SyncCase:          k8sTesting.SyncCase{Name: "creates the my.cnf of the master", WantReasons: []string{SuccessSynced}},
+			foo:               withMyCnf,
+			wantStatefulSets:  []string{"cn1-master", "cn1-slave"},
+			wantServices:      []string{"cn1-master", "cn1-slave", "example-mysql-headless", "example-mysql-ro", "example-mysql-rw"},
+			wantConfigMaps:    []string{myCnfName(&mysqlOperatorV1.MysqlSpec{Name: "cn1-master"})},
+			wantMasterReplica: 1,
+		},
+		{
+			SyncCase:          k8sTesting.SyncCase{Name: "fences the master once a slave was promoted", WantReasons: []string{SuccessSynced}},
+			foo:               failedOver,
+			wantStatefulSets:  []string{"cn1-master", "cn1-slave"},
+			wantServices:      []string{"cn1-master", "cn1-slave", "example-mysql-headless", "example-mysql-ro", "example-mysql-rw"},
+			wantConfigMaps:    []string{},
+			wantMasterReplica: 0,
+		},
+		{
+			SyncCase: k8sTesting.SyncCase{
+				Name:        "refuses the service of someone else",
+				Objects:     []runtime.Object{unowned},
+				WantErr:     true,
+				WantReasons: []string{k8sCoreV1.ErrResourceExists},
+			},
+			foo:              newTestMysqlOperator(1, 2),
+			wantStatefulSets: []string{"cn1-master"},
+			wantServices:     []string{"cn1-master"},
+			wantConfigMaps:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			f := k8sTesting.NewFixture(t, tt.Objects...)
+			clientSet := fake.NewSimpleClientset(tt.foo)
+			f.Start(newOption(k8sTesting.AgentName, clientSet, f.StopCh()))
+
+			synced := f.ExpectSync(tt.foo, tt.SyncCase)
+			if got := f.StatefulSetNames(k8sTesting.Namespace); !reflect.DeepEqual(got, tt.wantStatefulSets) {
+				t.Errorf("StatefulSets = %v, want %v", got, tt.wantStatefulSets)
+			}
+			if got := f.ServiceNames(k8sTesting.Namespace); !reflect.DeepEqual(got, tt.wantServices) {
+				t.Errorf("Services = %v, want %v", got, tt.wantServices)
+			}
+			if got := f.ConfigMapNames(k8sTesting.Namespace); !reflect.DeepEqual(got, tt.wantConfigMaps) {
+				t.Errorf("ConfigMaps = %v, want %v", got, tt.wantConfigMaps)
+			}
+			if !synced {
+				return
+			}
+			if got := *f.StatefulSet(k8sTesting.Namespace, "cn1-master").Spec.Replicas; got != tt.wantMasterReplica {
+				t.Errorf("master replicas = %d, want %d", got, tt.wantMasterReplica)
+			}
+			secret := f.Secret(k8sTesting.Namespace, k8sCoreV1.GetConnectionSecretName(tt.foo.Name))
+			if got := string(secret.Data[k8sCoreV1.ConnectionRWHost]); got == "" {
+				t.Errorf("connection secret has no %s", k8sCoreV1.ConnectionRWHost)
+			}
+		})
+	}
+}
+
+func TestSyncFailedOverRenamed(t *testing.T) {
+	foo := newTestMysqlOperator(1, 2)
+	foo.Spec.MasterSpec.Status.CurrentMaster = "cn1-slave-0"
+	f := k8sTesting.NewFixture(t)
+	clientSet := fake.NewSimpleClientset(foo)
+	f.Start(newOption(k8sTesting.AgentName, clientSet, f.StopCh()))
+	if _, err := f.Reconcile(foo); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+
+	// The master and the slaves were renamed after the slave pod cn1-slave-0 had been promoted
+	current, err := clientSet.NevercaseV1().MysqlOperators(k8sTesting.Namespace).Get(f.Context(), foo.Name, metaV1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	current.Spec.MasterSpec.Spec.Name, current.Spec.SlaveSpec.Spec.Name = "cn2", "cn2"
+	if current, err = clientSet.NevercaseV1().MysqlOperators(k8sTesting.Namespace).Update(f.Context(), current, metaV1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	f.Recorder.Reset()
+	if _, err = f.Reconcile(current); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if got, want := f.StatefulSetNames(k8sTesting.Namespace), []string{"cn1-slave", "cn2-master", "cn2-slave"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("StatefulSets = %v, want %v with the one of the live master", got, want)
+	}
+	if got := sets.NewString(f.ServiceNames(k8sTesting.Namespace)...); !got.Has("cn1-slave") || got.Has("cn1-master") {
+		t.Errorf("Services = %v, want the one of the live master kept and the fenced one deleted", got.List())
+	}
+}
+
+func TestSyncConnectionUser(t *testing.T) {
+	foo := newTestMysqlOperator(1, 1)
+	foo.Spec.Connection = &mysqlOperatorV1.MysqlConnectionSpec{
+		Grants: []mysqlOperatorV1.MysqlGrant{{Database: "app", Privileges: []string{"SELECT", "INSERT"}}},
+	}
+	f := k8sTesting.NewFixture(t)
+	clientSet := fake.NewSimpleClientset(foo)
+	f.Start(newOption(k8sTesting.AgentName, clientSet, f.StopCh()))
+	if _, err := f.Reconcile(foo); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+
+	user, err := clientSet.NevercaseV1().MysqlUsers(k8sTesting.Namespace).Get(f.Context(), connectionUserName(foo), metaV1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !metaV1.IsControlledBy(user, foo) || user.Spec.User != MysqlConnectionDefaultUser || !reflect.DeepEqual(user.Spec.Grants, foo.Spec.Connection.Grants) {
+		t.Errorf("mysqlUser = %+v, want the default user with the grants of the connection", user)
+	}
+	secret := f.Secret(k8sTesting.Namespace, k8sCoreV1.GetConnectionSecretName(foo.Name))
+	if _, ok := secret.Data[k8sCoreV1.ConnectionPassword]; ok {
+		t.Errorf("connection secret has %s before the account was created", k8sCoreV1.ConnectionPassword)
+	}
+
+	// The Secret of the MysqlUser was created by its controller
+	credentials := &coreV1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{Name: user.Spec.PasswordSecret, Namespace: k8sTesting.Namespace},
+		Data: map[string][]byte{
+			UserSecretKeyUsername: []byte(user.Spec.User),
+			UserSecretKeyPassword: []byte("generated"),
+		},
+	}
+	if _, err = f.KubeClientSet.CoreV1().Secrets(k8sTesting.Namespace).Create(f.Context(), credentials, metaV1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	current, err := clientSet.NevercaseV1().MysqlOperators(k8sTesting.Namespace).Get(f.Context(), foo.Name, metaV1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.Reconcile(current); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	secret = f.Secret(k8sTesting.Namespace, k8sCoreV1.GetConnectionSecretName(foo.Name))
+	if got := string(secret.Data[k8sCoreV1.ConnectionUsername]); got != MysqlConnectionDefaultUser {
+		t.Errorf("connection secret %s = %q, want %q", k8sCoreV1.ConnectionUsername, got, MysqlConnectionDefaultUser)
+	}
+	if got := string(secret.Data[k8sCoreV1.ConnectionRWURI]); !strings.HasPrefix(got, "mysql://app:generated@") {
+		t.Errorf("connection secret %s = %q, want the credentials of the account", k8sCoreV1.ConnectionRWURI, got)
+	}
+}
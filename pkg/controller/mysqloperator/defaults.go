@@ -0,0 +1,35 @@
+package mysqloperator
+
+import (
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	mysqlOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+)
+
+// Default fills the MysqlOperator in the admission webhook, so that the stored object shows what was deployed
+func (r *Reconciler) Default(foo *mysqlOperatorV1.MysqlOperator) {
+	SetDefaults(foo)
+}
+
+// SetDefaults fills the ports, the replicas, the service type, the resources and the image pull policy
+// of the master, the slaves and the backup which were not specified
+func SetDefaults(foo *mysqlOperatorV1.MysqlOperator) {
+	for _, rds := range []*mysqlOperatorV1.MysqlSpec{&foo.Spec.MasterSpec.Spec, &foo.Spec.SlaveSpec.Spec} {
+		k8sCoreV1.DefaultReplicas(&rds.Replicas)
+		rds.ContainerPorts = k8sCoreV1.DefaultContainerPorts(rds.ContainerPorts, MysqlDefaultPort)
+		k8sCoreV1.DefaultServicePorts(rds.ServicePorts)
+		k8sCoreV1.DefaultServiceType(&rds.ServiceType)
+		k8sCoreV1.DefaultImagePullPolicy(&rds.ImagePullPolicy)
+		k8sCoreV1.DefaultResources(&rds.Resources)
+	}
+	if foo.Spec.Backup != nil {
+		k8sCoreV1.DefaultResources(&foo.Spec.Backup.Resources)
+	}
+}
+
+// withDefaults returns the defaulted copy of the MysqlOperator, the ones stored before the defaulting webhook
+// was registered were synced the same way
+func withDefaults(foo *mysqlOperatorV1.MysqlOperator) *mysqlOperatorV1.MysqlOperator {
+	foo = foo.DeepCopy()
+	SetDefaults(foo)
+	return foo
+}
@@ -0,0 +1,637 @@
+package mysqloperator
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	appsV1 "k8s.io/api/apps/v1"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	mysqlOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/env"
+	mysqlOperatorClientSet "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned"
+)
+
+// promoteStatements turn a slave into a writable master
+var promoteStatements = []Statement{
+	{Query: "STOP SLAVE"},
+	{Query: "RESET SLAVE ALL"},
+	{Query: "SET GLOBAL super_read_only = OFF"},
+	{Query: "SET GLOBAL read_only = OFF"},
+}
+
+// replicationAccount creates the replication account on a promoted slave, which might have missed it
+func replicationAccount(user, password string) []Statement {
+	return []Statement{
+		{Query: "CREATE USER IF NOT EXISTS ? IDENTIFIED BY ?", Args: []interface{}{user, password}},
+		{Query: "GRANT REPLICATION SLAVE ON *.* TO ?", Args: []interface{}{user}},
+	}
+}
+
+// binlogPosition is a position in the binlogs of the master
+type binlogPosition struct {
+	File string
+	Pos  uint64
+}
+
+// Less compares the positions, the names of the binlogs were sortable since they have the same prefix
+func (p binlogPosition) Less(o binlogPosition) bool {
+	if p.File != o.File {
+		return p.File < o.File
+	}
+	return p.Pos < o.Pos
+}
+
+// replicationProgress is what a slave has received from the master. The slaves were compared by the GTIDs
+// which they have executed or retrieved if gtid_mode was ON, by the position in the binlogs of the master otherwise.
+type replicationProgress struct {
+	Position binlogPosition
+	GTIDs    gtidSet
+}
+
+// Less reports whether p has received less than o. The GTID set which was contained by the other was less,
+// the diverged sets which weren't contained either way were compared by their sizes.
+func (p replicationProgress) Less(o replicationProgress) bool {
+	if p.GTIDs == nil || o.GTIDs == nil {
+		return p.Position.Less(o.Position)
+	}
+	if contained, contains := o.GTIDs.Contains(p.GTIDs), p.GTIDs.Contains(o.GTIDs); contained != contains {
+		return contained
+	}
+	return p.GTIDs.Count() < o.GTIDs.Count()
+}
+
+// slaveProgress returns the progress of the row of SHOW SLAVE STATUS, the GTIDs were parsed if gtid was set
+func slaveProgress(row map[string]string, gtid bool) (replicationProgress, error) {
+	p := replicationProgress{Position: parsePosition(row, "Master_Log_File", "Read_Master_Log_Pos")}
+	if !gtid {
+		return p, nil
+	}
+	set, err := parseGTIDSet(row["Executed_Gtid_Set"] + "," + row["Retrieved_Gtid_Set"])
+	if err != nil {
+		return replicationProgress{}, err
+	}
+	p.GTIDs = set
+	return p, nil
+}
+
+func masterRdsName(foo *mysqlOperatorV1.MysqlOperator) string {
+	return fmt.Sprintf("%s-%s", foo.Spec.MasterSpec.Spec.Name, k8sCoreV1.MasterName)
+}
+
+func slaveRdsName(foo *mysqlOperatorV1.MysqlOperator) string {
+	return fmt.Sprintf("%s-%s", foo.Spec.SlaveSpec.Spec.Name, k8sCoreV1.SlaveName)
+}
+
+// currentMasterPod returns the name of the pod which was the master of the MysqlOperator
+func currentMasterPod(foo *mysqlOperatorV1.MysqlOperator) string {
+	if foo.Spec.MasterSpec.Status.CurrentMaster != "" {
+		return foo.Spec.MasterSpec.Status.CurrentMaster
+	}
+	return fmt.Sprintf("%s-0", k8sCoreV1.GetStatefulSetName(masterRdsName(foo)))
+}
+
+// promotedStatefulSet returns the name of the StatefulSet of the promoted slave pod, which is the pod name without its ordinal,
+// or "" if no slave was promoted
+func promotedStatefulSet(foo *mysqlOperatorV1.MysqlOperator) string {
+	podName := foo.Spec.MasterSpec.Status.CurrentMaster
+	if i := strings.LastIndex(podName, "-"); i > 0 {
+		return podName[:i]
+	}
+	return ""
+}
+
+// masterSelector selects the pod of the master StatefulSet, or the promoted slave pod once a slave was promoted
+func masterSelector(foo *mysqlOperatorV1.MysqlOperator, podName string) map[string]string {
+	selector := map[string]string{
+		k8sCoreV1.LabelApp:        OperatorKindName,
+		k8sCoreV1.LabelController: foo.Name,
+	}
+	if podName == "" {
+		selector[k8sCoreV1.LabelRole] = k8sCoreV1.MasterName
+	} else {
+		selector[appsV1.StatefulSetPodNameLabel] = podName
+	}
+	return selector
+}
+
+func podReady(pod *coreV1.Pod) bool {
+	if pod.DeletionTimestamp != nil || pod.Status.Phase != coreV1.PodRunning || pod.Status.PodIP == "" {
+		return false
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == coreV1.PodReady {
+			return c.Status == coreV1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func podAddr(pod *coreV1.Pod) string {
+	port := int32(MysqlDefaultPort)
+	if len(pod.Spec.Containers) > 0 && len(pod.Spec.Containers[0].Ports) > 0 {
+		port = pod.Spec.Containers[0].Ports[0].ContainerPort
+	}
+	return fmt.Sprintf("%s:%d", pod.Status.PodIP, port)
+}
+
+// getPod returns the pod from the informer
+func getPod(ks k8sCoreV1.KubernetesResource, nameSpace, name string) (*coreV1.Pod, error) {
+	return ks.Pod().Get(nameSpace, name)
+}
+
+// listPods returns the copies of the pods of the role from the informer
+func listPods(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator, role string) ([]coreV1.Pod, error) {
+	pods, err := ks.Pod().List(foo.Namespace, map[string]string{
+		k8sCoreV1.LabelApp:        OperatorKindName,
+		k8sCoreV1.LabelController: foo.Name,
+		k8sCoreV1.LabelRole:       role,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]coreV1.Pod, 0, len(pods))
+	for _, pod := range pods {
+		res = append(res, *pod.DeepCopy())
+	}
+	// The slaves were visited in the order of their names
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res, nil
+}
+
+// queryRow returns the first row of the query by the column names, or nil if there was no row
+func queryRow(ctx context.Context, addr string, account Account, query string) (map[string]string, error) {
+	db, err := Open(addr, account)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", query, err)
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	values := make([]sql.RawBytes, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err = rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+	row := make(map[string]string, len(columns))
+	for i, c := range columns {
+		row[c] = string(values[i])
+	}
+	return row, nil
+}
+
+func parsePosition(row map[string]string, fileColumn, posColumn string) binlogPosition {
+	pos, _ := strconv.ParseUint(row[posColumn], 10, 64)
+	return binlogPosition{File: row[fileColumn], Pos: pos}
+}
+
+// masterPosition returns the position of the binlog which was being written
+func masterPosition(ctx context.Context, addr string, account Account) (binlogPosition, error) {
+	row, err := queryRow(ctx, addr, account, "SHOW MASTER STATUS")
+	if err != nil {
+		return binlogPosition{}, err
+	}
+	if row == nil {
+		return binlogPosition{}, fmt.Errorf("log-bin is disabled on %s", addr)
+	}
+	return parsePosition(row, "File", "Position"), nil
+}
+
+// masterProgress returns the position of the binlog which was being written, and the executed GTIDs if gtid_mode was ON
+func masterProgress(ctx context.Context, addr string, account Account) (replicationProgress, error) {
+	pos, err := masterPosition(ctx, addr, account)
+	if err != nil {
+		return replicationProgress{}, err
+	}
+	row, err := queryRow(ctx, addr, account, "SELECT @@global.gtid_mode AS gtid_mode, @@global.gtid_executed AS gtid_executed")
+	if err != nil {
+		return replicationProgress{}, err
+	}
+	progress := replicationProgress{Position: pos}
+	if row["gtid_mode"] == "ON" {
+		if progress.GTIDs, err = parseGTIDSet(row["gtid_executed"]); err != nil {
+			return replicationProgress{}, err
+		}
+	}
+	return progress, nil
+}
+
+// waitForApplied waits until the slave at addr has executed the binlogs of the master up to pos
+func waitForApplied(ctx context.Context, addr string, account Account, pos binlogPosition) error {
+	deadline := time.Now().Add(time.Second * MysqlCatchUpTimeoutSeconds)
+	for {
+		row, err := queryRow(ctx, addr, account, "SHOW SLAVE STATUS")
+		if err != nil {
+			return err
+		}
+		if row == nil {
+			return fmt.Errorf("%s is not a slave", addr)
+		}
+		executed := parsePosition(row, "Relay_Master_Log_File", "Exec_Master_Log_Pos")
+		if !executed.Less(pos) {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%s has executed %s:%d only, timed out waiting for %s:%d", addr, executed.File, executed.Pos, pos.File, pos.Pos)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// waitForExecuted waits until the slave at addr has executed the GTIDs of set
+func waitForExecuted(ctx context.Context, addr string, account Account, set gtidSet) error {
+	db, err := Open(addr, account)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*(MysqlCatchUpTimeoutSeconds+time.Duration(env.DefaultExecutionDuration)))
+	defer cancel()
+	var timedOut int
+	if err = db.QueryRowContext(ctx, "SELECT WAIT_FOR_EXECUTED_GTID_SET(?, ?)", set.String(), MysqlCatchUpTimeoutSeconds).Scan(&timedOut); err != nil {
+		return err
+	}
+	if timedOut != 0 {
+		return fmt.Errorf("%s timed out executing %s", addr, set)
+	}
+	return nil
+}
+
+// waitForProgress waits until the slave at addr has executed what was received by progress
+func waitForProgress(ctx context.Context, addr string, account Account, progress replicationProgress) error {
+	if progress.GTIDs != nil {
+		return waitForExecuted(ctx, addr, account, progress.GTIDs)
+	}
+	if progress.Position.File == "" {
+		return nil
+	}
+	return waitForApplied(ctx, addr, account, progress.Position)
+}
+
+// slaveCaughtUp checks that the slave at addr could be pointed to the promoted master, and waits until it has executed
+// the relay logs. With GTID the auto position fetches what the slave has missed from the promoted master, unless the slave
+// has executed the GTIDs which the promoted master hasn't. Without GTID the slave starts from the current position
+// of the promoted master, so that it must have received the binlogs of the lost master up to the promoted one exactly.
+func slaveCaughtUp(ctx context.Context, addr string, account Account, progress replicationProgress, executed gtidSet) error {
+	row, err := queryRow(ctx, addr, account, "SHOW SLAVE STATUS")
+	if err != nil {
+		return err
+	}
+	if row == nil {
+		return fmt.Errorf("%s is not a slave", addr)
+	}
+	if executed != nil {
+		own, err := slaveProgress(row, true)
+		if err != nil {
+			return err
+		}
+		if !executed.Contains(own.GTIDs) {
+			return fmt.Errorf("%s has the GTIDs %s which the promoted master hasn't executed", addr, own.GTIDs)
+		}
+		return nil
+	}
+	if progress.Position.File == "" {
+		return fmt.Errorf("the position of the promoted master in the binlogs of the lost one is unknown")
+	}
+	// The slave was still receiving from the master which was set read only by the switchover
+	received := parsePosition(row, "Master_Log_File", "Read_Master_Log_Pos")
+	if row["Slave_IO_Running"] != "Yes" && received != progress.Position {
+		return fmt.Errorf("%s has received %s:%d, the promoted master has %s:%d", addr, received.File, received.Pos, progress.Position.File, progress.Position.Pos)
+	}
+	return waitForApplied(ctx, addr, account, progress.Position)
+}
+
+// changeMaster returns the statements which point a slave to the master Service with the replication account.
+// The slaves use the auto position if GTID was enabled, otherwise they start from pos.
+func changeMaster(foo *mysqlOperatorV1.MysqlOperator, pos binlogPosition, gtid bool, user, password string) []Statement {
+	port := MysqlDefaultPort
+	if len(foo.Spec.MasterSpec.Spec.ServicePorts) > 0 {
+		port = int(foo.Spec.MasterSpec.Spec.ServicePorts[0].Port)
+	}
+	s := Statement{
+		Query: "CHANGE MASTER TO MASTER_HOST = ?, MASTER_PORT = ?, MASTER_USER = ?, MASTER_PASSWORD = ?, MASTER_CONNECT_RETRY = 10",
+		Args:  []interface{}{k8sCoreV1.GetServiceName(masterRdsName(foo)), port, user, password},
+	}
+	if gtid {
+		s.Query += ", MASTER_AUTO_POSITION = 1"
+	} else {
+		s.Query += ", MASTER_LOG_FILE = ?, MASTER_LOG_POS = ?"
+		s.Args = append(s.Args, pos.File, pos.Pos)
+	}
+	return []Statement{{Query: "STOP SLAVE"}, s, {Query: "START SLAVE"}}
+}
+
+// failover promotes the most up-to-date slave once the master has been unavailable for longer than
+// the grace period, or the slave which was named by the switchover annotation while the master was available
+func failover(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator, clientSet mysqlOperatorClientSet.Interface, recorder record.EventRecorder) (k8sCoreV1.Result, error) {
+	ctx := ks.Context()
+	if foo.Spec.MasterSpec.Status.RestorePhase == RestorePhaseRunning {
+		return k8sCoreV1.Result{}, nil
+	}
+	key := fmt.Sprintf("%s/%s", foo.Namespace, foo.Name)
+	current := currentMasterPod(foo)
+	master, err := getPod(ks, foo.Namespace, current)
+	if err != nil && !errors.IsNotFound(err) {
+		return k8sCoreV1.Result{}, err
+	}
+	if err == nil && podReady(master) {
+		if foo.Spec.MasterSpec.Status.MasterLostSince != nil {
+			if err = updateMasterStatus(ctx, clientSet, foo, func(s *mysqlOperatorV1.MysqlStatus) { s.MasterLostSince = nil }); err != nil {
+				return k8sCoreV1.Result{}, err
+			}
+		}
+		if target := foo.Annotations[MysqlSwitchoverAnnotation]; target != "" && target != current {
+			return k8sCoreV1.Result{}, switchover(ks, foo, clientSet, recorder, master, target)
+		}
+		account, err := rootAccount(ks, foo)
+		if err != nil {
+			return k8sCoreV1.Result{}, err
+		}
+		return k8sCoreV1.Result{}, ensureWritable(ctx, foo, master, account)
+	}
+	if foo.Spec.Failover == nil {
+		return k8sCoreV1.Result{}, nil
+	}
+	grace := time.Second * MysqlFailoverDefaultGracePeriodSeconds
+	if foo.Spec.Failover.GracePeriodSeconds != nil {
+		grace = time.Second * time.Duration(*foo.Spec.Failover.GracePeriodSeconds)
+	}
+	// The time was kept in the status, so that the grace period survives a restart or a new leader of the operator
+	lostSince := foo.Spec.MasterSpec.Status.MasterLostSince
+	if lostSince == nil {
+		now := metaV1.Now()
+		if err = updateMasterStatus(ctx, clientSet, foo, func(s *mysqlOperatorV1.MysqlStatus) { s.MasterLostSince = &now }); err != nil {
+			return k8sCoreV1.Result{}, err
+		}
+		recorder.Eventf(foo, coreV1.EventTypeWarning, MasterUnavailable, MessageMasterUnavailable, current, grace)
+		lostSince = &now
+	}
+	elapsed := time.Since(lostSince.Time).Truncate(time.Second)
+	if elapsed < grace {
+		// The MysqlOperator was checked again once the grace period was exceeded
+		klog.Infof("master %s of %s has been unavailable for %v", current, key, elapsed)
+		return k8sCoreV1.RequeueAfter(grace - elapsed), nil
+	}
+	account, err := rootAccount(ks, foo)
+	if err != nil {
+		recorder.Eventf(foo, coreV1.EventTypeWarning, FailoverFailed, MessageFailoverFailed, "a slave", err)
+		return k8sCoreV1.Result{}, err
+	}
+	target, progress, err := mostUpToDateSlave(ks, foo, current, account)
+	if err != nil {
+		recorder.Eventf(foo, coreV1.EventTypeWarning, FailoverFailed, MessageFailoverFailed, "a slave", err)
+		return k8sCoreV1.Result{}, err
+	}
+	recorder.Eventf(foo, coreV1.EventTypeNormal, FailoverStarted, MessageFailoverStarted, current, elapsed, target.Name, progress.Position.File, progress.Position.Pos)
+	// Apply the relay logs which were received from the lost master
+	if err = waitForProgress(ctx, podAddr(target), account, progress); err != nil {
+		recorder.Eventf(foo, coreV1.EventTypeWarning, FailoverFailed, MessageFailoverFailed, target.Name, err)
+		return k8sCoreV1.Result{}, err
+	}
+	return k8sCoreV1.Result{}, promote(ks, foo, clientSet, recorder, target, nil, progress)
+}
+
+// mostUpToDateSlave returns the ready slave which has received the most from the master, by the GTIDs if gtid_mode was ON
+// or by the position in the binlogs of the master otherwise.
+// A writable slave without the replication was returned at once, which was being promoted by a failed attempt.
+func mostUpToDateSlave(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator, exclude string, account Account) (*coreV1.Pod, replicationProgress, error) {
+	ctx := ks.Context()
+	pods, err := listPods(ks, foo, k8sCoreV1.SlaveName)
+	if err != nil {
+		return nil, replicationProgress{}, err
+	}
+	var target *coreV1.Pod
+	var latest replicationProgress
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Name == exclude || !podReady(pod) {
+			continue
+		}
+		row, err := queryRow(ctx, podAddr(pod), account, "SHOW SLAVE STATUS")
+		if err != nil {
+			klog.Warningf("failover of %s/%s skips %s: %v", foo.Namespace, foo.Name, pod.Name, err)
+			continue
+		}
+		if row == nil {
+			ro, err := queryRow(ctx, podAddr(pod), account, "SELECT @@global.read_only AS read_only")
+			if err == nil && ro["read_only"] == "0" {
+				return pod, replicationProgress{}, nil
+			}
+			continue
+		}
+		mode, err := queryRow(ctx, podAddr(pod), account, "SELECT @@global.gtid_mode AS gtid_mode")
+		if err != nil {
+			klog.Warningf("failover of %s/%s skips %s: %v", foo.Namespace, foo.Name, pod.Name, err)
+			continue
+		}
+		progress, err := slaveProgress(row, mode["gtid_mode"] == "ON")
+		if err != nil {
+			klog.Warningf("failover of %s/%s skips %s: %v", foo.Namespace, foo.Name, pod.Name, err)
+			continue
+		}
+		if progress.Position.File == "" && len(progress.GTIDs) == 0 {
+			continue
+		}
+		if target == nil || latest.Less(progress) {
+			target, latest = pod, progress
+		}
+	}
+	if target == nil {
+		return nil, replicationProgress{}, fmt.Errorf("no ready slave was replicating from the master")
+	}
+	return target, latest, nil
+}
+
+// switchover sets the master read only, waits for the target to catch up and promotes it
+func switchover(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator, clientSet mysqlOperatorClientSet.Interface, recorder record.EventRecorder, master *coreV1.Pod, targetName string) error {
+	ctx := ks.Context()
+	recorder.Eventf(foo, coreV1.EventTypeNormal, SwitchoverStarted, MessageSwitchoverStarted, master.Name, targetName)
+	target, err := getPod(ks, foo.Namespace, targetName)
+	if err == nil && (target.Labels[k8sCoreV1.LabelController] != foo.Name || target.Labels[k8sCoreV1.LabelRole] != k8sCoreV1.SlaveName || !podReady(target)) {
+		err = fmt.Errorf("%s is not a ready slave of %s", targetName, foo.Name)
+	}
+	if err != nil {
+		recorder.Eventf(foo, coreV1.EventTypeWarning, FailoverFailed, MessageFailoverFailed, targetName, err)
+		return err
+	}
+	account, err := rootAccount(ks, foo)
+	if err != nil {
+		recorder.Eventf(foo, coreV1.EventTypeWarning, FailoverFailed, MessageFailoverFailed, targetName, err)
+		return err
+	}
+	addr := podAddr(master)
+	if err = Exec(ctx, addr, account, Statement{Query: "SET GLOBAL super_read_only = ON"}); err != nil {
+		recorder.Eventf(foo, coreV1.EventTypeWarning, FailoverFailed, MessageFailoverFailed, targetName, err)
+		return err
+	}
+	progress, err := masterProgress(ctx, addr, account)
+	if err == nil {
+		recorder.Eventf(foo, coreV1.EventTypeNormal, MasterReadOnly, MessageMasterReadOnly, master.Name, progress.Position.File, progress.Position.Pos)
+		err = waitForProgress(ctx, podAddr(target), account, progress)
+	}
+	if err != nil {
+		// Keep the master writable since the target was not promoted
+		if e := Exec(ctx, addr, account, Statement{Query: "SET GLOBAL super_read_only = OFF"}, Statement{Query: "SET GLOBAL read_only = OFF"}); e != nil {
+			klog.Warningf("failed to set %s writable again: %v", master.Name, e)
+		}
+		recorder.Eventf(foo, coreV1.EventTypeWarning, FailoverFailed, MessageFailoverFailed, targetName, err)
+		return err
+	}
+	return promote(ks, foo, clientSet, recorder, target, master, progress)
+}
+
+// promote makes the target writable, points the master Service and the other slaves to it and records it in the status.
+// The previous master was re-pointed if it was a slave pod, the master StatefulSet was scaled to 0 by Sync otherwise.
+// The slaves which couldn't replicate from the target without skipping or doubling the transactions were left out,
+// progress is what the target had received from the previous master.
+func promote(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator, clientSet mysqlOperatorClientSet.Interface, recorder record.EventRecorder, target, previous *coreV1.Pod, progress replicationProgress) error {
+	ctx := ks.Context()
+	fail := func(err error) error {
+		recorder.Eventf(foo, coreV1.EventTypeWarning, FailoverFailed, MessageFailoverFailed, target.Name, err)
+		return err
+	}
+	account, err := rootAccount(ks, foo)
+	if err != nil {
+		return fail(err)
+	}
+	addr := podAddr(target)
+	row, err := queryRow(ctx, addr, account, "SELECT @@global.log_bin AS log_bin, @@global.gtid_mode AS gtid_mode")
+	if err != nil {
+		return fail(err)
+	}
+	if row["log_bin"] != "1" {
+		return fail(fmt.Errorf("log-bin is disabled on %s, the other slaves couldn't replicate from it", target.Name))
+	}
+	gtid := row["gtid_mode"] == "ON"
+	user, password, err := replicationCredentials(ks, foo)
+	if err != nil {
+		return fail(err)
+	}
+	if err = Exec(ctx, addr, account, append(promoteStatements, replicationAccount(user, password)...)...); err != nil {
+		return fail(err)
+	}
+	recorder.Eventf(foo, coreV1.EventTypeNormal, SlavePromoted, MessageSlavePromoted, target.Name)
+	// The progress of the target was taken before the Services were pointed to it, none of the writes of the clients were before it
+	promoted, err := masterProgress(ctx, addr, account)
+	if err != nil {
+		return fail(err)
+	}
+
+	svc, err := ks.Service().Get(foo.Namespace, masterRdsName(foo))
+	if err != nil && !errors.IsNotFound(err) {
+		return fail(err)
+	}
+	if err == nil {
+		svc = svc.DeepCopy()
+		svc.Spec.Selector = masterSelector(foo, target.Name)
+		if _, err = ks.Service().Update(foo.Namespace, svc); err != nil {
+			return fail(err)
+		}
+		recorder.Eventf(foo, coreV1.EventTypeNormal, MasterServiceSwitched, MessageMasterServiceSwitched, svc.Name, target.Name)
+	}
+
+	if err = labelPods(ks, foo, target.Name); err != nil {
+		return fail(err)
+	}
+	recorder.Eventf(foo, coreV1.EventTypeNormal, MasterServiceSwitched, MessageMasterServiceSwitched, k8sCoreV1.GetReadWriteServiceName(foo.Name), target.Name)
+
+	statements := changeMaster(foo, promoted.Position, gtid, user, password)
+	slaves, err := listPods(ks, foo, k8sCoreV1.SlaveName)
+	if err != nil {
+		return fail(err)
+	}
+	for i := range slaves {
+		pod := &slaves[i]
+		if pod.Name == target.Name || !podReady(pod) {
+			continue
+		}
+		if previous != nil && pod.Name == previous.Name {
+			// The previous master was read only since the target had caught up with it
+			if err = Exec(ctx, podAddr(pod), account, Statement{Query: "SET GLOBAL super_read_only = ON"}); err != nil {
+				return fail(err)
+			}
+		} else if err = slaveCaughtUp(ctx, podAddr(pod), account, progress, promoted.GTIDs); err != nil {
+			recorder.Eventf(foo, coreV1.EventTypeWarning, SlaveLeftOut, MessageSlaveLeftOut, pod.Name, target.Name, err)
+			continue
+		}
+		if err = Exec(ctx, podAddr(pod), account, statements...); err != nil {
+			return fail(err)
+		}
+		recorder.Eventf(foo, coreV1.EventTypeNormal, SlaveRepointed, MessageSlaveRepointed, pod.Name, target.Name)
+	}
+
+	err = updateMasterStatus(ctx, clientSet, foo, func(s *mysqlOperatorV1.MysqlStatus) {
+		s.CurrentMaster = target.Name
+		s.MasterLostSince = nil
+	})
+	if err != nil {
+		return fail(err)
+	}
+	if foo.Spec.MasterSpec.Status.CurrentMaster == "" {
+		recorder.Eventf(foo, coreV1.EventTypeNormal, MasterFenced, MessageMasterFenced, k8sCoreV1.GetStatefulSetName(masterRdsName(foo)), target.Name)
+	}
+	recorder.Eventf(foo, coreV1.EventTypeNormal, FailoverSucceeded, MessageFailoverSucceeded, target.Name)
+	return nil
+}
+
+// ensureWritable promotes the current master again if it was restarted as a read only slave
+func ensureWritable(ctx context.Context, foo *mysqlOperatorV1.MysqlOperator, master *coreV1.Pod, account Account) error {
+	if foo.Spec.MasterSpec.Status.CurrentMaster == "" {
+		return nil
+	}
+	row, err := queryRow(ctx, podAddr(master), account, "SELECT @@global.read_only AS read_only")
+	if err != nil {
+		return err
+	}
+	if row["read_only"] == "0" {
+		return nil
+	}
+	klog.Infof("promoting %s of %s/%s again", master.Name, foo.Namespace, foo.Name)
+	return Exec(ctx, podAddr(master), account, promoteStatements...)
+}
+
+// updateMasterStatus applies update to the status of the master of the latest MysqlOperator
+func updateMasterStatus(ctx context.Context, clientSet mysqlOperatorClientSet.Interface, foo *mysqlOperatorV1.MysqlOperator, update func(s *mysqlOperatorV1.MysqlStatus)) error {
+	if k8sCoreV1.SuppressedByDryRun(OperatorKindName, foo) {
+		return nil
+	}
+	latest, err := GetOperator(ctx, clientSet, foo.Namespace, foo.Name)
+	if err != nil {
+		return err
+	}
+	fooCopy := latest.DeepCopy()
+	update(&fooCopy.Spec.MasterSpec.Status)
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	_, err = clientSet.NevercaseV1().MysqlOperators(foo.Namespace).Update(ctx, fooCopy, metaV1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,264 @@
+package mysqloperator
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	appsV1 "k8s.io/api/apps/v1"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	k8sTesting "github.com/nevercase/k8s-controller-custom-resource/core/v1/testing"
+	mysqlOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned/fake"
+)
+
+func TestFailoverMasterLostSince(t *testing.T) {
+	foo := newTestMysqlOperator(1, 2)
+	grace := int32(30)
+	foo.Spec.Failover = &mysqlOperatorV1.MysqlFailoverSpec{GracePeriodSeconds: &grace}
+	f := k8sTesting.NewFixture(t, NewRootSecret(foo, "generated"))
+	f.Start()
+	f.WaitForCache()
+	clientSet := fake.NewSimpleClientset(foo)
+
+	// The master pod was not found
+	result, err := failover(f.Resource(), foo, clientSet, f.Recorder)
+	if err != nil {
+		t.Fatalf("failover() error = %v", err)
+	}
+	if result.RequeueAfter <= 0 || result.RequeueAfter > time.Second*time.Duration(grace) {
+		t.Errorf("failover() result = %v, want a requeue within the grace period", result)
+	}
+	if got, want := f.Recorder.Reasons(), []string{MasterUnavailable}; !reflect.DeepEqual(got, want) {
+		t.Errorf("event reasons = %v, want %v", got, want)
+	}
+	current, err := clientSet.NevercaseV1().MysqlOperators(k8sTesting.Namespace).Get(f.Context(), foo.Name, metaV1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current.Spec.MasterSpec.Status.MasterLostSince == nil {
+		t.Fatal("masterLostSince was not recorded in the status")
+	}
+
+	// A new operator resumes the grace period from the status
+	lostSince := metaV1.NewTime(time.Now().Add(-time.Second * 20))
+	current.Spec.MasterSpec.Status.MasterLostSince = &lostSince
+	f.Recorder.Reset()
+	if result, err = failover(f.Resource(), current, clientSet, f.Recorder); err != nil {
+		t.Fatalf("failover() error = %v", err)
+	}
+	if result.RequeueAfter > time.Second*11 {
+		t.Errorf("failover() result = %v, want the rest of the grace period", result)
+	}
+	if got := f.Recorder.Reasons(); len(got) != 0 {
+		t.Errorf("event reasons = %v, want the unavailable master being reported once", got)
+	}
+
+	// No slave could be promoted once the grace period was exceeded
+	lostSince = metaV1.NewTime(time.Now().Add(-time.Minute))
+	if _, err = failover(f.Resource(), current, clientSet, f.Recorder); err == nil {
+		t.Error("failover() succeeded without any slave")
+	}
+	if got, want := f.Recorder.Reasons(), []string{FailoverFailed}; !reflect.DeepEqual(got, want) {
+		t.Errorf("event reasons = %v, want %v", got, want)
+	}
+}
+
+func TestChangeMaster(t *testing.T) {
+	foo := newTestMysqlOperator(1, 2)
+	statements := changeMaster(foo, binlogPosition{File: "binlog.000002", Pos: 154}, false, "repl", "generated")
+	if len(statements) != 3 {
+		t.Fatalf("changeMaster() = %v, want STOP, CHANGE and START", statements)
+	}
+	want := []interface{}{k8sCoreV1.GetServiceName(masterRdsName(foo)), MysqlDefaultPort, "repl", "generated", "binlog.000002", uint64(154)}
+	if got := statements[1].Args; !reflect.DeepEqual(got, want) {
+		t.Errorf("CHANGE MASTER args = %v, want %v", got, want)
+	}
+}
+
+func TestReconcileStatusRelabelsPods(t *testing.T) {
+	foo := newTestMysqlOperator(1, 2)
+	foo.Spec.MasterSpec.Status.CurrentMaster = "cn1-slave-0"
+	f := k8sTesting.NewFixture(t)
+	clientSet := fake.NewSimpleClientset(foo)
+	f.Start(newOption(k8sTesting.AgentName, clientSet, f.StopCh()))
+	if _, err := f.Reconcile(foo); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+
+	// The promoted pod was restarted with the labels of the pod template of the slaves
+	for _, name := range []string{"cn1-slave-0", "cn1-slave-1"} {
+		pod := newTestPod(foo, name, k8sCoreV1.SlaveName, "10.0.0.1")
+		pod.Labels[k8sCoreV1.LabelAccess] = k8sCoreV1.AccessReadOnly
+		if _, err := f.KubeClientSet.CoreV1().Pods(k8sTesting.Namespace).Create(f.Context(), pod, metaV1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f.WaitForCache()
+	current, err := clientSet.NevercaseV1().MysqlOperators(k8sTesting.Namespace).Get(f.Context(), foo.Name, metaV1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := k8sCoreV1.WithResource(f.Context(), f.Resource(), f.Recorder)
+	if err = NewReconciler(clientSet).ReconcileStatus(ctx, current, f.StatefulSet(k8sTesting.Namespace, "cn1-slave")); err != nil {
+		t.Fatalf("ReconcileStatus() error = %v", err)
+	}
+	for name, want := range map[string]string{"cn1-slave-0": k8sCoreV1.AccessReadWrite, "cn1-slave-1": k8sCoreV1.AccessReadOnly} {
+		pod, err := f.KubeClientSet.CoreV1().Pods(k8sTesting.Namespace).Get(f.Context(), name, metaV1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := pod.Labels[k8sCoreV1.LabelAccess]; got != want {
+			t.Errorf("access of %s = %s, want %s", name, got, want)
+		}
+	}
+}
+
+func TestFailoverSkipped(t *testing.T) {
+	restoring := newTestMysqlOperator(1, 2)
+	restoring.Spec.Failover = &mysqlOperatorV1.MysqlFailoverSpec{}
+	restoring.Spec.MasterSpec.Status.RestorePhase = RestorePhaseRunning
+
+	tests := []struct {
+		name string
+		foo  *mysqlOperatorV1.MysqlOperator
+	}{
+		{name: "leaves the lost master without the failover spec", foo: newTestMysqlOperator(1, 2)},
+		{name: "waits for the restore", foo: restoring},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := k8sTesting.NewFixture(t)
+			f.Start()
+			clientSet := fake.NewSimpleClientset(tt.foo)
+			result, err := failover(f.Resource(), tt.foo, clientSet, f.Recorder)
+			if err != nil || !result.IsZero() {
+				t.Errorf("failover() = %v, %v, want nothing done", result, err)
+			}
+			if got := f.Recorder.Reasons(); len(got) != 0 {
+				t.Errorf("event reasons = %v, want none", got)
+			}
+			current, err := clientSet.NevercaseV1().MysqlOperators(k8sTesting.Namespace).Get(f.Context(), tt.foo.Name, metaV1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if current.Spec.MasterSpec.Status.MasterLostSince != nil {
+				t.Error("masterLostSince was recorded in the status")
+			}
+		})
+	}
+}
+
+func TestCurrentMaster(t *testing.T) {
+	foo := newTestMysqlOperator(1, 2)
+	if got, want := currentMasterPod(foo), "cn1-master-0"; got != want {
+		t.Errorf("currentMasterPod() = %s, want %s", got, want)
+	}
+	if got := promotedStatefulSet(foo); got != "" {
+		t.Errorf("promotedStatefulSet() = %s, want none before the failover", got)
+	}
+	if got := masterSelector(foo, ""); got[k8sCoreV1.LabelRole] != k8sCoreV1.MasterName {
+		t.Errorf("masterSelector() = %v, want the pod of the master StatefulSet", got)
+	}
+
+	foo.Spec.MasterSpec.Status.CurrentMaster = "cn1-slave-1"
+	if got, want := currentMasterPod(foo), "cn1-slave-1"; got != want {
+		t.Errorf("currentMasterPod() = %s, want %s", got, want)
+	}
+	if got, want := promotedStatefulSet(foo), "cn1-slave"; got != want {
+		t.Errorf("promotedStatefulSet() = %s, want %s", got, want)
+	}
+	got := masterSelector(foo, foo.Spec.MasterSpec.Status.CurrentMaster)
+	if _, ok := got[k8sCoreV1.LabelRole]; ok || got[appsV1.StatefulSetPodNameLabel] != "cn1-slave-1" {
+		t.Errorf("masterSelector() = %v, want the promoted pod only", got)
+	}
+}
+
+func TestBinlogPositionLess(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b binlogPosition
+		want bool
+	}{
+		{name: "compares the positions of the same file", a: binlogPosition{"binlog.000002", 154}, b: binlogPosition{"binlog.000002", 1024}, want: true},
+		{name: "compares the files first", a: binlogPosition{"binlog.000002", 1024}, b: binlogPosition{"binlog.000003", 4}, want: true},
+		{name: "is not less than itself", a: binlogPosition{"binlog.000002", 154}, b: binlogPosition{"binlog.000002", 154}},
+		{name: "is not less than an older file", a: binlogPosition{"binlog.000003", 4}, b: binlogPosition{"binlog.000002", 1024}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Less(tt.b); got != tt.want {
+				t.Errorf("%v.Less(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePosition(t *testing.T) {
+	row := map[string]string{"Master_Log_File": "binlog.000002", "Read_Master_Log_Pos": "154"}
+	if got, want := parsePosition(row, "Master_Log_File", "Read_Master_Log_Pos"), (binlogPosition{"binlog.000002", 154}); got != want {
+		t.Errorf("parsePosition() = %v, want %v", got, want)
+	}
+	// The slave which was never configured has empty columns
+	if got := parsePosition(map[string]string{}, "Master_Log_File", "Read_Master_Log_Pos"); got != (binlogPosition{}) {
+		t.Errorf("parsePosition() = %v, want the zero position", got)
+	}
+}
+
+func TestPodReady(t *testing.T) {
+	foo := newTestMysqlOperator(1, 2)
+	ready := func(modify func(pod *coreV1.Pod)) *coreV1.Pod {
+		pod := newTestPod(foo, "cn1-slave-0", k8sCoreV1.SlaveName, "10.0.0.1")
+		pod.Status.Phase = coreV1.PodRunning
+		pod.Status.Conditions = []coreV1.PodCondition{{Type: coreV1.PodReady, Status: coreV1.ConditionTrue}}
+		modify(pod)
+		return pod
+	}
+	now := metaV1.Now()
+	tests := []struct {
+		name string
+		pod  *coreV1.Pod
+		want bool
+	}{
+		{name: "accepts the running pod with the condition", pod: ready(func(pod *coreV1.Pod) {}), want: true},
+		{name: "refuses the pending pod", pod: ready(func(pod *coreV1.Pod) { pod.Status.Phase = coreV1.PodPending })},
+		{name: "refuses the pod without an IP", pod: ready(func(pod *coreV1.Pod) { pod.Status.PodIP = "" })},
+		{name: "refuses the terminating pod", pod: ready(func(pod *coreV1.Pod) { pod.DeletionTimestamp = &now })},
+		{name: "refuses the pod which was not ready", pod: ready(func(pod *coreV1.Pod) { pod.Status.Conditions[0].Status = coreV1.ConditionFalse })},
+		{name: "refuses the pod without the condition", pod: ready(func(pod *coreV1.Pod) { pod.Status.Conditions = nil })},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := podReady(tt.pod); got != tt.want {
+				t.Errorf("podReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMostUpToDateSlaveWithoutCandidate(t *testing.T) {
+	foo := newTestMysqlOperator(1, 2)
+	promoted := newTestPod(foo, "cn1-slave-0", k8sCoreV1.SlaveName, "10.0.0.1")
+	promoted.Status.Phase = coreV1.PodRunning
+	promoted.Status.Conditions = []coreV1.PodCondition{{Type: coreV1.PodReady, Status: coreV1.ConditionTrue}}
+	master := promoted.DeepCopy()
+	master.Name, master.Labels[k8sCoreV1.LabelRole] = "cn1-master-0", k8sCoreV1.MasterName
+	f := k8sTesting.NewFixture(t, promoted, master, newTestPod(foo, "cn1-slave-1", k8sCoreV1.SlaveName, ""))
+	f.Start()
+	f.WaitForCache()
+
+	pods, err := listPods(f.Resource(), foo, k8sCoreV1.SlaveName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pods) != 2 || pods[0].Name != "cn1-slave-0" || pods[1].Name != "cn1-slave-1" {
+		t.Errorf("listPods() = %v, want the slaves in the order of their names", pods)
+	}
+	// The lost master was excluded and the other slave was not ready, so that no slave was queried
+	if target, _, err := mostUpToDateSlave(f.Resource(), foo, "cn1-slave-0", Account{}); err == nil {
+		t.Errorf("mostUpToDateSlave() = %s, want no slave being promoted", target.Name)
+	}
+}
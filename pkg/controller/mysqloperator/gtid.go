@@ -0,0 +1,122 @@
+package mysqloperator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// gtidInterval is the range of the transaction numbers of a source, both ends were included
+type gtidInterval struct {
+	Start uint64
+	End   uint64
+}
+
+// gtidSet is a GTID set by the server_uuid of the sources, the intervals of each source were sorted and merged
+type gtidSet map[string][]gtidInterval
+
+// parseGTIDSet parses the text of a GTID set, e.g. the Executed_Gtid_Set of SHOW SLAVE STATUS.
+// The repeated sources were merged, so that the union of the sets was parsed from their texts joined by commas.
+func parseGTIDSet(text string) (gtidSet, error) {
+	set := make(gtidSet)
+	for _, source := range strings.Split(text, ",") {
+		source = strings.TrimSpace(source)
+		if source == "" {
+			continue
+		}
+		parts := strings.Split(source, ":")
+		if len(parts) < 2 {
+			return nil, fmt.Errorf("invalid GTID set %q: %q has no interval", text, source)
+		}
+		uuid := strings.ToLower(parts[0])
+		for _, part := range parts[1:] {
+			bounds := strings.SplitN(part, "-", 2)
+			start, err := strconv.ParseUint(bounds[0], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid GTID set %q: %v", text, err)
+			}
+			end := start
+			if len(bounds) == 2 {
+				if end, err = strconv.ParseUint(bounds[1], 10, 64); err != nil {
+					return nil, fmt.Errorf("invalid GTID set %q: %v", text, err)
+				}
+			}
+			if end < start {
+				return nil, fmt.Errorf("invalid GTID set %q: %q is reversed", text, part)
+			}
+			set[uuid] = append(set[uuid], gtidInterval{Start: start, End: end})
+		}
+	}
+	for uuid, intervals := range set {
+		set[uuid] = mergeIntervals(intervals)
+	}
+	return set, nil
+}
+
+func mergeIntervals(intervals []gtidInterval) []gtidInterval {
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i].Start < intervals[j].Start })
+	res := intervals[:1]
+	for _, in := range intervals[1:] {
+		last := &res[len(res)-1]
+		if in.Start > last.End+1 {
+			res = append(res, in)
+		} else if in.End > last.End {
+			last.End = in.End
+		}
+	}
+	return res
+}
+
+// Contains reports whether every transaction of o was in s
+func (s gtidSet) Contains(o gtidSet) bool {
+	for uuid, intervals := range o {
+		for _, in := range intervals {
+			covered := false
+			for _, own := range s[uuid] {
+				if own.Start <= in.Start && in.End <= own.End {
+					covered = true
+					break
+				}
+			}
+			if !covered {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Count returns the number of the transactions of s
+func (s gtidSet) Count() uint64 {
+	var n uint64
+	for _, intervals := range s {
+		for _, in := range intervals {
+			n += in.End - in.Start + 1
+		}
+	}
+	return n
+}
+
+// String returns the text of s in the order of the sources, which MySQL accepts back
+func (s gtidSet) String() string {
+	uuids := make([]string, 0, len(s))
+	for uuid := range s {
+		uuids = append(uuids, uuid)
+	}
+	sort.Strings(uuids)
+	sources := make([]string, 0, len(uuids))
+	for _, uuid := range uuids {
+		var b strings.Builder
+		b.WriteString(uuid)
+		for _, in := range s[uuid] {
+			if in.Start == in.End {
+				fmt.Fprintf(&b, ":%d", in.Start)
+			} else {
+				fmt.Fprintf(&b, ":%d-%d", in.Start, in.End)
+			}
+		}
+		sources = append(sources, b.String())
+	}
+	return strings.Join(sources, ",")
+}
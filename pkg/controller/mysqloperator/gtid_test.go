@@ -0,0 +1,76 @@
+package mysqloperator
+
+import (
+	"testing"
+)
+
+func TestParseGTIDSet(t *testing.T) {
+	const a, b = "3e11fa47-71ca-11e1-9e33-c80aa9429562", "4e11fa47-71ca-11e1-9e33-c80aa9429562"
+	tests := []struct {
+		name      string
+		text      string
+		want      string
+		wantCount uint64
+		wantErr   bool
+	}{
+		{name: "parses the empty set", text: "", want: "", wantCount: 0},
+		{name: "parses the intervals", text: a + ":1-5:7", want: a + ":1-5:7", wantCount: 6},
+		{name: "merges the repeated sources", text: a + ":1-5,\n" + b + ":3," + a + ":4-9", want: a + ":1-9," + b + ":3", wantCount: 10},
+		{name: "merges the adjacent intervals", text: a + ":6-9:1-5", want: a + ":1-9", wantCount: 9},
+		{name: "refuses the source without interval", text: a, wantErr: true},
+		{name: "refuses the reversed interval", text: a + ":5-1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set, err := parseGTIDSet(tt.text)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseGTIDSet() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := set.String(); got != tt.want {
+				t.Errorf("parseGTIDSet() = %s, want %s", got, tt.want)
+			}
+			if got := set.Count(); got != tt.wantCount {
+				t.Errorf("Count() = %d, want %d", got, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestReplicationProgressLess(t *testing.T) {
+	const a, b = "3e11fa47-71ca-11e1-9e33-c80aa9429562", "4e11fa47-71ca-11e1-9e33-c80aa9429562"
+	gtids := func(text string) replicationProgress {
+		set, err := parseGTIDSet(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The positions were ignored with GTID, they were in the binlogs of different masters after a failover
+		return replicationProgress{Position: binlogPosition{File: "mysql-bin.000009", Pos: 4}, GTIDs: set}
+	}
+	tests := []struct {
+		name string
+		p, o replicationProgress
+		want bool
+	}{
+		{
+			name: "compares the positions without GTID",
+			p:    replicationProgress{Position: binlogPosition{File: "mysql-bin.000001", Pos: 900}},
+			o:    replicationProgress{Position: binlogPosition{File: "mysql-bin.000002", Pos: 4}},
+			want: true,
+		},
+		{name: "the contained set is less", p: gtids(a + ":1-5"), o: gtids(a + ":1-7"), want: true},
+		{name: "the containing set is not less", p: gtids(a + ":1-7"), o: gtids(a + ":1-5")},
+		{name: "the equal sets are not less", p: gtids(a + ":1-7"), o: gtids(a + ":1-7")},
+		{name: "the smaller diverged set is less", p: gtids(a + ":1-5," + b + ":1"), o: gtids(a + ":1-7"), want: true},
+		{name: "the bigger diverged set is not less", p: gtids(a + ":1-7"), o: gtids(a + ":1-5," + b + ":1")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.Less(tt.o); got != tt.want {
+				t.Errorf("Less() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
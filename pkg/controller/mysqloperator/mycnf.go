@@ -0,0 +1,245 @@
+package mysqloperator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	appsV1 "k8s.io/api/apps/v1"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	mysqlOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+)
+
+// myCnfOptionRegexp matches the name of an option, such as: max_connections, slow-query-log
+var myCnfOptionRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+func myCnfName(rds *mysqlOperatorV1.MysqlSpec) string {
+	return fmt.Sprintf(MysqlConfigMapTemplate, rds.Name)
+}
+
+// renderMyCnf renders the options into the [mysqld] section, the options were sorted to keep the hash stable
+func renderMyCnf(myCnf map[string]string) (string, error) {
+	keys := make([]string, 0, len(myCnf))
+	for k, v := range myCnf {
+		if !myCnfOptionRegexp.MatchString(k) {
+			return "", fmt.Errorf("invalid option %q", k)
+		}
+		if strings.ContainsAny(v, "\r\n") {
+			return "", fmt.Errorf("the value of the option %q must be a single line", k)
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	b.WriteString("[mysqld]\n")
+	for _, k := range keys {
+		b.WriteString(fmt.Sprintf("%s = %s\n", k, myCnf[k]))
+	}
+	return b.String(), nil
+}
+
+// parseMyCnf parses the options which were rendered by renderMyCnf
+func parseMyCnf(content string) map[string]string {
+	res := make(map[string]string)
+	for _, line := range strings.Split(content, "\n") {
+		kv := strings.SplitN(line, " = ", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		res[kv[0]] = kv[1]
+	}
+	return res
+}
+
+func myCnfHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:8])
+}
+
+// NewMyCnfConfigMap returns the ConfigMap which holds the my.cnf of the MysqlSpec
+func NewMyCnfConfigMap(foo *mysqlOperatorV1.MysqlOperator, rds *mysqlOperatorV1.MysqlSpec, content string) *coreV1.ConfigMap {
+	return &coreV1.ConfigMap{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      myCnfName(rds),
+			Namespace: foo.Namespace,
+			OwnerReferences: []metaV1.OwnerReference{
+				*metaV1.NewControllerRef(foo, mysqlOperatorV1.SchemeGroupVersion.WithKind(OperatorKindName)),
+			},
+			Labels: map[string]string{
+				k8sCoreV1.LabelApp:        OperatorKindName,
+				k8sCoreV1.LabelController: foo.Name,
+				k8sCoreV1.LabelRole:       rds.Role,
+			},
+		},
+		Data: map[string]string{
+			MysqlConfigFile: content,
+		},
+	}
+}
+
+// myCnfVolume returns the volume of the ConfigMap, the file was mounted with subPath
+// so that the files in /etc/mysql/conf.d of the image were kept
+func myCnfVolume(rds *mysqlOperatorV1.MysqlSpec) (coreV1.Volume, coreV1.VolumeMount) {
+	return coreV1.Volume{
+		Name: MysqlConfigVolume,
+		VolumeSource: coreV1.VolumeSource{
+			ConfigMap: &coreV1.ConfigMapVolumeSource{
+				LocalObjectReference: coreV1.LocalObjectReference{
+					Name: myCnfName(rds),
+				},
+			},
+		},
+	}, coreV1.VolumeMount{
+		MountPath: fmt.Sprintf("%s/%s", MysqlConfigDir, MysqlConfigFile),
+		SubPath:   MysqlConfigFile,
+		Name:      MysqlConfigVolume,
+	}
+}
+
+// currentMyCnfHash returns the hash in the pod template of the StatefulSet
+func currentMyCnfHash(ss *appsV1.StatefulSet) string {
+	if ss == nil {
+		return ""
+	}
+	return ss.Spec.Template.Annotations[MysqlConfigHashAnnotation]
+}
+
+// syncMyCnf keeps the ConfigMap in line with the myCnf, and returns the hash which the pod template
+// should carry. The hash was kept as it was if all of the changed options were applied with SET GLOBAL,
+// otherwise it was changed to trigger a rolling restart of the StatefulSet.
+// The hash was recorded on the ConfigMap as well, so that a failed update of the StatefulSet would be retried.
+func syncMyCnf(ks k8sCoreV1.KubernetesResource,
+	foo *mysqlOperatorV1.MysqlOperator,
+	rds *mysqlOperatorV1.MysqlSpec,
+	ss *appsV1.StatefulSet,
+	recorder record.EventRecorder) (string, error) {
+	name := myCnfName(rds)
+	if len(rds.MyCnf) == 0 {
+		return "", ks.ConfigMap().Delete(foo.Namespace, name)
+	}
+	content, err := renderMyCnf(rds.MyCnf)
+	if err != nil {
+		recorder.Eventf(foo, coreV1.EventTypeWarning, ErrMyCnf, MessageErrMyCnf, rds.Name, err)
+		return "", err
+	}
+	hash := myCnfHash(content)
+	cm, err := ks.ConfigMap().Get(foo.Namespace, name)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return "", err
+		}
+		klog.Info("new my.cnf configMap:", name)
+		cm = NewMyCnfConfigMap(foo, rds, content)
+		cm.Annotations = map[string]string{MysqlConfigHashAnnotation: hash}
+		if _, err = ks.ConfigMap().Create(foo.Namespace, name, cm); err != nil {
+			return "", err
+		}
+		return hash, nil
+	}
+	previous := cm.Data[MysqlConfigFile]
+	if previous == content && cm.Annotations[MysqlConfigHashAnnotation] != "" {
+		return cm.Annotations[MysqlConfigHashAnnotation], nil
+	}
+	target := hash
+	if current := currentMyCnfHash(ss); current != "" && previous != content {
+		changed, removed := diffMyCnf(parseMyCnf(previous), rds.MyCnf)
+		if len(removed) > 0 {
+			recorder.Eventf(foo, coreV1.EventTypeNormal, MyCnfRestart, MessageMyCnfRestart, rds.Name, fmt.Errorf("removed %v", removed))
+		} else if err = setGlobal(ks, foo, rds, changed); err != nil {
+			recorder.Eventf(foo, coreV1.EventTypeNormal, MyCnfRestart, MessageMyCnfRestart, rds.Name, err)
+		} else {
+			recorder.Eventf(foo, coreV1.EventTypeNormal, MyCnfApplied, MessageMyCnfApplied, changed, rds.Name)
+			target = current
+		}
+	}
+	cmCopy := cm.DeepCopy()
+	cmCopy.Data = map[string]string{
+		MysqlConfigFile: content,
+	}
+	if cmCopy.Annotations == nil {
+		cmCopy.Annotations = make(map[string]string)
+	}
+	cmCopy.Annotations[MysqlConfigHashAnnotation] = target
+	if _, err = ks.ConfigMap().Update(foo.Namespace, cmCopy); err != nil {
+		return "", err
+	}
+	return target, nil
+}
+
+// diffMyCnf returns the options which were added or changed, and the names of the ones which were removed
+func diffMyCnf(previous, next map[string]string) (map[string]string, []string) {
+	changed := make(map[string]string)
+	for k, v := range next {
+		if pv, ok := previous[k]; !ok || pv != v {
+			changed[k] = v
+		}
+	}
+	removed := make([]string, 0)
+	for k := range previous {
+		if _, ok := next[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	sort.Strings(removed)
+	return changed, removed
+}
+
+// setGlobal applies the options to all of the running pods of the MysqlSpec with SET GLOBAL.
+// It fails if any of the options is read only (or unknown) or any of the pods is unreachable,
+// in which case a rolling restart was required.
+func setGlobal(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator, rds *mysqlOperatorV1.MysqlSpec, options map[string]string) error {
+	pods, err := listPods(ks, foo, rds.Role)
+	if err != nil {
+		return err
+	}
+	port := strconv.Itoa(MysqlDefaultPort)
+	if len(rds.ContainerPorts) > 0 {
+		port = strconv.Itoa(int(rds.ContainerPorts[0].ContainerPort))
+	}
+	account, err := rootAccount(ks, foo)
+	if err != nil {
+		return err
+	}
+	statements := make([]Statement, 0, len(options))
+	for k, v := range options {
+		// The dashes were allowed in my.cnf but not in SET GLOBAL
+		s := Statement{Query: fmt.Sprintf("SET GLOBAL %s = ?", strings.ReplaceAll(k, "-", "_"))}
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			s.Args = []interface{}{i}
+		} else {
+			s.Args = []interface{}{v}
+		}
+		statements = append(statements, s)
+	}
+	for _, pod := range pods {
+		if pod.Status.Phase != coreV1.PodRunning || pod.Status.PodIP == "" {
+			return fmt.Errorf("pod %s is not running", pod.Name)
+		}
+		if err = Exec(ks.Context(), fmt.Sprintf("%s:%s", pod.Status.PodIP, port), account, statements...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newStatefulSetWithMyCnfHash returns the StatefulSet whose pod template carries the hash of the my.cnf,
+// a different hash rolls the pods of the StatefulSet
+func newStatefulSetWithMyCnfHash(foo *mysqlOperatorV1.MysqlOperator, rds *mysqlOperatorV1.MysqlSpec, hash string) *appsV1.StatefulSet {
+	ss := NewStatefulSet(foo, rds)
+	if hash != "" {
+		ss.Spec.Template.Annotations = map[string]string{
+			MysqlConfigHashAnnotation: hash,
+		}
+	}
+	return ss
+}
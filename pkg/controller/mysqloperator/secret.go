@@ -0,0 +1,288 @@
+package mysqloperator
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	mysqlOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/env"
+	mysqlOperatorClientSet "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned"
+)
+
+// NewConnectionSecret returns the Secret which holds the `-rw` and `-ro` endpoints and the credentials of the account
+// of the connection, so that it could be mounted by the applications with `envFrom`.
+// The credentials were left out until the account was created by its MysqlUser.
+func NewConnectionSecret(foo *mysqlOperatorV1.MysqlOperator, user, password string) *coreV1.Secret {
+	rwPort, roPort := int32(MysqlDefaultPort), int32(MysqlDefaultPort)
+	if len(foo.Spec.MasterSpec.Spec.ServicePorts) > 0 {
+		rwPort = foo.Spec.MasterSpec.Spec.ServicePorts[0].Port
+	}
+	if len(foo.Spec.SlaveSpec.Spec.ServicePorts) > 0 {
+		roPort = foo.Spec.SlaveSpec.Spec.ServicePorts[0].Port
+	}
+	rwHost := fmt.Sprintf("%s.%s.svc", k8sCoreV1.GetReadWriteServiceName(foo.Name), foo.Namespace)
+	roHost := fmt.Sprintf("%s.%s.svc", k8sCoreV1.GetReadOnlyServiceName(foo.Name), foo.Namespace)
+	uri := func(host string, port int32) string {
+		u := url.URL{
+			Scheme: "mysql",
+			Host:   net.JoinHostPort(host, strconv.Itoa(int(port))),
+			Path:   "/",
+		}
+		if password != "" {
+			u.User = url.UserPassword(user, password)
+		}
+		return u.String()
+	}
+	secret := &coreV1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      k8sCoreV1.GetConnectionSecretName(foo.Name),
+			Namespace: foo.Namespace,
+			OwnerReferences: []metaV1.OwnerReference{
+				*metaV1.NewControllerRef(foo, mysqlOperatorV1.SchemeGroupVersion.WithKind(OperatorKindName)),
+			},
+			Labels: map[string]string{
+				k8sCoreV1.LabelApp:        OperatorKindName,
+				k8sCoreV1.LabelController: foo.Name,
+			},
+		},
+		Type: coreV1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			k8sCoreV1.ConnectionRWHost: []byte(rwHost),
+			k8sCoreV1.ConnectionRWPort: []byte(strconv.Itoa(int(rwPort))),
+			k8sCoreV1.ConnectionRWURI:  []byte(uri(rwHost, rwPort)),
+			k8sCoreV1.ConnectionROHost: []byte(roHost),
+			k8sCoreV1.ConnectionROPort: []byte(strconv.Itoa(int(roPort))),
+			k8sCoreV1.ConnectionROURI:  []byte(uri(roHost, roPort)),
+		},
+	}
+	if password != "" {
+		secret.Data[k8sCoreV1.ConnectionUsername] = []byte(user)
+		secret.Data[k8sCoreV1.ConnectionPassword] = []byte(password)
+	}
+	return secret
+}
+
+func connectionUserName(foo *mysqlOperatorV1.MysqlOperator) string {
+	return fmt.Sprintf(MysqlConnectionUserTemplate, foo.Name)
+}
+
+// NewConnectionUser returns the MysqlUser of the account which was published in the connection Secret.
+// The account has no privileges but the grants of the connection, rather than being the root.
+func NewConnectionUser(foo *mysqlOperatorV1.MysqlOperator) *mysqlOperatorV1.MysqlUser {
+	spec := mysqlOperatorV1.MysqlUserSpec{
+		Operator:       foo.Name,
+		User:           MysqlConnectionDefaultUser,
+		PasswordSecret: fmt.Sprintf(MysqlConnectionCredentialsTemplate, foo.Name),
+	}
+	if c := foo.Spec.Connection; c != nil {
+		if c.User != "" {
+			spec.User = c.User
+		}
+		spec.Grants = c.Grants
+	}
+	return &mysqlOperatorV1.MysqlUser{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      connectionUserName(foo),
+			Namespace: foo.Namespace,
+			OwnerReferences: []metaV1.OwnerReference{
+				*metaV1.NewControllerRef(foo, mysqlOperatorV1.SchemeGroupVersion.WithKind(OperatorKindName)),
+			},
+			Labels: map[string]string{
+				k8sCoreV1.LabelApp:        OperatorKindName,
+				k8sCoreV1.LabelController: foo.Name,
+			},
+		},
+		Spec: spec,
+	}
+}
+
+// applyConnectionUser creates or updates the MysqlUser of the connection, whose account was created by its controller
+func applyConnectionUser(ctx context.Context, clientSet mysqlOperatorClientSet.Interface, foo *mysqlOperatorV1.MysqlOperator) (*mysqlOperatorV1.MysqlUser, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	users := clientSet.NevercaseV1().MysqlUsers(foo.Namespace)
+	desired := NewConnectionUser(foo)
+	current, err := users.Get(ctx, desired.Name, metaV1.GetOptions{})
+	if errors.IsNotFound(err) {
+		klog.Info("new connection user:", desired.Name)
+		return users.Create(ctx, desired, metaV1.CreateOptions{DryRun: k8sCoreV1.DryRunOptions()})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !metaV1.IsControlledBy(current, foo) {
+		return nil, k8sCoreV1.NewResourceExistsError("MysqlUser", current.Name)
+	}
+	if equality.Semantic.DeepEqual(current.Spec, desired.Spec) {
+		return current, nil
+	}
+	currentCopy := current.DeepCopy()
+	currentCopy.Spec = desired.Spec
+	return users.Update(ctx, currentCopy, metaV1.UpdateOptions{DryRun: k8sCoreV1.DryRunOptions()})
+}
+
+// connectionCredentials returns the credentials of the account of the connection,
+// they were empty until the Secret was created by the MysqlUser
+func connectionCredentials(ks k8sCoreV1.KubernetesResource, user *mysqlOperatorV1.MysqlUser) (string, string, error) {
+	secret, err := ks.Secret().Get(user.Namespace, user.Spec.PasswordSecret)
+	if errors.IsNotFound(err) {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	return string(secret.Data[UserSecretKeyUsername]), string(secret.Data[UserSecretKeyPassword]), nil
+}
+
+const passwordLetters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// GeneratePassword returns a random password of n letters and digits
+func GeneratePassword(n int) (string, error) {
+	res := make([]byte, n)
+	max := big.NewInt(int64(len(passwordLetters)))
+	for i := range res {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		res[i] = passwordLetters[n.Int64()]
+	}
+	return string(res), nil
+}
+
+func replicationSecretName(foo *mysqlOperatorV1.MysqlOperator) string {
+	return fmt.Sprintf(MysqlReplicationSecretTemplate, foo.Name)
+}
+
+func rootSecretName(foo *mysqlOperatorV1.MysqlOperator) string {
+	return fmt.Sprintf(MysqlRootSecretTemplate, foo.Name)
+}
+
+// NewReplicationSecret returns the Secret which holds the credentials of the replication account,
+// they were passed to the master and the slaves and used to repoint the slaves by the failover
+func NewReplicationSecret(foo *mysqlOperatorV1.MysqlOperator, password string) *coreV1.Secret {
+	return newAccountSecret(foo, replicationSecretName(foo), MysqlReplicationUser, password)
+}
+
+// NewRootSecret returns the Secret which holds the credentials of the root account, the password of root
+// was initialized from it by the image, and the operator, the backup sidecar and the restore job connect with it
+func NewRootSecret(foo *mysqlOperatorV1.MysqlOperator, password string) *coreV1.Secret {
+	return newAccountSecret(foo, rootSecretName(foo), MysqlRootUser, password)
+}
+
+func newAccountSecret(foo *mysqlOperatorV1.MysqlOperator, name, user, password string) *coreV1.Secret {
+	return &coreV1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      name,
+			Namespace: foo.Namespace,
+			OwnerReferences: []metaV1.OwnerReference{
+				*metaV1.NewControllerRef(foo, mysqlOperatorV1.SchemeGroupVersion.WithKind(OperatorKindName)),
+			},
+			Labels: map[string]string{
+				k8sCoreV1.LabelApp:        OperatorKindName,
+				k8sCoreV1.LabelController: foo.Name,
+			},
+		},
+		Type: coreV1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			k8sCoreV1.ConnectionUsername: []byte(user),
+			k8sCoreV1.ConnectionPassword: []byte(password),
+		},
+	}
+}
+
+// ensureReplicationSecret creates the Secret of the replication account unless it exists.
+// The password was generated, unless the master was created before the Secret and kept the legacy one.
+func ensureReplicationSecret(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator) error {
+	return ensureAccountSecret(ks, foo, NewReplicationSecret(foo, ""), MysqlLegacyReplicationPassword)
+}
+
+// ensureRootSecret creates the Secret of the root account unless it exists. The password was the one of
+// config of the masterSpec or a generated one, unless the master was created before the Secret and kept the legacy one.
+// The password of root was only initialized with the empty data directory, so the Secret was never updated from the spec.
+func ensureRootSecret(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator) error {
+	return ensureAccountSecret(ks, foo, NewRootSecret(foo, foo.Spec.MasterSpec.Spec.Config.Password), MysqlLegacyRootPassword)
+}
+
+// ensureAccountSecret creates secret unless it exists, its empty password was filled with legacyPassword if the master
+// StatefulSet existed before the Secret, or a generated one otherwise
+func ensureAccountSecret(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator, secret *coreV1.Secret, legacyPassword string) error {
+	_, err := ks.Secret().Get(foo.Namespace, secret.Name)
+	if err == nil || !errors.IsNotFound(err) {
+		return err
+	}
+	password := legacyPassword
+	if _, err = ks.StatefulSet().Get(foo.Namespace, masterRdsName(foo)); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		password = string(secret.Data[k8sCoreV1.ConnectionPassword])
+		if password == "" {
+			if password, err = GeneratePassword(MysqlPasswordLength); err != nil {
+				return err
+			}
+		}
+	}
+	secret.Data[k8sCoreV1.ConnectionPassword] = []byte(password)
+	klog.Info("new account secret:", secret.Name)
+	_, err = ks.Secret().Create(foo.Namespace, secret)
+	return err
+}
+
+// replicationCredentials returns the user and the password of the replication account
+func replicationCredentials(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator) (user, password string, err error) {
+	account, err := accountCredentials(ks, foo, replicationSecretName(foo))
+	return account.User, account.Password, err
+}
+
+// rootAccount returns the root account which the operator connects to the mysql with
+func rootAccount(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator) (Account, error) {
+	return accountCredentials(ks, foo, rootSecretName(foo))
+}
+
+func accountCredentials(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator, name string) (Account, error) {
+	secret, err := ks.Secret().Get(foo.Namespace, name)
+	if err != nil {
+		return Account{}, err
+	}
+	account := Account{User: string(secret.Data[k8sCoreV1.ConnectionUsername]), Password: string(secret.Data[k8sCoreV1.ConnectionPassword])}
+	if account.User == "" || account.Password == "" {
+		return Account{}, fmt.Errorf("secret %s has no %q or %q", name, k8sCoreV1.ConnectionUsername, k8sCoreV1.ConnectionPassword)
+	}
+	return account, nil
+}
+
+// replicationEnv returns the environment variable of the key of the Secret of the replication account
+func replicationEnv(foo *mysqlOperatorV1.MysqlOperator, name, key string) coreV1.EnvVar {
+	return secretEnv(replicationSecretName(foo), name, key)
+}
+
+// rootEnv returns the environment variable of the key of the Secret of the root account
+func rootEnv(foo *mysqlOperatorV1.MysqlOperator, name, key string) coreV1.EnvVar {
+	return secretEnv(rootSecretName(foo), name, key)
+}
+
+func secretEnv(secretName, name, key string) coreV1.EnvVar {
+	return coreV1.EnvVar{
+		Name: name,
+		ValueFrom: &coreV1.EnvVarSource{
+			SecretKeyRef: &coreV1.SecretKeySelector{
+				LocalObjectReference: coreV1.LocalObjectReference{Name: secretName},
+				Key:                  key,
+			},
+		},
+	}
+}
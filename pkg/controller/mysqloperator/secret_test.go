@@ -0,0 +1,153 @@
+package mysqloperator
+
+import (
+	"testing"
+	"time"
+
+	appsV1 "k8s.io/api/apps/v1"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	k8sTesting "github.com/nevercase/k8s-controller-custom-resource/core/v1/testing"
+	mysqlOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+)
+
+func TestEnsureReplicationSecret(t *testing.T) {
+	foo := newTestMysqlOperator(1, 2)
+	existingMaster := &appsV1.StatefulSet{
+		ObjectMeta: metaV1.ObjectMeta{Name: k8sCoreV1.GetStatefulSetName(masterRdsName(foo)), Namespace: k8sTesting.Namespace},
+	}
+	existingSecret := NewReplicationSecret(foo, "kept")
+
+	tests := []struct {
+		name         string
+		objects      []runtime.Object
+		wantPassword string
+	}{
+		{
+			name: "generates the password of a new MysqlOperator",
+		},
+		{
+			name:         "keeps the legacy password of an existing master",
+			objects:      []runtime.Object{existingMaster},
+			wantPassword: MysqlLegacyReplicationPassword,
+		},
+		{
+			name:         "keeps the existing secret",
+			objects:      []runtime.Object{existingMaster, existingSecret},
+			wantPassword: "kept",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := k8sTesting.NewFixture(t, tt.objects...)
+			f.Start()
+
+			if err := ensureReplicationSecret(f.Resource(), foo); err != nil {
+				t.Fatalf("ensureReplicationSecret() error = %v", err)
+			}
+			f.WaitForCache()
+			user, password, err := replicationCredentials(f.Resource(), foo)
+			if err != nil {
+				t.Fatalf("replicationCredentials() error = %v", err)
+			}
+			if user != MysqlReplicationUser {
+				t.Errorf("user = %s, want %s", user, MysqlReplicationUser)
+			}
+			switch {
+			case tt.wantPassword != "" && password != tt.wantPassword:
+				t.Errorf("password = %s, want %s", password, tt.wantPassword)
+			case tt.wantPassword == "" && (len(password) != MysqlPasswordLength || password == MysqlLegacyReplicationPassword):
+				t.Errorf("password = %s, want a generated one", password)
+			}
+			secret := f.Secret(k8sTesting.Namespace, replicationSecretName(foo))
+			if !metaV1.IsControlledBy(secret, foo) {
+				t.Errorf("secret %v is not controlled by %s", secret.OwnerReferences, foo.Name)
+			}
+		})
+	}
+}
+
+func TestEnsureRootSecret(t *testing.T) {
+	existingMaster := &appsV1.StatefulSet{
+		ObjectMeta: metaV1.ObjectMeta{Name: k8sCoreV1.GetStatefulSetName(masterRdsName(newTestMysqlOperator(1, 2))), Namespace: k8sTesting.Namespace},
+	}
+
+	tests := []struct {
+		name         string
+		password     string
+		objects      []runtime.Object
+		wantPassword string
+	}{
+		{
+			name: "generates the password of a new MysqlOperator",
+		},
+		{
+			name:         "takes the password of the config",
+			password:     "configured",
+			wantPassword: "configured",
+		},
+		{
+			name:         "keeps the legacy password of an existing master",
+			password:     "configured",
+			objects:      []runtime.Object{existingMaster},
+			wantPassword: MysqlLegacyRootPassword,
+		},
+		{
+			name:         "keeps the existing secret",
+			password:     "configured",
+			objects:      []runtime.Object{NewRootSecret(newTestMysqlOperator(1, 2), "kept")},
+			wantPassword: "kept",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			foo := newTestMysqlOperator(1, 2)
+			foo.Spec.MasterSpec.Spec.Config.Password = tt.password
+			f := k8sTesting.NewFixture(t, tt.objects...)
+			f.Start()
+
+			if err := ensureRootSecret(f.Resource(), foo); err != nil {
+				t.Fatalf("ensureRootSecret() error = %v", err)
+			}
+			f.WaitForCache()
+			account, err := rootAccount(f.Resource(), foo)
+			if err != nil {
+				t.Fatalf("rootAccount() error = %v", err)
+			}
+			if account.User != MysqlRootUser {
+				t.Errorf("user = %s, want %s", account.User, MysqlRootUser)
+			}
+			switch {
+			case tt.wantPassword != "" && account.Password != tt.wantPassword:
+				t.Errorf("password = %s, want %s", account.Password, tt.wantPassword)
+			case tt.wantPassword == "" && (len(account.Password) != MysqlPasswordLength || account.Password == MysqlLegacyRootPassword):
+				t.Errorf("password = %s, want a generated one", account.Password)
+			}
+		})
+	}
+}
+
+func TestRootPasswordFromSecret(t *testing.T) {
+	foo := newTestMysqlOperator(1, 2)
+	foo.Spec.Backup = &mysqlOperatorV1.MysqlBackupSpec{}
+	foo = withDefaults(foo)
+	foo.Spec.MasterSpec.Spec.Config.ServerId = k8sTesting.Int32Ptr(1)
+	envs := map[string][]coreV1.EnvVar{
+		"mysql":   NewStatefulSet(foo, &foo.Spec.MasterSpec.Spec).Spec.Template.Spec.Containers[0].Env,
+		"backup":  NewBackupDeployment(foo).Spec.Template.Spec.Containers[0].Env,
+		"restore": NewRestoreJob(foo, time.Now(), nil).Spec.Template.Spec.Containers[0].Env,
+	}
+	for container, env := range envs {
+		for _, e := range env {
+			if e.Name != MysqlRootPassword && e.Name != MysqlBackupPassword {
+				continue
+			}
+			if e.Value != "" || e.ValueFrom == nil || e.ValueFrom.SecretKeyRef == nil || e.ValueFrom.SecretKeyRef.Name != rootSecretName(foo) {
+				t.Errorf("%s of %s = %+v, want the key of secret %s", e.Name, container, e, rootSecretName(foo))
+			}
+		}
+	}
+}
@@ -1,7 +1,6 @@
 package mysqloperator
 
 import (
-	"github.com/Shanghai-Lunara/helixsaga-operator/pkg/serviceloadbalancer"
 	corev1 "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -17,17 +16,13 @@ func NewService(foo *mysqlOperatorV1.MysqlOperator, rds *mysqlOperatorV1.MysqlSp
 		k8scorev1.LabelRole:       rds.Role,
 	}
 	serviceName = k8scorev1.GetServiceName(rds.Name)
-	ports := []corev1.ServicePort{
-		{
-			Port: MysqlDefaultPort,
-		},
-	}
-	if len(rds.ServicePorts) > 0 {
-		ports = rds.ServicePorts
+	selector := labels
+	if rds.Role == k8scorev1.MasterName {
+		selector = masterSelector(foo, foo.Spec.MasterSpec.Status.CurrentMaster)
 	}
 	return &corev1.Service{
 		ObjectMeta: metaV1.ObjectMeta{
-			Annotations: serviceloadbalancer.Annotation(rds.ServiceType, rds.ServiceWhiteList),
+			Annotations: k8scorev1.LoadBalancerAnnotation(rds.ServiceType, rds.ServiceWhiteList),
 			Name:        serviceName,
 			Namespace:   foo.Namespace,
 			OwnerReferences: []metaV1.OwnerReference{
@@ -36,9 +31,59 @@ func NewService(foo *mysqlOperatorV1.MysqlOperator, rds *mysqlOperatorV1.MysqlSp
 			Labels: labels,
 		},
 		Spec: corev1.ServiceSpec{
-			Type:     k8scorev1.GetServiceType(rds.ServiceType),
+			Type:     rds.ServiceType,
+			Ports:    rds.ServicePorts,
+			Selector: selector,
+		},
+	}
+}
+
+// NewAccessServices returns the Services which were named after the MysqlOperator:
+// the `-rw` Service selects the current master (which might be a promoted slave), the `-ro` Service
+// selects the ready slaves and the headless Service gives each pod a DNS record.
+func NewAccessServices(foo *mysqlOperatorV1.MysqlOperator) []*corev1.Service {
+	masterPorts := []corev1.ServicePort{{Port: MysqlDefaultPort}}
+	if len(foo.Spec.MasterSpec.Spec.ServicePorts) > 0 {
+		masterPorts = foo.Spec.MasterSpec.Spec.ServicePorts
+	}
+	slavePorts := []corev1.ServicePort{{Port: MysqlDefaultPort}}
+	if len(foo.Spec.SlaveSpec.Spec.ServicePorts) > 0 {
+		slavePorts = foo.Spec.SlaveSpec.Spec.ServicePorts
+	}
+	rw := newAccessService(foo, k8scorev1.GetReadWriteServiceName(foo.Name), k8scorev1.AccessReadWrite, masterPorts)
+	ro := newAccessService(foo, k8scorev1.GetReadOnlyServiceName(foo.Name), k8scorev1.AccessReadOnly, slavePorts)
+	headless := newAccessService(foo, k8scorev1.GetHeadlessServiceName(foo.Name), "", masterPorts)
+	headless.Spec.ClusterIP = corev1.ClusterIPNone
+	headless.Spec.PublishNotReadyAddresses = true
+	return []*corev1.Service{rw, ro, headless}
+}
+
+func newAccessService(foo *mysqlOperatorV1.MysqlOperator, name, access string, ports []corev1.ServicePort) *corev1.Service {
+	var labels = map[string]string{
+		k8scorev1.LabelApp:        OperatorKindName,
+		k8scorev1.LabelController: foo.Name,
+	}
+	selector := map[string]string{
+		k8scorev1.LabelApp:        OperatorKindName,
+		k8scorev1.LabelController: foo.Name,
+	}
+	if access != "" {
+		labels[k8scorev1.LabelAccess] = access
+		selector[k8scorev1.LabelAccess] = access
+	}
+	return &corev1.Service{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      name,
+			Namespace: foo.Namespace,
+			OwnerReferences: []metaV1.OwnerReference{
+				*metaV1.NewControllerRef(foo, mysqlOperatorV1.SchemeGroupVersion.WithKind(OperatorKindName)),
+			},
+			Labels: labels,
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeClusterIP,
 			Ports:    ports,
-			Selector: labels,
+			Selector: selector,
 		},
 	}
 }
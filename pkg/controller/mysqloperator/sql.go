@@ -0,0 +1,144 @@
+package mysqloperator
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	mysqlOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/env"
+	mysqlOperatorClientSet "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned"
+)
+
+// MasterAddress returns the host and the port of the master Service.
+// The host was qualified with the namespace, so that it could be reached from the operator.
+func MasterAddress(foo *mysqlOperatorV1.MysqlOperator) (host, port string) {
+	masterName := fmt.Sprintf("%s-%s", foo.Spec.MasterSpec.Spec.Name, k8sCoreV1.MasterName)
+	port = strconv.Itoa(MysqlDefaultPort)
+	if len(foo.Spec.MasterSpec.Spec.ServicePorts) > 0 {
+		port = strconv.Itoa(int(foo.Spec.MasterSpec.Spec.ServicePorts[0].Port))
+	}
+	return fmt.Sprintf("%s.%s", k8sCoreV1.GetServiceName(masterName), foo.Namespace), port
+}
+
+// GetOperator returns the MysqlOperator which was referenced by a MysqlDatabase or a MysqlUser
+func GetOperator(ctx context.Context, clientSet mysqlOperatorClientSet.Interface, nameSpace, name string) (*mysqlOperatorV1.MysqlOperator, error) {
+	if name == "" {
+		return nil, fmt.Errorf("the name of the MysqlOperator must be specified")
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	return clientSet.NevercaseV1().MysqlOperators(nameSpace).Get(ctx, name, metaV1.GetOptions{})
+}
+
+// Account is the user and the password which the operator connects to the mysql with
+type Account struct {
+	User     string
+	Password string
+}
+
+// Open connects to the mysql instance at addr with the account.
+// The parameters of the statements were interpolated by the driver, because the
+// account management statements couldn't be prepared by the server.
+func Open(addr string, account Account) (*sql.DB, error) {
+	cfg := mysql.NewConfig()
+	cfg.User = account.User
+	cfg.Passwd = account.Password
+	cfg.Net = "tcp"
+	cfg.Addr = addr
+	cfg.InterpolateParams = true
+	cfg.Timeout = time.Second * 5
+	db, err := sql.Open("mysql", cfg.FormatDSN())
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(1)
+	return db, nil
+}
+
+// Exec executes the statements on the mysql instance at addr one by one.
+// The statements were logged only in the dry run, their parameters were left out since they may carry the passwords.
+func Exec(ctx context.Context, addr string, account Account, statements ...Statement) error {
+	if k8sCoreV1.IsDryRun() {
+		for _, s := range statements {
+			klog.InfoS("Dry run", "verb", "exec", "addr", addr, "query", s.Query)
+		}
+		return nil
+	}
+	db, err := Open(addr, account)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	for _, s := range statements {
+		if _, err = db.ExecContext(ctx, s.Query, s.Args...); err != nil {
+			return fmt.Errorf("%s: %v", s.Query, err)
+		}
+	}
+	return nil
+}
+
+// ExecMaster executes the statements on the master of the MysqlOperator one by one with the root account of its Secret
+func ExecMaster(ctx context.Context, ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator, statements ...Statement) error {
+	account, err := rootAccount(ks, foo)
+	if err != nil {
+		return err
+	}
+	host, port := MasterAddress(foo)
+	return Exec(ctx, fmt.Sprintf("%s:%s", host, port), account, statements...)
+}
+
+// QueryMaster returns the first column of the rows of the statement on the master of the MysqlOperator.
+// The queries were read only, so they ran in the dry run as well.
+func QueryMaster(ctx context.Context, ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlOperator, s Statement) ([]string, error) {
+	account, err := rootAccount(ks, foo)
+	if err != nil {
+		return nil, err
+	}
+	host, port := MasterAddress(foo)
+	db, err := Open(fmt.Sprintf("%s:%s", host, port), account)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	rows, err := db.QueryContext(ctx, s.Query, s.Args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", s.Query, err)
+	}
+	defer rows.Close()
+	res := make([]string, 0)
+	for rows.Next() {
+		var v string
+		if err = rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, rows.Err()
+}
+
+// Statement is a SQL statement with its parameters
+type Statement struct {
+	Query string
+	Args  []interface{}
+}
+
+// QuoteIdentifier quotes the name of a database or a table, "*" was kept as it is
+func QuoteIdentifier(name string) string {
+	if name == "*" {
+		return name
+	}
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
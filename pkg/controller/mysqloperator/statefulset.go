@@ -18,6 +18,16 @@ func NewStatefulSet(foo *mysqlOperatorV1.MysqlOperator, rds *mysqlOperatorV1.Mys
 		k8sCoreV1.LabelController: foo.Name,
 		k8sCoreV1.LabelRole:       rds.Role,
 	}
+	// The access of the pods would be changed by the operator at runtime, so that it wasn't a part of the selector
+	podLabels := map[string]string{
+		k8sCoreV1.LabelAccess: k8sCoreV1.AccessReadOnly,
+	}
+	for k, v := range labels {
+		podLabels[k] = v
+	}
+	if rds.Role == k8sCoreV1.MasterName {
+		podLabels[k8sCoreV1.LabelAccess] = k8sCoreV1.AccessReadWrite
+	}
 	t := coreV1.HostPathDirectoryOrCreate
 	hostPath := &coreV1.HostPathVolumeSource{
 		Type: &t,
@@ -26,14 +36,6 @@ func NewStatefulSet(foo *mysqlOperatorV1.MysqlOperator, rds *mysqlOperatorV1.Mys
 	objectName := k8sCoreV1.GetStatefulSetName(rds.Name)
 	containerName := k8sCoreV1.GetContainerName(rds.Name)
 	masterName := fmt.Sprintf("%s-%s", foo.Spec.MasterSpec.Spec.Name, k8sCoreV1.MasterName)
-	ports := []coreV1.ContainerPort{
-		{
-			ContainerPort: MysqlDefaultPort,
-		},
-	}
-	if len(rds.ContainerPorts) > 0 {
-		ports = rds.ContainerPorts
-	}
 	masterPort := strconv.Itoa(MysqlDefaultPort)
 	if len(foo.Spec.MasterSpec.Spec.ServicePorts) > 0 {
 		masterPort = strconv.Itoa(int(foo.Spec.MasterSpec.Spec.ServicePorts[0].Port))
@@ -48,14 +50,15 @@ func NewStatefulSet(foo *mysqlOperatorV1.MysqlOperator, rds *mysqlOperatorV1.Mys
 			Labels: labels,
 		},
 		Spec: appsV1.StatefulSetSpec{
-			Replicas: rds.Replicas,
+			ServiceName: k8sCoreV1.GetHeadlessServiceName(foo.Name),
+			Replicas:    rds.Replicas,
 			//Replicas: foo.Spec.MasterSpec.Replicas,
 			Selector: &metaV1.LabelSelector{
 				MatchLabels: labels,
 			},
 			Template: coreV1.PodTemplateSpec{
 				ObjectMeta: metaV1.ObjectMeta{
-					Labels: labels,
+					Labels: podLabels,
 				},
 				Spec: coreV1.PodSpec{
 					Volumes: []coreV1.Volume{
@@ -73,16 +76,13 @@ func NewStatefulSet(foo *mysqlOperatorV1.MysqlOperator, rds *mysqlOperatorV1.Mys
 						{
 							Name:  containerName,
 							Image: rds.Image,
-							Ports: ports,
+							Ports: rds.ContainerPorts,
 							Env: []coreV1.EnvVar{
 								{
 									Name:  MysqlServerId,
 									Value: strconv.Itoa(int(*rds.Config.ServerId)),
 								},
-								{
-									Name:  MysqlRootPassword,
-									Value: MysqlDefaultRootPassword,
-								},
+								rootEnv(foo, MysqlRootPassword, k8sCoreV1.ConnectionPassword),
 								{
 									Name:  MysqlDataDir,
 									Value: "/data",
@@ -99,14 +99,8 @@ func NewStatefulSet(foo *mysqlOperatorV1.MysqlOperator, rds *mysqlOperatorV1.Mys
 									Name:  MysqlMasterPort,
 									Value: masterPort,
 								},
-								{
-									Name:  MysqlMasterUser,
-									Value: "root",
-								},
-								{
-									Name:  MysqlMasterPassword,
-									Value: "root",
-								},
+								replicationEnv(foo, MysqlMasterUser, k8sCoreV1.ConnectionUsername),
+								replicationEnv(foo, MysqlMasterPassword, k8sCoreV1.ConnectionPassword),
 								{
 									Name:  MysqlMasterLogFile,
 									Value: "",
@@ -123,7 +117,7 @@ func NewStatefulSet(foo *mysqlOperatorV1.MysqlOperator, rds *mysqlOperatorV1.Mys
 									Name:      "task-pv-storage",
 								},
 							},
-							ImagePullPolicy: coreV1.PullAlways,
+							ImagePullPolicy: rds.ImagePullPolicy,
 						},
 					},
 					ImagePullSecrets: rds.ImagePullSecrets,
@@ -133,5 +127,11 @@ func NewStatefulSet(foo *mysqlOperatorV1.MysqlOperator, rds *mysqlOperatorV1.Mys
 			},
 		},
 	}
+	if len(rds.MyCnf) > 0 {
+		podSpec := &standard.Spec.Template.Spec
+		volume, mount := myCnfVolume(rds)
+		podSpec.Volumes = append(podSpec.Volumes, volume)
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, mount)
+	}
 	return standard
 }
@@ -0,0 +1,78 @@
+package mysqloperator
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	mysqlOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/env"
+	mysqlOperatorClientSet "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned"
+)
+
+// syncOverallStatus refreshes the status subresource of the MysqlOperator from the statuses of the master and
+// the slaves in its spec. The latest MysqlOperator was got since those statuses were just updated by the sync.
+func syncOverallStatus(ctx context.Context, clientSet mysqlOperatorClientSet.Interface, namespace, name string) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	foo, err := clientSet.NevercaseV1().MysqlOperators(namespace).Get(ctx, name, metaV1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	status := overallStatus(foo)
+	if equality.Semantic.DeepEqual(foo.Status, status) || k8sCoreV1.SuppressedByDryRun(OperatorKindName, foo) {
+		return nil
+	}
+	fooCopy := foo.DeepCopy()
+	fooCopy.Status = status
+	_, err = clientSet.NevercaseV1().MysqlOperators(namespace).UpdateStatus(ctx, fooCopy, metaV1.UpdateOptions{})
+	return err
+}
+
+// overallStatus summarizes the master and the slaves, the slaves were what the scale subresource scales.
+// Their statuses were mirrored as well, and the conditions of the v2 API were kept.
+func overallStatus(foo *mysqlOperatorV1.MysqlOperator) mysqlOperatorV1.MysqlOperatorStatus {
+	master, slave := foo.Spec.MasterSpec, foo.Spec.SlaveSpec
+	status := mysqlOperatorV1.MysqlOperatorStatus{
+		Phase:         MysqlPhaseReady,
+		Replicas:      slave.Status.Replicas,
+		ReadyReplicas: master.Status.ReadyReplicas + slave.Status.ReadyReplicas,
+		Selector: labels.SelectorFromSet(map[string]string{
+			k8sCoreV1.LabelApp:        OperatorKindName,
+			k8sCoreV1.LabelController: foo.Name,
+			k8sCoreV1.LabelRole:       k8sCoreV1.SlaveName,
+		}).String(),
+		Conditions: foo.Status.Conditions,
+		Master:     master.Status.DeepCopy(),
+		Slave:      slave.Status.DeepCopy(),
+	}
+	masterReplicas := master.Spec.Replicas
+	if master.Status.CurrentMaster != "" {
+		// The master StatefulSet was fenced since a slave had been promoted
+		fenced := int32(0)
+		masterReplicas = &fenced
+	}
+	switch {
+	case master.Status.Paused:
+		status.Phase = MysqlPhasePaused
+	case !rolledOut(masterReplicas, master.Status) || !rolledOut(slave.Spec.Replicas, slave.Status):
+		status.Phase = MysqlPhasePending
+	}
+	return status
+}
+
+// rolledOut is the same as k8sCoreV1.StatefulSetRolledOut for the status which was copied from the StatefulSet
+func rolledOut(replicas *int32, s mysqlOperatorV1.MysqlStatus) bool {
+	desired := int32(1)
+	if replicas != nil {
+		desired = *replicas
+	}
+	if s.UpdateRevision != "" && s.CurrentRevision != s.UpdateRevision {
+		return false
+	}
+	return s.UpdatedReplicas >= desired && s.ReadyReplicas >= desired
+}
@@ -0,0 +1,109 @@
+package mysqloperator
+
+import (
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	mysqlOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+)
+
+var (
+	masterSpecPath = field.NewPath("spec", "masterSpec", "spec")
+	slaveSpecPath  = field.NewPath("spec", "slaveSpec", "spec")
+)
+
+// ValidateCreate validates the MysqlOperator in the admission webhook, the names of its children
+// mustn't be taken by the others in the namespace.
+func (r *Reconciler) ValidateCreate(foo *mysqlOperatorV1.MysqlOperator, others []*mysqlOperatorV1.MysqlOperator) field.ErrorList {
+	return append(validateSpec(foo), validateChildNames(foo, others)...)
+}
+
+// ValidateUpdate validates the MysqlOperator in the admission webhook. The names and the volume paths were immutable,
+// since the data of the master and the slaves was kept under them, and the spec was validated only if it was changed.
+func (r *Reconciler) ValidateUpdate(old, foo *mysqlOperatorV1.MysqlOperator, others []*mysqlOperatorV1.MysqlOperator) field.ErrorList {
+	if foo.DeletionTimestamp != nil {
+		return nil
+	}
+	res := field.ErrorList{}
+	for _, v := range []struct {
+		path     *field.Path
+		old, new *mysqlOperatorV1.MysqlSpec
+	}{
+		{masterSpecPath, &old.Spec.MasterSpec.Spec, &foo.Spec.MasterSpec.Spec},
+		{slaveSpecPath, &old.Spec.SlaveSpec.Spec, &foo.Spec.SlaveSpec.Spec},
+	} {
+		res = append(res, apivalidation.ValidateImmutableField(v.new.Name, v.old.Name, v.path.Child("name"))...)
+		res = append(res, apivalidation.ValidateImmutableField(v.new.VolumePath, v.old.VolumePath, v.path.Child("volumePath"))...)
+	}
+	if !apiequality.Semantic.DeepEqual(old.Spec.MasterSpec.Spec, foo.Spec.MasterSpec.Spec) ||
+		!apiequality.Semantic.DeepEqual(old.Spec.SlaveSpec.Spec, foo.Spec.SlaveSpec.Spec) ||
+		!apiequality.Semantic.DeepEqual(old.Spec.Backup, foo.Spec.Backup) {
+		res = append(res, validateSpec(foo)...)
+	}
+	return res
+}
+
+// validateSpec returns the problems of the spec of foo which the sync couldn't get over,
+// the MysqlOperators which were stored before the schema of the CustomResourceDefinition might have them.
+func validateSpec(foo *mysqlOperatorV1.MysqlOperator) field.ErrorList {
+	res := validateMysqlSpec(&foo.Spec.MasterSpec.Spec, k8sCoreV1.MasterName, masterSpecPath)
+	res = append(res, validateMysqlSpec(&foo.Spec.SlaveSpec.Spec, k8sCoreV1.SlaveName, slaveSpecPath)...)
+	if foo.Spec.Backup != nil && foo.Spec.Backup.Image == "" {
+		res = append(res, field.Required(field.NewPath("spec", "backup", "image"), ""))
+	}
+	return res
+}
+
+func validateMysqlSpec(rds *mysqlOperatorV1.MysqlSpec, role string, path *field.Path) field.ErrorList {
+	res := field.ErrorList{}
+	if rds.Name == "" {
+		res = append(res, field.Required(path.Child("name"), ""))
+	} else {
+		// The StatefulSet, the Service and the my.cnf were named after the name with the role
+		for _, msg := range validation.IsDNS1035Label(childName(rds.Name, role)) {
+			res = append(res, field.Invalid(path.Child("name"), rds.Name, msg))
+		}
+	}
+	if rds.Replicas == nil {
+		res = append(res, field.Required(path.Child("replicas"), ""))
+	} else if *rds.Replicas < 0 {
+		res = append(res, field.Invalid(path.Child("replicas"), *rds.Replicas, "must be greater than or equal to 0"))
+	}
+	if rds.Image == "" {
+		res = append(res, field.Required(path.Child("image"), ""))
+	}
+	return res
+}
+
+// validateChildNames rejects the names whose children were taken by the others,
+// they would be refused as ErrResourceExists otherwise.
+func validateChildNames(foo *mysqlOperatorV1.MysqlOperator, others []*mysqlOperatorV1.MysqlOperator) field.ErrorList {
+	taken := make(map[string]string)
+	for _, other := range others {
+		taken[masterRdsName(other)] = other.Name
+		taken[slaveRdsName(other)] = other.Name
+	}
+	res := field.ErrorList{}
+	for _, v := range []struct {
+		path      *field.Path
+		name, rds string
+	}{
+		{masterSpecPath.Child("name"), foo.Spec.MasterSpec.Spec.Name, masterRdsName(foo)},
+		{slaveSpecPath.Child("name"), foo.Spec.SlaveSpec.Spec.Name, slaveRdsName(foo)},
+	} {
+		if owner, ok := taken[v.rds]; ok && v.name != "" {
+			res = append(res, field.Duplicate(v.path, fmt.Sprintf("%s (taken by %s %s)", v.name, OperatorKindName, owner)))
+		}
+	}
+	return res
+}
+
+// childName returns the name of the children of the spec name with the role
+func childName(name, role string) string {
+	return fmt.Sprintf("%s-%s", name, role)
+}
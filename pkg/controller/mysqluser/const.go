@@ -0,0 +1,28 @@
+package mysqluser
+
+import "github.com/nevercase/k8s-controller-custom-resource/pkg/controller/mysqloperator"
+
+const OperatorKindName = "MysqlUser"
+
+const (
+	// SuccessSynced is used as part of the Event 'reason' when a MysqlUser is synced
+	SuccessSynced = "Synced"
+	// MessageResourceSynced is the message used for an Event fired when a MysqlUser
+	// is synced successfully
+	MessageResourceSynced = "Account %s was granted in the master of %s"
+)
+
+const (
+	// MysqlUserSecretTemplate is the default name of the Secret which holds the credentials
+	MysqlUserSecretTemplate = "%s-mysql-user"
+	MysqlUserDefaultHost    = "%"
+	MysqlUserPasswordLength = 24
+
+	// mysqlErrNonexistingGrant is the error number of SHOW GRANTS for an account which doesn't exist
+	mysqlErrNonexistingGrant = 1141
+
+	SecretKeyUsername = mysqloperator.UserSecretKeyUsername
+	SecretKeyPassword = mysqloperator.UserSecretKeyPassword
+	SecretKeyHost     = "host"
+	SecretKeyPort     = "port"
+)
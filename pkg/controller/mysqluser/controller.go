@@ -0,0 +1,176 @@
+package mysqluser
+
+import (
+	"context"
+	"time"
+
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	mysqlOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/controller/mysqloperator"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/env"
+	mysqlOperatorClientSet "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned"
+	mysqlOperatorScheme "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned/scheme"
+)
+
+// Reconciler creates the accounts of the MysqlUsers and their grants in the master of the referenced MysqlOperator
+type Reconciler struct {
+	clientSet mysqlOperatorClientSet.Interface
+}
+
+// NewReconciler returns the Reconciler which updates the MysqlUsers with clientSet
+func NewReconciler(clientSet mysqlOperatorClientSet.Interface) *Reconciler {
+	return &Reconciler{clientSet: clientSet}
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, foo *mysqlOperatorV1.MysqlUser) (k8sCoreV1.Result, error) {
+	return k8sCoreV1.Result{}, Sync(ctx, foo, r.clientSet, k8sCoreV1.ResourceFromContext(ctx), k8sCoreV1.RecorderFromContext(ctx))
+}
+
+// Finalize drops the account if the MysqlUser was deleted with DropOnDelete
+func (r *Reconciler) Finalize(ctx context.Context, foo *mysqlOperatorV1.MysqlUser) error {
+	return finalize(ctx, foo, r.clientSet, k8sCoreV1.ResourceFromContext(ctx), k8sCoreV1.RecorderFromContext(ctx))
+}
+
+// ReconcilePaused sets the phase to Paused, the phase was refreshed by the sync once it was resumed
+func (r *Reconciler) ReconcilePaused(ctx context.Context, foo *mysqlOperatorV1.MysqlUser, paused bool) error {
+	if !paused {
+		return nil
+	}
+	return updateStatus(ctx, foo, r.clientSet, mysqloperator.MysqlPhasePaused, "", foo.Status.SecretName)
+}
+
+func NewOption(controllerName string, cfg *rest.Config, stopCh <-chan struct{}) k8sCoreV1.Option {
+	c, err := mysqlOperatorClientSet.NewForConfig(cfg)
+	if err != nil {
+		klog.Fatalf("Error building clientSet: %s", err.Error())
+	}
+	return newOption(controllerName, c, stopCh)
+}
+
+func newOption(controllerName string, clientSet mysqlOperatorClientSet.Interface, stopCh <-chan struct{}) k8sCoreV1.Option {
+	return k8sCoreV1.NewBuilder[mysqlOperatorV1.MysqlUser, *mysqlOperatorV1.MysqlUserList](clientSet.NevercaseV1().MysqlUsers).
+		AgentName(controllerName).
+		Scheme(mysqlOperatorScheme.AddToScheme).
+		Reconciler(NewReconciler(clientSet)).
+		Build(stopCh)
+}
+
+func Sync(ctx context.Context, foo *mysqlOperatorV1.MysqlUser, clientSet mysqlOperatorClientSet.Interface, ks k8sCoreV1.KubernetesResource, recorder record.EventRecorder) error {
+	if foo.DeletionTimestamp != nil {
+		return finalize(ctx, foo, clientSet, ks, recorder)
+	}
+	// The finalizer was added or removed by the update, and the update event would bring the MysqlUser back
+	if updated, err := ensureFinalizer(ctx, foo, clientSet); err != nil || updated {
+		return err
+	}
+	secretName := passwordSecretName(foo)
+	operator, err := mysqloperator.GetOperator(ctx, clientSet, foo.Namespace, foo.Spec.Operator)
+	if err == nil {
+		var password string
+		if password, err = ensureSecret(ks, foo, operator); err == nil {
+			err = grant(ctx, ks, foo, operator, password)
+		}
+	}
+	if err != nil {
+		recorder.Eventf(foo, coreV1.EventTypeWarning, mysqloperator.ErrMysqlExec, mysqloperator.MessageErrMysqlExec, foo.Spec.Operator, err)
+		if updateErr := updateStatus(ctx, foo, clientSet, mysqloperator.MysqlPhaseFailed, err.Error(), secretName); updateErr != nil {
+			klog.V(2).Info(updateErr)
+		}
+		return err
+	}
+	if err = updateStatus(ctx, foo, clientSet, mysqloperator.MysqlPhaseReady, "", secretName); err != nil {
+		return err
+	}
+	recorder.Eventf(foo, coreV1.EventTypeNormal, SuccessSynced, MessageResourceSynced, userName(foo), foo.Spec.Operator)
+	return nil
+}
+
+// grant creates the account, and applies the difference between its grants in the master and the spec
+func grant(ctx context.Context, ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlUser, operator *mysqlOperatorV1.MysqlOperator, password string) error {
+	desired, err := desiredGrants(foo)
+	if err != nil {
+		return err
+	}
+	if err = mysqloperator.ExecMaster(ctx, ks, operator, accountStatements(foo, password)...); err != nil {
+		return err
+	}
+	current, err := currentGrants(ctx, ks, foo, operator)
+	if err != nil {
+		return err
+	}
+	if statements := grantStatements(foo, current, desired); len(statements) > 0 {
+		return mysqloperator.ExecMaster(ctx, ks, operator, statements...)
+	}
+	return nil
+}
+
+// finalize drops the account if the MysqlUser was deleted with DropOnDelete,
+// and then releases the MysqlUser by removing the finalizer
+func finalize(ctx context.Context, foo *mysqlOperatorV1.MysqlUser, clientSet mysqlOperatorClientSet.Interface, ks k8sCoreV1.KubernetesResource, recorder record.EventRecorder) error {
+	if !k8sCoreV1.HasFinalizer(foo, mysqloperator.MysqlDropFinalizer) {
+		return nil
+	}
+	if foo.Spec.DropOnDelete {
+		operator, err := mysqloperator.GetOperator(ctx, clientSet, foo.Namespace, foo.Spec.Operator)
+		if err == nil {
+			err = mysqloperator.ExecMaster(ctx, ks, operator, dropStatement(foo))
+		}
+		if err != nil {
+			recorder.Eventf(foo, coreV1.EventTypeWarning, mysqloperator.ErrMysqlExec, mysqloperator.MessageErrMysqlExec, foo.Spec.Operator, err)
+			return err
+		}
+		recorder.Eventf(foo, coreV1.EventTypeNormal, mysqloperator.MysqlDropped, mysqloperator.MessageMysqlDropped, userName(foo), foo.Spec.Operator)
+	}
+	fooCopy := foo.DeepCopy()
+	k8sCoreV1.RemoveFinalizer(fooCopy, mysqloperator.MysqlDropFinalizer)
+	return update(ctx, fooCopy, clientSet, false)
+}
+
+// ensureFinalizer keeps the finalizer in line with the DropOnDelete, it reports whether the MysqlUser was updated
+func ensureFinalizer(ctx context.Context, foo *mysqlOperatorV1.MysqlUser, clientSet mysqlOperatorClientSet.Interface) (bool, error) {
+	has := k8sCoreV1.HasFinalizer(foo, mysqloperator.MysqlDropFinalizer)
+	if has == foo.Spec.DropOnDelete {
+		return false, nil
+	}
+	fooCopy := foo.DeepCopy()
+	if foo.Spec.DropOnDelete {
+		k8sCoreV1.AddFinalizer(fooCopy, mysqloperator.MysqlDropFinalizer)
+	} else {
+		k8sCoreV1.RemoveFinalizer(fooCopy, mysqloperator.MysqlDropFinalizer)
+	}
+	return true, update(ctx, fooCopy, clientSet, false)
+}
+
+func updateStatus(ctx context.Context, foo *mysqlOperatorV1.MysqlUser, clientSet mysqlOperatorClientSet.Interface, phase, message, secretName string) error {
+	// The update would bring the MysqlUser back, so that it was skipped if nothing changed
+	if foo.Status.Phase == phase && foo.Status.Message == message && foo.Status.SecretName == secretName {
+		return nil
+	}
+	fooCopy := foo.DeepCopy()
+	fooCopy.Status.Phase = phase
+	fooCopy.Status.Message = message
+	fooCopy.Status.SecretName = secretName
+	return update(ctx, fooCopy, clientSet, true)
+}
+
+// update writes foo, or only its status through the status subresource if status was true
+func update(ctx context.Context, foo *mysqlOperatorV1.MysqlUser, clientSet mysqlOperatorClientSet.Interface, status bool) error {
+	if k8sCoreV1.SuppressedByDryRun(OperatorKindName, foo) {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	var err error
+	if status {
+		_, err = clientSet.NevercaseV1().MysqlUsers(foo.Namespace).UpdateStatus(ctx, foo, metaV1.UpdateOptions{})
+	} else {
+		_, err = clientSet.NevercaseV1().MysqlUsers(foo.Namespace).Update(ctx, foo, metaV1.UpdateOptions{})
+	}
+	return err
+}
@@ -0,0 +1,241 @@
+package mysqluser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	coreV1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	mysqlOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/controller/mysqloperator"
+)
+
+// privilegeRegexp matches a privilege such as: SELECT, ALL PRIVILEGES, GRANT OPTION
+var privilegeRegexp = regexp.MustCompile(`^[A-Za-z]+( [A-Za-z]+)*$`)
+
+func userName(foo *mysqlOperatorV1.MysqlUser) string {
+	if foo.Spec.User != "" {
+		return foo.Spec.User
+	}
+	return foo.Name
+}
+
+func userHost(foo *mysqlOperatorV1.MysqlUser) string {
+	if foo.Spec.Host != "" {
+		return foo.Spec.Host
+	}
+	return MysqlUserDefaultHost
+}
+
+func passwordSecretName(foo *mysqlOperatorV1.MysqlUser) string {
+	if foo.Spec.PasswordSecret != "" {
+		return foo.Spec.PasswordSecret
+	}
+	return fmt.Sprintf(MysqlUserSecretTemplate, foo.Name)
+}
+
+// NewSecret returns the Secret which holds the generated credentials of the account
+func NewSecret(foo *mysqlOperatorV1.MysqlUser, operator *mysqlOperatorV1.MysqlOperator, password string) *coreV1.Secret {
+	host, port := mysqloperator.MasterAddress(operator)
+	return &coreV1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      passwordSecretName(foo),
+			Namespace: foo.Namespace,
+			OwnerReferences: []metaV1.OwnerReference{
+				*metaV1.NewControllerRef(foo, mysqlOperatorV1.SchemeGroupVersion.WithKind(OperatorKindName)),
+			},
+			Labels: map[string]string{
+				k8sCoreV1.LabelApp:        OperatorKindName,
+				k8sCoreV1.LabelController: foo.Name,
+			},
+		},
+		Type: coreV1.SecretTypeOpaque,
+		StringData: map[string]string{
+			SecretKeyUsername: userName(foo),
+			SecretKeyPassword: password,
+			SecretKeyHost:     host,
+			SecretKeyPort:     port,
+		},
+	}
+}
+
+// ensureSecret returns the password of the account.
+// The password would be generated and stored in a new Secret if the Secret doesn't exist,
+// otherwise the password in the existing Secret was used.
+func ensureSecret(ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlUser, operator *mysqlOperatorV1.MysqlOperator) (string, error) {
+	name := passwordSecretName(foo)
+	secret, err := ks.Secret().Get(foo.Namespace, name)
+	if err != nil {
+		if !apiErrors.IsNotFound(err) {
+			return "", err
+		}
+		password, err := mysqloperator.GeneratePassword(MysqlUserPasswordLength)
+		if err != nil {
+			return "", err
+		}
+		klog.Info("new mysql user secret:", name)
+		if _, err = ks.Secret().Create(foo.Namespace, NewSecret(foo, operator, password)); err != nil {
+			return "", err
+		}
+		return password, nil
+	}
+	password, ok := secret.Data[SecretKeyPassword]
+	if !ok || len(password) == 0 {
+		return "", fmt.Errorf("secret %s has no %q", name, SecretKeyPassword)
+	}
+	return string(password), nil
+}
+
+// accountStatements return the idempotent statements which create the account and set its password
+func accountStatements(foo *mysqlOperatorV1.MysqlUser, password string) []mysqloperator.Statement {
+	user, host := userName(foo), userHost(foo)
+	return []mysqloperator.Statement{
+		{Query: "CREATE USER IF NOT EXISTS ?@? IDENTIFIED BY ?", Args: []interface{}{user, host, password}},
+		{Query: "ALTER USER ?@? IDENTIFIED BY ?", Args: []interface{}{user, host, password}},
+	}
+}
+
+// grants are the privileges of an account by the target, such as `db`.* or *.*
+type grants map[string]sets.String
+
+func (g grants) add(target string, privileges ...string) {
+	if _, ok := g[target]; !ok {
+		g[target] = sets.NewString()
+	}
+	for _, p := range privileges {
+		p = strings.ToUpper(strings.TrimSpace(p))
+		switch p {
+		case "", "USAGE":
+			// USAGE is no privilege
+			continue
+		case "ALL":
+			p = "ALL PRIVILEGES"
+		}
+		g[target].Insert(p)
+	}
+}
+
+// desiredGrants returns the grants of the spec
+func desiredGrants(foo *mysqlOperatorV1.MysqlUser) (grants, error) {
+	res := make(grants)
+	for _, g := range foo.Spec.Grants {
+		if len(g.Privileges) == 0 {
+			continue
+		}
+		for _, p := range g.Privileges {
+			if !privilegeRegexp.MatchString(p) {
+				return nil, fmt.Errorf("invalid privilege %q", p)
+			}
+		}
+		if g.Database == "" {
+			return nil, fmt.Errorf("the database of the privileges %v must be specified", g.Privileges)
+		}
+		table := g.Table
+		if table == "" {
+			table = "*"
+		}
+		res.add(fmt.Sprintf("%s.%s", mysqloperator.QuoteIdentifier(g.Database), mysqloperator.QuoteIdentifier(table)), g.Privileges...)
+	}
+	return res, nil
+}
+
+// parseGrants parses the rows of SHOW GRANTS, such as: GRANT SELECT, INSERT ON `db`.* TO 'user'@'%' WITH GRANT OPTION.
+// The grants of the roles, which have no target, were skipped.
+func parseGrants(rows []string) grants {
+	res := make(grants)
+	for _, row := range rows {
+		if !strings.HasPrefix(row, "GRANT ") {
+			continue
+		}
+		i := strings.Index(row, " ON ")
+		j := strings.LastIndex(row, " TO ")
+		if i < 0 || j < i {
+			continue
+		}
+		target := row[i+len(" ON ") : j]
+		res.add(target, splitPrivileges(row[len("GRANT "):i])...)
+		if strings.HasSuffix(row, " WITH GRANT OPTION") {
+			res.add(target, "GRANT OPTION")
+		}
+		if len(res[target]) == 0 {
+			delete(res, target)
+		}
+	}
+	return res
+}
+
+// splitPrivileges splits the privileges by the commas, except those in the column lists such as SELECT (a, b)
+func splitPrivileges(s string) []string {
+	res := make([]string, 0)
+	depth, start := 0, 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				res = append(res, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(res, s[start:])
+}
+
+// grantStatements returns the statements which revoke the current privileges that were removed from the spec
+// and grant the ones that were missing, nothing was executed once the account matched the spec
+func grantStatements(foo *mysqlOperatorV1.MysqlUser, current, desired grants) []mysqloperator.Statement {
+	user, host := userName(foo), userHost(foo)
+	res := make([]mysqloperator.Statement, 0)
+	for _, target := range sets.StringKeySet(current).List() {
+		if revoked := current[target].Difference(desired[target]); revoked.Len() > 0 {
+			res = append(res, mysqloperator.Statement{
+				Query: fmt.Sprintf("REVOKE %s ON %s FROM ?@?", strings.Join(revoked.List(), ", "), target),
+				Args:  []interface{}{user, host},
+			})
+		}
+	}
+	for _, target := range sets.StringKeySet(desired).List() {
+		if granted := desired[target].Difference(current[target]); granted.Len() > 0 {
+			res = append(res, mysqloperator.Statement{
+				Query: fmt.Sprintf("GRANT %s ON %s TO ?@?", strings.Join(granted.List(), ", "), target),
+				Args:  []interface{}{user, host},
+			})
+		}
+	}
+	return res
+}
+
+// currentGrants returns the grants of the account in the master, an account which doesn't exist has none
+func currentGrants(ctx context.Context, ks k8sCoreV1.KubernetesResource, foo *mysqlOperatorV1.MysqlUser, operator *mysqlOperatorV1.MysqlOperator) (grants, error) {
+	rows, err := mysqloperator.QueryMaster(ctx, ks, operator, mysqloperator.Statement{
+		Query: "SHOW GRANTS FOR ?@?",
+		Args:  []interface{}{userName(foo), userHost(foo)},
+	})
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrNonexistingGrant {
+		return grants{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseGrants(rows), nil
+}
+
+func dropStatement(foo *mysqlOperatorV1.MysqlUser) mysqloperator.Statement {
+	return mysqloperator.Statement{
+		Query: "DROP USER IF EXISTS ?@?",
+		Args:  []interface{}{userName(foo), userHost(foo)},
+	}
+}
@@ -0,0 +1,91 @@
+package mysqluser
+
+import (
+	"reflect"
+	"testing"
+
+	mysqlOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+)
+
+func newTestMysqlUser(grants ...mysqlOperatorV1.MysqlGrant) *mysqlOperatorV1.MysqlUser {
+	foo := &mysqlOperatorV1.MysqlUser{}
+	foo.Name = "app"
+	foo.Spec.Grants = grants
+	return foo
+}
+
+func TestParseGrants(t *testing.T) {
+	got := parseGrants([]string{
+		"GRANT USAGE ON *.* TO 'app'@'%'",
+		"GRANT SELECT, INSERT, UPDATE (`a`, `b`) ON `shop`.* TO 'app'@'%' WITH GRANT OPTION",
+		"GRANT ALL PRIVILEGES ON `shop`.`orders` TO 'app'@'%'",
+		"GRANT `reader`@`%` TO 'app'@'%'",
+	})
+	want := make(grants)
+	want.add("`shop`.*", "SELECT", "INSERT", "UPDATE (`a`, `b`)", "GRANT OPTION")
+	want.add("`shop`.`orders`", "ALL PRIVILEGES")
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseGrants() = %v, want %v", got, want)
+	}
+}
+
+func TestGrantStatements(t *testing.T) {
+	foo := newTestMysqlUser(
+		mysqlOperatorV1.MysqlGrant{Database: "shop", Privileges: []string{"select", "insert"}},
+		mysqlOperatorV1.MysqlGrant{Database: "shop", Table: "orders", Privileges: []string{"ALL"}},
+	)
+	desired, err := desiredGrants(foo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name        string
+		rows        []string
+		wantQueries []string
+	}{
+		{
+			name:        "grants a new account",
+			wantQueries: []string{"GRANT INSERT, SELECT ON `shop`.* TO ?@?", "GRANT ALL PRIVILEGES ON `shop`.`orders` TO ?@?"},
+		},
+		{
+			name: "leaves the matching grants",
+			rows: []string{
+				"GRANT USAGE ON *.* TO 'app'@'%'",
+				"GRANT SELECT, INSERT ON `shop`.* TO 'app'@'%'",
+				"GRANT ALL PRIVILEGES ON `shop`.`orders` TO 'app'@'%'",
+			},
+			wantQueries: []string{},
+		},
+		{
+			name: "revokes only the removed privileges",
+			rows: []string{
+				"GRANT SELECT, INSERT, DELETE ON `shop`.* TO 'app'@'%' WITH GRANT OPTION",
+				"GRANT SELECT ON `logs`.* TO 'app'@'%'",
+				"GRANT ALL PRIVILEGES ON `shop`.`orders` TO 'app'@'%'",
+			},
+			wantQueries: []string{"REVOKE SELECT ON `logs`.* FROM ?@?", "REVOKE DELETE, GRANT OPTION ON `shop`.* FROM ?@?"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queries := make([]string, 0)
+			for _, s := range grantStatements(foo, parseGrants(tt.rows), desired) {
+				queries = append(queries, s.Query)
+			}
+			if !reflect.DeepEqual(queries, tt.wantQueries) {
+				t.Errorf("grantStatements() = %q, want %q", queries, tt.wantQueries)
+			}
+		})
+	}
+}
+
+func TestDesiredGrantsInvalid(t *testing.T) {
+	for _, g := range []mysqlOperatorV1.MysqlGrant{
+		{Database: "shop", Privileges: []string{"SELECT; DROP"}},
+		{Privileges: []string{"SELECT"}},
+	} {
+		if _, err := desiredGrants(newTestMysqlUser(g)); err == nil {
+			t.Errorf("desiredGrants(%+v) succeeded, want an error", g)
+		}
+	}
+}
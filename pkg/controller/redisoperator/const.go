@@ -24,6 +24,13 @@ const (
 	RedisDefaultPort = 6379
 )
 
+// The phases of the status subresource of the RedisOperator
+const (
+	RedisPhasePending = "Pending"
+	RedisPhaseReady   = "Ready"
+	RedisPhasePaused  = "Paused"
+)
+
 const (
 	EnvRedisMaster     = "ENV_REDIS_MASTER"
 	EnvRedisMasterPort = "ENV_REDIS_MASTER_PORT"
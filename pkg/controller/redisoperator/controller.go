@@ -27,7 +27,6 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
@@ -36,36 +35,68 @@ import (
 	redisOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/redisoperator/v1"
 	redisOperatorClientSet "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/redisoperator/clientset/versioned"
 	redisOperatorScheme "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/redisoperator/clientset/versioned/scheme"
-	informersext "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/redisoperator/informers/externalversions"
-	informers "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/redisoperator/informers/externalversions/redisoperator/v1"
 )
 
+// ownerKind is the kind of the controller ownerRef of the children
+var ownerKind = redisOperatorV1.SchemeGroupVersion.WithKind(OperatorKindName)
+
+// Reconciler reconciles the RedisOperators with the StatefulSets and the Services of the master and the slaves
+type Reconciler struct {
+	clientSet redisOperatorClientSet.Interface
+}
+
+// NewReconciler returns the Reconciler which updates the status of the RedisOperators with clientSet
+func NewReconciler(clientSet redisOperatorClientSet.Interface) *Reconciler {
+	return &Reconciler{clientSet: clientSet}
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, foo *redisOperatorV1.RedisOperator) (k8sCoreV1.Result, error) {
+	return Sync(ctx, foo, r.clientSet, k8sCoreV1.ResourceFromContext(ctx), k8sCoreV1.RecorderFromContext(ctx))
+}
+
+// Finalize does nothing, the children were collected by their owner references
+func (r *Reconciler) Finalize(ctx context.Context, foo *redisOperatorV1.RedisOperator) error {
+	return nil
+}
+
+// ReconcilePaused reflects the annotation nevercase.io/paused in masterSpec.status.paused
+func (r *Reconciler) ReconcilePaused(ctx context.Context, foo *redisOperatorV1.RedisOperator, paused bool) error {
+	if foo.Spec.MasterSpec.Status.Paused == paused || k8sCoreV1.SuppressedByDryRun(OperatorKindName, foo) {
+		return nil
+	}
+	fooCopy := foo.DeepCopy()
+	fooCopy.Spec.MasterSpec.Status.Paused = paused
+	updateCtx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	if _, err := r.clientSet.NevercaseV1().RedisOperators(foo.Namespace).Update(updateCtx, fooCopy, metaV1.UpdateOptions{}); err != nil {
+		return err
+	}
+	return syncOverallStatus(ctx, r.clientSet, foo.Namespace, foo.Name)
+}
+
+func (r *Reconciler) ReconcileStatus(ctx context.Context, foo *redisOperatorV1.RedisOperator, child metaV1.Object) error {
+	ss, ok := child.(*appsV1.StatefulSet)
+	if !ok {
+		return nil
+	}
+	// The status was synced on the events of the pods as well, the restarted pods came back without the access label
+	if err := labelPods(k8sCoreV1.ResourceFromContext(ctx), foo); err != nil {
+		return err
+	}
+	return SyncStatus(ctx, ss, r.clientSet, k8sCoreV1.RecorderFromContext(ctx))
+}
+
 func NewController(
 	controllerName string,
 	k8sClientSet kubernetes.Interface,
 	clientSet redisOperatorClientSet.Interface,
 	stopCh <-chan struct{}) k8sCoreV1.KubernetesControllerV1 {
-	informerFactory := informersext.NewSharedInformerFactory(clientSet, time.Second*30)
-	fooInformer := informerFactory.Nevercase().V1().RedisOperators()
-	opt := k8sCoreV1.NewOption(&redisOperatorV1.RedisOperator{},
-		controllerName,
-		OperatorKindName,
-		redisOperatorScheme.AddToScheme(scheme.Scheme),
-		clientSet,
-		fooInformer,
-		fooInformer.Informer(),
-		CompareResourceVersion,
-		Get,
-		Sync,
-		SyncStatus)
 	opts := k8sCoreV1.NewOptions()
-	if err := opts.Add(opt); err != nil {
+	if err := opts.Add(newOption(controllerName, clientSet, stopCh)); err != nil {
 		klog.Fatal(err)
 	}
 	op := k8sCoreV1.NewKubernetesOperator(k8sClientSet, stopCh, controllerName, opts)
-	kc := k8sCoreV1.NewKubernetesController(op)
-	informerFactory.Start(stopCh)
-	return kc
+	return k8sCoreV1.NewKubernetesController(op)
 }
 
 func NewOption(controllerName string, cfg *rest.Config, stopCh <-chan struct{}) k8sCoreV1.Option {
@@ -73,66 +104,111 @@ func NewOption(controllerName string, cfg *rest.Config, stopCh <-chan struct{})
 	if err != nil {
 		klog.Fatalf("Error building clientSet: %s", err.Error())
 	}
-	informerFactory := informersext.NewSharedInformerFactory(c, time.Second*30)
-	fooInformer := informerFactory.Nevercase().V1().RedisOperators()
-	opt := k8sCoreV1.NewOption(&redisOperatorV1.RedisOperator{},
-		controllerName,
-		OperatorKindName,
-		redisOperatorScheme.AddToScheme(scheme.Scheme),
-		c,
-		fooInformer,
-		fooInformer.Informer(),
-		CompareResourceVersion,
-		Get,
-		Sync,
-		SyncStatus)
-	informerFactory.Start(stopCh)
-	return opt
-}
-
-func CompareResourceVersion(old, new interface{}) bool {
-	newResource := new.(*redisOperatorV1.RedisOperator)
-	oldResource := old.(*redisOperatorV1.RedisOperator)
-	if newResource.ResourceVersion == oldResource.ResourceVersion {
-		// Periodic resync will send update events for all known Deployments.
-		// Two different versions of the same Deployment will always have different RVs.
-		return true
-	}
-	return false
+	return newOption(controllerName, c, stopCh)
 }
 
-func Get(foo interface{}, nameSpace, ownerRefName string) (obj interface{}, err error) {
-	kc := foo.(informers.RedisOperatorInformer)
-	return kc.Lister().RedisOperators(nameSpace).Get(ownerRefName)
+func newOption(controllerName string, clientSet redisOperatorClientSet.Interface, stopCh <-chan struct{}) k8sCoreV1.Option {
+	return k8sCoreV1.NewBuilder[redisOperatorV1.RedisOperator, *redisOperatorV1.RedisOperatorList](clientSet.NevercaseV1().RedisOperators).
+		AgentName(controllerName).
+		Scheme(redisOperatorScheme.AddToScheme).
+		Reconciler(NewReconciler(clientSet)).
+		Build(stopCh)
 }
 
-func Sync(obj interface{}, clientObj interface{}, ks k8sCoreV1.KubernetesResource, recorder record.EventRecorder) error {
-	foo := obj.(*redisOperatorV1.RedisOperator)
-	clientSet := clientObj.(redisOperatorClientSet.Interface)
+func Sync(ctx context.Context, foo *redisOperatorV1.RedisOperator, clientSet redisOperatorClientSet.Interface, ks k8sCoreV1.KubernetesResource, recorder record.EventRecorder) (k8sCoreV1.Result, error) {
+	// The invalid spec would fail again on each retry, the RedisOperator was synced again once it was fixed
+	foo = withDefaults(foo)
+	if errs := validateSpec(foo); len(errs) > 0 {
+		recorder.Event(foo, coreV1.EventTypeWarning, k8sCoreV1.ErrInvalidSpec, errs.ToAggregate().Error())
+		return k8sCoreV1.Result{}, nil
+	}
 	//defer recorder.Event(foo, coreV1.EventTypeNormal, SuccessSynced, MessageResourceSynced)
 	// Create the Deployment of master with MasterSpec
-	err := createStatefulSetAndService(ks, foo, clientSet, true)
+	err := createStatefulSetAndService(ks, foo, clientSet, recorder, true)
 	if err != nil {
-		return err
+		return k8sCoreV1.Result{}, err
 	}
 	// Create the Deployment of slave with SlaveSpec
-	err = createStatefulSetAndService(ks, foo, clientSet, false)
+	err = createStatefulSetAndService(ks, foo, clientSet, recorder, false)
 	if err != nil {
-		return err
+		return k8sCoreV1.Result{}, err
+	}
+	// Create the Services which were named after the RedisOperator
+	if err = accessServices(ks, foo, recorder); err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	// Delete the children of the former names of the master and the slaves
+	if err = collectOrphans(ks, foo, recorder); err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	// Publish the endpoints and the credentials for the applications
+	if err = k8sCoreV1.ApplySecret(ks.Secret(), NewConnectionSecret(foo)); err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	// The pods which couldn't pull their images keep the RedisOperator being requeued
+	selector := map[string]string{
+		k8sCoreV1.LabelApp:        OperatorKindName,
+		k8sCoreV1.LabelController: foo.Name,
+	}
+	if err = k8sCoreV1.CheckImagePull(ks, foo.Namespace, selector); err != nil {
+		return k8sCoreV1.Result{}, err
 	}
 	recorder.Event(foo, coreV1.EventTypeNormal, SuccessSynced, MessageResourceSynced)
-	return nil
+	if err = syncOverallStatus(ctx, clientSet, foo.Namespace, foo.Name); err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	// The status follows the rollout without waiting for the events of the StatefulSets
+	return rolloutResult(ks, foo)
 }
 
-func createStatefulSetAndService(ks k8sCoreV1.KubernetesResource, foo *redisOperatorV1.RedisOperator, clientSet redisOperatorClientSet.Interface, isMaster bool) (err error) {
+// rolloutResult requeues the RedisOperator while any of its StatefulSets was being rolled out
+func rolloutResult(ks k8sCoreV1.KubernetesResource, foo *redisOperatorV1.RedisOperator) (k8sCoreV1.Result, error) {
+	names := []string{
+		fmt.Sprintf("%s-%s", foo.Spec.MasterSpec.Spec.Name, k8sCoreV1.MasterName),
+		fmt.Sprintf("%s-%s", foo.Spec.SlaveSpec.Spec.Name, k8sCoreV1.SlaveName),
+	}
+	for _, name := range names {
+		ss, err := ks.StatefulSet().Get(foo.Namespace, name)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return k8sCoreV1.Result{}, err
+		}
+		if !k8sCoreV1.StatefulSetRolledOut(ss) {
+			return k8sCoreV1.RequeueAfter(k8sCoreV1.RolloutRequeueAfter), nil
+		}
+	}
+	return k8sCoreV1.Result{}, nil
+}
+
+// collectOrphans deletes the StatefulSets and the Services which the RedisOperator no longer desires
+func collectOrphans(ks k8sCoreV1.KubernetesResource, foo *redisOperatorV1.RedisOperator, recorder record.EventRecorder) error {
+	master := fmt.Sprintf("%s-%s", foo.Spec.MasterSpec.Spec.Name, k8sCoreV1.MasterName)
+	slave := fmt.Sprintf("%s-%s", foo.Spec.SlaveSpec.Spec.Name, k8sCoreV1.SlaveName)
+	desired := k8sCoreV1.Children{
+		StatefulSets: []string{master, slave},
+		Services:     []string{k8sCoreV1.GetServiceName(master), k8sCoreV1.GetServiceName(slave)},
+	}
+	for _, svc := range NewAccessServices(foo) {
+		desired.Services = append(desired.Services, svc.Name)
+	}
+	selector := map[string]string{
+		k8sCoreV1.LabelApp:        OperatorKindName,
+		k8sCoreV1.LabelController: foo.Name,
+	}
+	return k8sCoreV1.CollectOrphans(ks, foo, selector, desired, recorder)
+}
+
+func createStatefulSetAndService(ks k8sCoreV1.KubernetesResource, foo *redisOperatorV1.RedisOperator, clientSet redisOperatorClientSet.Interface, recorder record.EventRecorder, isMaster bool) (err error) {
 	if isMaster == true {
 		rds := foo.Spec.MasterSpec.Spec
 		rds.Name = fmt.Sprintf("%s-%s", rds.Name, k8sCoreV1.MasterName)
 		rds.Role = k8sCoreV1.MasterName
-		if err = statefulSet(ks, foo, &rds, clientSet, isMaster); err != nil {
+		if err = statefulSet(ks, foo, &rds, clientSet, recorder, isMaster); err != nil {
 			return err
 		}
-		if err = service(ks, foo, &rds, clientSet, isMaster); err != nil {
+		if err = service(ks, foo, &rds, clientSet, recorder, isMaster); err != nil {
 			return err
 		}
 		return nil
@@ -141,10 +217,10 @@ func createStatefulSetAndService(ks k8sCoreV1.KubernetesResource, foo *redisOper
 	rds := foo.Spec.SlaveSpec.Spec
 	rds.Name = fmt.Sprintf("%s-%s", rds.Name, k8sCoreV1.SlaveName)
 	rds.Role = k8sCoreV1.SlaveName
-	if err = statefulSet(ks, foo, &rds, clientSet, isMaster); err != nil {
+	if err = statefulSet(ks, foo, &rds, clientSet, recorder, isMaster); err != nil {
 		return err
 	}
-	if err = service(ks, foo, &rds, clientSet, isMaster); err != nil {
+	if err = service(ks, foo, &rds, clientSet, recorder, isMaster); err != nil {
 		return err
 	}
 	return nil
@@ -154,6 +230,7 @@ func statefulSet(ks k8sCoreV1.KubernetesResource,
 	foo *redisOperatorV1.RedisOperator,
 	rds *redisOperatorV1.RedisSpec,
 	clientSet redisOperatorClientSet.Interface,
+	recorder record.EventRecorder,
 	isMaster bool) error {
 	ss, err := ks.StatefulSet().Get(foo.Namespace, rds.Name)
 	if err != nil {
@@ -165,23 +242,31 @@ func statefulSet(ks k8sCoreV1.KubernetesResource,
 		if ss, err = ks.StatefulSet().Create(foo.Namespace, NewStatefulSet(foo, rds)); err != nil {
 			return err
 		}
+	} else if ss, err = k8sCoreV1.ClaimStatefulSet(ks.StatefulSet(), foo, ownerKind, ss, recorder); err != nil {
+		return err
 	}
 	klog.Info("rds:", *rds.Replicas)
 	klog.Info("statefulSet:", *ss.Spec.Replicas)
 	if rds.Replicas != nil && *rds.Replicas != *ss.Spec.Replicas || rds.Image != ss.Spec.Template.Spec.Containers[0].Image {
-		if ss, err = ks.StatefulSet().Update(foo.Namespace, NewStatefulSet(foo, rds)); err != nil {
+		newSS := NewStatefulSet(foo, rds)
+		// The serviceName of an existing StatefulSet couldn't be changed
+		newSS.Spec.ServiceName = ss.Spec.ServiceName
+		if ss, err = ks.StatefulSet().Update(foo.Namespace, newSS); err != nil {
 			klog.V(2).Info(err)
 			return err
 		}
 	}
-	if err = updateFooStatus(foo, clientSet, ss, isMaster); err != nil {
+	if err = updateFooStatus(ks.Context(), foo, clientSet, ss, isMaster); err != nil {
 		klog.Info(err)
 		return err
 	}
 	return nil
 }
 
-func updateFooStatus(foo *redisOperatorV1.RedisOperator, clientSet redisOperatorClientSet.Interface, statefulSet *appsV1.StatefulSet, isMaster bool) error {
+func updateFooStatus(ctx context.Context, foo *redisOperatorV1.RedisOperator, clientSet redisOperatorClientSet.Interface, statefulSet *appsV1.StatefulSet, isMaster bool) error {
+	if k8sCoreV1.SuppressedByDryRun(OperatorKindName, foo) {
+		return nil
+	}
 	// NEVER modify objects from the store. It's a read-only, local cache.
 	// You can use DeepCopy() to make a deep copy of original object and modify this copy
 	// Or create a copy manually for better performance
@@ -196,6 +281,7 @@ func updateFooStatus(foo *redisOperatorV1.RedisOperator, clientSet redisOperator
 		fooCopy.Spec.MasterSpec.Status.CurrentRevision = statefulSet.Status.CurrentRevision
 		fooCopy.Spec.MasterSpec.Status.UpdateRevision = statefulSet.Status.UpdateRevision
 		fooCopy.Spec.MasterSpec.Status.CollisionCount = statefulSet.Status.CollisionCount
+		fooCopy.Spec.MasterSpec.Status.ConnectionSecret = k8sCoreV1.GetConnectionSecretName(foo.Name)
 	} else {
 		fooCopy.Spec.SlaveSpec.Status.ObservedGeneration = statefulSet.Status.ObservedGeneration
 		fooCopy.Spec.SlaveSpec.Status.Replicas = statefulSet.Status.Replicas
@@ -211,7 +297,7 @@ func updateFooStatus(foo *redisOperatorV1.RedisOperator, clientSet redisOperator
 	// UpdateStatus will not allow changes to the Spec of the resource,
 	// which is ideal for ensuring nothing other than resource status has been updated.
 	opt := metaV1.UpdateOptions{}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(env.DefaultExecutionDuration))
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
 	_, err := clientSet.NevercaseV1().RedisOperators(foo.Namespace).Update(ctx, fooCopy, opt)
 	cancel()
 	return err
@@ -221,8 +307,9 @@ func service(ks k8sCoreV1.KubernetesResource,
 	foo *redisOperatorV1.RedisOperator,
 	rds *redisOperatorV1.RedisSpec,
 	clientSet redisOperatorClientSet.Interface,
+	recorder record.EventRecorder,
 	isMaster bool) error {
-	_, err := ks.Service().Get(foo.Namespace, rds.Name)
+	svc, err := ks.Service().Get(foo.Namespace, rds.Name)
 	if err != nil {
 		klog.Info("service err:", err)
 		if !errors.IsNotFound(err) {
@@ -236,6 +323,10 @@ func service(ks k8sCoreV1.KubernetesResource,
 			return err
 		}
 	} else {
+		// The Service which isn't ours would neither be updated nor deleted
+		if _, err = k8sCoreV1.ClaimService(ks.Service(), foo, ownerKind, svc, recorder); err != nil {
+			return err
+		}
 		klog.Info("update service no action!")
 		//if _, err = ks.Service().Update(foo.Namespace, newService(foo, rds)); err != nil {
 		//	klog.Info(err)
@@ -251,9 +342,39 @@ func service(ks k8sCoreV1.KubernetesResource,
 	return nil
 }
 
-func SyncStatus(obj interface{}, clientObj interface{}, ks k8sCoreV1.KubernetesResource, recorder record.EventRecorder) error {
-	clientSet := clientObj.(redisOperatorClientSet.Interface)
-	ss := obj.(*appsV1.StatefulSet)
+// accessServices applies the `-rw`, `-ro` and headless Services and labels the pods with their access
+func accessServices(ks k8sCoreV1.KubernetesResource, foo *redisOperatorV1.RedisOperator, recorder record.EventRecorder) error {
+	for _, svc := range NewAccessServices(foo) {
+		current, err := ks.Service().Get(svc.Namespace, svc.Name)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if err == nil {
+			adopted, err := k8sCoreV1.ClaimService(ks.Service(), foo, ownerKind, current, recorder)
+			if err != nil {
+				return err
+			}
+			if adopted {
+				continue
+			}
+		}
+		if err = k8sCoreV1.ApplyService(ks.Service(), svc); err != nil {
+			return err
+		}
+	}
+	return labelPods(ks, foo)
+}
+
+// labelPods labels the pods with their access
+func labelPods(ks k8sCoreV1.KubernetesResource, foo *redisOperatorV1.RedisOperator) error {
+	selector := map[string]string{
+		k8sCoreV1.LabelApp:        OperatorKindName,
+		k8sCoreV1.LabelController: foo.Name,
+	}
+	return k8sCoreV1.EnsurePodLabel(ks, foo.Namespace, selector, k8sCoreV1.LabelAccess, podAccess)
+}
+
+func SyncStatus(ctx context.Context, ss *appsV1.StatefulSet, clientSet redisOperatorClientSet.Interface, recorder record.EventRecorder) error {
 	var isMaster bool
 	if t, ok := ss.Labels[k8sCoreV1.LabelRole]; ok {
 		if t == k8sCoreV1.MasterName {
@@ -270,13 +391,16 @@ func SyncStatus(obj interface{}, clientObj interface{}, ks k8sCoreV1.KubernetesR
 	} else {
 		return fmt.Errorf(ErrResourceNotMatch, "no controller")
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(env.DefaultExecutionDuration))
-	redis, err := clientSet.NevercaseV1().RedisOperators(ss.Namespace).Get(ctx, specName, metaV1.GetOptions{})
+	getCtx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	redis, err := clientSet.NevercaseV1().RedisOperators(ss.Namespace).Get(getCtx, specName, metaV1.GetOptions{})
 	cancel()
 	if err != nil {
 		return err
 	}
-	if err := updateFooStatus(redis, clientSet, ss, isMaster); err != nil {
+	if err := updateFooStatus(ctx, redis, clientSet, ss, isMaster); err != nil {
+		return err
+	}
+	if err := syncOverallStatus(ctx, clientSet, redis.Namespace, redis.Name); err != nil {
 		return err
 	}
 	recorder.Event(redis, coreV1.EventTypeNormal, SuccessSynced, MessageResourceSynced)
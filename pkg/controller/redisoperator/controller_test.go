@@ -0,0 +1,286 @@
+package redisoperator
+
+import (
+	"reflect"
+	"testing"
+
+	appsV1 "k8s.io/api/apps/v1"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	k8sTesting "github.com/nevercase/k8s-controller-custom-resource/core/v1/testing"
+	redisOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/redisoperator/v1"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/generated/redisoperator/clientset/versioned/fake"
+)
+
+func newTestRedisOperator(masterReplicas, slaveReplicas int32) *redisOperatorV1.RedisOperator {
+	spec := func(replicas int32) redisOperatorV1.RedisCore {
+		return redisOperatorV1.RedisCore{
+			Spec: redisOperatorV1.RedisSpec{
+				Name:         "cn1",
+				Replicas:     k8sTesting.Int32Ptr(replicas),
+				Image:        "redis:6.0",
+				ServicePorts: []coreV1.ServicePort{{Port: RedisDefaultPort}},
+				VolumePath:   "/mnt/nas",
+			},
+		}
+	}
+	return &redisOperatorV1.RedisOperator{
+		TypeMeta:   metaV1.TypeMeta{APIVersion: redisOperatorV1.SchemeGroupVersion.String(), Kind: OperatorKindName},
+		ObjectMeta: k8sTesting.ObjectMeta("example-redis"),
+		Spec: redisOperatorV1.RedisOperatorSpec{
+			MasterSpec: spec(masterReplicas),
+			SlaveSpec:  spec(slaveReplicas),
+		},
+	}
+}
+
+// childOf returns the child of foo which was built by NewStatefulSet from the defaulted master or slave spec
+func childOf(foo *redisOperatorV1.RedisOperator, isMaster bool) *appsV1.StatefulSet {
+	foo = withDefaults(foo)
+	rds := foo.Spec.SlaveSpec.Spec
+	rds.Name, rds.Role = rds.Name+"-"+k8sCoreV1.SlaveName, k8sCoreV1.SlaveName
+	if isMaster {
+		rds = foo.Spec.MasterSpec.Spec
+		rds.Name, rds.Role = rds.Name+"-"+k8sCoreV1.MasterName, k8sCoreV1.MasterName
+	}
+	return NewStatefulSet(foo, &rds)
+}
+
+func TestSync(t *testing.T) {
+	unowned := childOf(newTestRedisOperator(1, 2), true)
+	unowned.OwnerReferences = nil
+
+	adopting := newTestRedisOperator(1, 2)
+	adopting.Annotations = map[string]string{k8sCoreV1.AdoptAnnotation: "true"}
+
+	scaled := newTestRedisOperator(1, 3)
+	scaledChild := childOf(newTestRedisOperator(1, 1), false)
+
+	tests := []struct {
+		k8sTesting.SyncCase
+		foo              *redisOperatorV1.RedisOperator
+		wantStatefulSets []string
+		wantServices     []string
+		wantSlaveImage   string
+		wantSlaveReplica int32
+	}{
+		// The StatefulSets which were just created were not in the listers yet, so that the rollout
+		// was followed by their events rather than by the Result
+		{
+			SyncCase: k8sTesting.SyncCase{
+				Name:        "creates the children",
+				WantResult:  k8sTesting.Result(k8sCoreV1.Result{}),
+				WantReasons: []string{SuccessSynced},
+			},
+			foo:              newTestRedisOperator(1, 2),
+			wantStatefulSets: []string{"cn1-master", "cn1-slave"},
+			wantServices:     []string{"cn1-master", "cn1-slave", "example-redis-headless", "example-redis-ro", "example-redis-rw"},
+			wantSlaveImage:   "redis:6.0",
+			wantSlaveReplica: 2,
+		},
+		{
+			SyncCase: k8sTesting.SyncCase{
+				Name:        "skips the service of the slaves without replicas",
+				WantResult:  k8sTesting.Result(k8sCoreV1.Result{}),
+				WantReasons: []string{SuccessSynced},
+			},
+			foo:              newTestRedisOperator(1, 0),
+			wantStatefulSets: []string{"cn1-master", "cn1-slave"},
+			wantServices:     []string{"cn1-master", "example-redis-headless", "example-redis-ro", "example-redis-rw"},
+			wantSlaveImage:   "redis:6.0",
+			wantSlaveReplica: 0,
+		},
+		{
+			SyncCase: k8sTesting.SyncCase{
+				Name:        "scales the slaves",
+				Objects:     []runtime.Object{scaledChild},
+				WantResult:  k8sTesting.Result(k8sCoreV1.RequeueAfter(k8sCoreV1.RolloutRequeueAfter)),
+				WantReasons: []string{SuccessSynced},
+			},
+			foo:              scaled,
+			wantStatefulSets: []string{"cn1-master", "cn1-slave"},
+			wantServices:     []string{"cn1-master", "cn1-slave", "example-redis-headless", "example-redis-ro", "example-redis-rw"},
+			wantSlaveImage:   "redis:6.0",
+			wantSlaveReplica: 3,
+		},
+		{
+			SyncCase: k8sTesting.SyncCase{
+				Name:        "refuses the statefulset of someone else",
+				Objects:     []runtime.Object{unowned},
+				WantErr:     true,
+				WantResult:  k8sTesting.Result(k8sCoreV1.Result{}),
+				WantReasons: []string{k8sCoreV1.ErrResourceExists},
+			},
+			foo:              newTestRedisOperator(1, 2),
+			wantStatefulSets: []string{"cn1-master"},
+			wantServices:     []string{},
+		},
+		{
+			SyncCase: k8sTesting.SyncCase{
+				Name:        "adopts the statefulset with the annotation",
+				Objects:     []runtime.Object{unowned.DeepCopy()},
+				WantResult:  k8sTesting.Result(k8sCoreV1.RequeueAfter(k8sCoreV1.RolloutRequeueAfter)),
+				WantReasons: []string{k8sCoreV1.SuccessAdopted, SuccessSynced},
+			},
+			foo:              adopting,
+			wantStatefulSets: []string{"cn1-master", "cn1-slave"},
+			wantServices:     []string{"cn1-master", "cn1-slave", "example-redis-headless", "example-redis-ro", "example-redis-rw"},
+			wantSlaveImage:   "redis:6.0",
+			wantSlaveReplica: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			f := k8sTesting.NewFixture(t, tt.Objects...)
+			clientSet := fake.NewSimpleClientset(tt.foo)
+			f.Start(newOption(k8sTesting.AgentName, clientSet, f.StopCh()))
+
+			synced := f.ExpectSync(tt.foo, tt.SyncCase)
+			if got := f.StatefulSetNames(k8sTesting.Namespace); !reflect.DeepEqual(got, tt.wantStatefulSets) {
+				t.Errorf("StatefulSets = %v, want %v", got, tt.wantStatefulSets)
+			}
+			if got := f.ServiceNames(k8sTesting.Namespace); !reflect.DeepEqual(got, tt.wantServices) {
+				t.Errorf("Services = %v, want %v", got, tt.wantServices)
+			}
+			if !synced {
+				return
+			}
+			master := f.StatefulSet(k8sTesting.Namespace, "cn1-master")
+			if !metaV1.IsControlledBy(master, tt.foo) {
+				t.Errorf("master %v is not controlled by %s", master.OwnerReferences, tt.foo.Name)
+			}
+			slave := f.StatefulSet(k8sTesting.Namespace, "cn1-slave")
+			if got := slave.Spec.Template.Spec.Containers[0].Image; got != tt.wantSlaveImage {
+				t.Errorf("slave image = %s, want %s", got, tt.wantSlaveImage)
+			}
+			if got := *slave.Spec.Replicas; got != tt.wantSlaveReplica {
+				t.Errorf("slave replicas = %d, want %d", got, tt.wantSlaveReplica)
+			}
+			f.Secret(k8sTesting.Namespace, k8sCoreV1.GetConnectionSecretName(tt.foo.Name))
+		})
+	}
+}
+
+func TestSyncRolledOut(t *testing.T) {
+	foo := newTestRedisOperator(1, 2)
+	f := k8sTesting.NewFixture(t)
+	clientSet := fake.NewSimpleClientset(foo)
+	f.Start(newOption(k8sTesting.AgentName, clientSet, f.StopCh()))
+
+	if _, err := f.Reconcile(foo); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	f.SetStatefulSetStatus(k8sTesting.Namespace, "cn1-master", appsV1.StatefulSetStatus{Replicas: 1, ReadyReplicas: 1, UpdatedReplicas: 1})
+	f.SetStatefulSetStatus(k8sTesting.Namespace, "cn1-slave", appsV1.StatefulSetStatus{Replicas: 2, ReadyReplicas: 2, UpdatedReplicas: 2})
+
+	current, err := clientSet.NevercaseV1().RedisOperators(k8sTesting.Namespace).Get(f.Context(), foo.Name, metaV1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := f.Reconcile(current)
+	if err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if !result.IsZero() {
+		t.Errorf("Sync() result = %v, want no requeue once rolled out", result)
+	}
+	current, err = clientSet.NevercaseV1().RedisOperators(k8sTesting.Namespace).Get(f.Context(), foo.Name, metaV1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := current.Spec.SlaveSpec.Status.ReadyReplicas; got != 2 {
+		t.Errorf("slave ready replicas = %d, want 2", got)
+	}
+}
+
+func TestSyncInvalidSpec(t *testing.T) {
+	foo := newTestRedisOperator(1, 2)
+	foo.Spec.SlaveSpec.Spec.Image = ""
+	f := k8sTesting.NewFixture(t)
+	clientSet := fake.NewSimpleClientset(foo)
+	f.Start(newOption(k8sTesting.AgentName, clientSet, f.StopCh()))
+
+	// The invalid spec was not retried
+	f.ExpectSync(foo, k8sTesting.SyncCase{
+		WantResult:  k8sTesting.Result(k8sCoreV1.Result{}),
+		WantReasons: []string{k8sCoreV1.ErrInvalidSpec},
+	})
+	if got := f.StatefulSetNames(k8sTesting.Namespace); len(got) != 0 {
+		t.Errorf("StatefulSets = %v, want none", got)
+	}
+}
+
+func TestSyncRenamed(t *testing.T) {
+	// The children of the former name cn0, whose master kept its data in a PersistentVolumeClaim
+	former := newTestRedisOperator(1, 2)
+	former.Spec.MasterSpec.Spec.Name, former.Spec.SlaveSpec.Spec.Name = "cn0", "cn0"
+	formerMaster := childOf(former, true)
+	formerMaster.Spec.VolumeClaimTemplates = []coreV1.PersistentVolumeClaim{{ObjectMeta: metaV1.ObjectMeta{Name: "data"}}}
+	formerService := NewService(withDefaults(former), &withDefaults(former).Spec.MasterSpec.Spec)
+	formerService.Name = "cn0-master"
+	pvc := &coreV1.PersistentVolumeClaim{ObjectMeta: metaV1.ObjectMeta{
+		Name:      "data-cn0-master-0",
+		Namespace: k8sTesting.Namespace,
+		Labels:    formerMaster.Spec.Selector.MatchLabels,
+	}}
+
+	tests := []struct {
+		name          string
+		annotations   map[string]string
+		wantPVC       bool
+		wantCollected int
+		wantReasons   []string
+	}{
+		{
+			name:          "deletes the children of the former name",
+			wantCollected: 3,
+			wantReasons:   []string{SuccessSynced},
+		},
+		{
+			name:          "retains the volumes with the annotation",
+			annotations:   map[string]string{k8sCoreV1.RetainVolumesAnnotation: "true"},
+			wantPVC:       true,
+			wantCollected: 2,
+			wantReasons:   []string{k8sCoreV1.SuccessRetained, SuccessSynced},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			foo := newTestRedisOperator(1, 2)
+			foo.Annotations = tt.annotations
+			f := k8sTesting.NewFixture(t, formerMaster.DeepCopy(), formerService.DeepCopy(), pvc.DeepCopy())
+			clientSet := fake.NewSimpleClientset(foo)
+			f.Start(newOption(k8sTesting.AgentName, clientSet, f.StopCh()))
+
+			if _, err := f.Reconcile(foo); err != nil {
+				t.Fatalf("Sync() error = %v", err)
+			}
+			if got, want := f.StatefulSetNames(k8sTesting.Namespace), []string{"cn1-master", "cn1-slave"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("StatefulSets = %v, want %v", got, want)
+			}
+			if got := f.ServiceNames(k8sTesting.Namespace); sets.NewString(got...).Has("cn0-master") {
+				t.Errorf("Services = %v, want the one of the former name deleted", got)
+			}
+			_, err := f.KubeClientSet.CoreV1().PersistentVolumeClaims(k8sTesting.Namespace).Get(f.Context(), pvc.Name, metaV1.GetOptions{})
+			if (err == nil) != tt.wantPVC {
+				t.Errorf("PersistentVolumeClaim error = %v, want it retained %v", err, tt.wantPVC)
+			}
+			var reasons []string
+			collected := 0
+			for _, r := range f.Recorder.Reasons() {
+				if r == k8sCoreV1.SuccessCollected {
+					collected++
+					continue
+				}
+				reasons = append(reasons, r)
+			}
+			if collected != tt.wantCollected || !reflect.DeepEqual(reasons, tt.wantReasons) {
+				t.Errorf("event reasons = %v, want %d collections and %v", f.Recorder.Reasons(), tt.wantCollected, tt.wantReasons)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package redisoperator
+
+import (
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	redisOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/redisoperator/v1"
+)
+
+// Default fills the RedisOperator in the admission webhook, so that the stored object shows what was deployed
+func (r *Reconciler) Default(foo *redisOperatorV1.RedisOperator) {
+	SetDefaults(foo)
+}
+
+// SetDefaults fills the ports, the replicas, the service type, the resources and the image pull policy
+// of the master and the slaves which were not specified
+func SetDefaults(foo *redisOperatorV1.RedisOperator) {
+	for _, rds := range []*redisOperatorV1.RedisSpec{&foo.Spec.MasterSpec.Spec, &foo.Spec.SlaveSpec.Spec} {
+		k8sCoreV1.DefaultReplicas(&rds.Replicas)
+		rds.ContainerPorts = k8sCoreV1.DefaultContainerPorts(rds.ContainerPorts, RedisDefaultPort)
+		k8sCoreV1.DefaultServicePorts(rds.ServicePorts)
+		k8sCoreV1.DefaultServiceType(&rds.ServiceType)
+		k8sCoreV1.DefaultImagePullPolicy(&rds.ImagePullPolicy)
+		k8sCoreV1.DefaultResources(&rds.Resources)
+	}
+}
+
+// withDefaults returns the defaulted copy of the RedisOperator, the ones stored before the defaulting webhook
+// was registered were synced the same way
+func withDefaults(foo *redisOperatorV1.RedisOperator) *redisOperatorV1.RedisOperator {
+	foo = foo.DeepCopy()
+	SetDefaults(foo)
+	return foo
+}
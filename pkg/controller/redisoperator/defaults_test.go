@@ -0,0 +1,66 @@
+package redisoperator
+
+import (
+	"testing"
+
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	k8sTesting "github.com/nevercase/k8s-controller-custom-resource/core/v1/testing"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/generated/redisoperator/clientset/versioned/fake"
+)
+
+func TestSetDefaults(t *testing.T) {
+	foo := newTestRedisOperator(1, 2)
+	foo.Spec.SlaveSpec.Spec.Replicas = nil
+	foo.Spec.SlaveSpec.Spec.ContainerPorts = []coreV1.ContainerPort{{ContainerPort: 6380}}
+	foo.Spec.SlaveSpec.Spec.ServicePorts = nil
+	foo.Spec.SlaveSpec.Spec.ServiceType = coreV1.ServiceTypeNodePort
+	foo.Spec.SlaveSpec.Spec.ImagePullPolicy = coreV1.PullIfNotPresent
+	foo.Spec.SlaveSpec.Spec.Resources.Limits = coreV1.ResourceList{coreV1.ResourceMemory: resource.MustParse("1Gi")}
+	SetDefaults(foo)
+
+	master, slave := foo.Spec.MasterSpec.Spec, foo.Spec.SlaveSpec.Spec
+	if *master.Replicas != 1 || *slave.Replicas != 1 {
+		t.Errorf("replicas = %d, %d, want the specified 1 and the default 1", *master.Replicas, *slave.Replicas)
+	}
+	if got := master.ContainerPorts; len(got) != 1 || got[0].ContainerPort != RedisDefaultPort || got[0].Protocol != coreV1.ProtocolTCP {
+		t.Errorf("master containerPorts = %v, want %d/TCP", got, RedisDefaultPort)
+	}
+	if got := slave.ContainerPorts; len(got) != 1 || got[0].ContainerPort != 6380 || got[0].Protocol != coreV1.ProtocolTCP {
+		t.Errorf("slave containerPorts = %v, want the specified 6380/TCP", got)
+	}
+	if got := master.ServicePorts; len(got) != 1 || got[0].Protocol != coreV1.ProtocolTCP || got[0].TargetPort != intstr.FromInt(RedisDefaultPort) {
+		t.Errorf("master servicePorts = %v, want the target port %d/TCP", got, RedisDefaultPort)
+	}
+	if len(slave.ServicePorts) != 0 {
+		t.Errorf("slave servicePorts = %v, want none so that no Service of the slaves was created", slave.ServicePorts)
+	}
+	if master.ServiceType != coreV1.ServiceTypeClusterIP || slave.ServiceType != coreV1.ServiceTypeNodePort {
+		t.Errorf("serviceType = %s, %s, want ClusterIP and the specified NodePort", master.ServiceType, slave.ServiceType)
+	}
+	if master.ImagePullPolicy != coreV1.PullAlways || slave.ImagePullPolicy != coreV1.PullIfNotPresent {
+		t.Errorf("imagePullPolicy = %s, %s, want Always and the specified IfNotPresent", master.ImagePullPolicy, slave.ImagePullPolicy)
+	}
+	if got := slave.Resources.Requests[coreV1.ResourceMemory]; got.String() != "1Gi" {
+		t.Errorf("memory request = %s, want the limit 1Gi", got.String())
+	}
+}
+
+func TestDefaultingOption(t *testing.T) {
+	f := k8sTesting.NewFixture(t)
+	opt, ok := newOption(k8sTesting.AgentName, fake.NewSimpleClientset(), f.StopCh()).(k8sCoreV1.DefaultingOption)
+	if !ok {
+		t.Fatal("the Option of the RedisOperator was not a DefaultingOption")
+	}
+	if _, ok = opt.(k8sCoreV1.ValidatingOption); !ok {
+		t.Fatal("the DefaultingOption of the RedisOperator was not a ValidatingOption anymore")
+	}
+	foo := newTestRedisOperator(1, 2)
+	foo.Spec.MasterSpec.Spec.Replicas = nil
+	if err := opt.Default(foo); err != nil || foo.Spec.MasterSpec.Spec.Replicas == nil {
+		t.Errorf("Default() = %v, want the replicas of the master to be defaulted", err)
+	}
+}
@@ -0,0 +1,60 @@
+package redisoperator
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	redisOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/redisoperator/v1"
+)
+
+// NewConnectionSecret returns the Secret which holds the `-rw` and `-ro` endpoints,
+// so that it could be mounted by the applications with `envFrom`.
+// The redis has no password, the credentials were kept empty for the applications which expect them.
+func NewConnectionSecret(foo *redisOperatorV1.RedisOperator) *coreV1.Secret {
+	rwPort, roPort := int32(RedisDefaultPort), int32(RedisDefaultPort)
+	if len(foo.Spec.MasterSpec.Spec.ServicePorts) > 0 {
+		rwPort = foo.Spec.MasterSpec.Spec.ServicePorts[0].Port
+	}
+	if len(foo.Spec.SlaveSpec.Spec.ServicePorts) > 0 {
+		roPort = foo.Spec.SlaveSpec.Spec.ServicePorts[0].Port
+	}
+	rwHost := fmt.Sprintf("%s.%s.svc", k8sCoreV1.GetReadWriteServiceName(foo.Name), foo.Namespace)
+	roHost := fmt.Sprintf("%s.%s.svc", k8sCoreV1.GetReadOnlyServiceName(foo.Name), foo.Namespace)
+	uri := func(host string, port int32) string {
+		u := url.URL{
+			Scheme: "redis",
+			Host:   net.JoinHostPort(host, strconv.Itoa(int(port))),
+		}
+		return u.String()
+	}
+	return &coreV1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      k8sCoreV1.GetConnectionSecretName(foo.Name),
+			Namespace: foo.Namespace,
+			OwnerReferences: []metaV1.OwnerReference{
+				*metaV1.NewControllerRef(foo, redisOperatorV1.SchemeGroupVersion.WithKind(OperatorKindName)),
+			},
+			Labels: map[string]string{
+				k8sCoreV1.LabelApp:        OperatorKindName,
+				k8sCoreV1.LabelController: foo.Name,
+			},
+		},
+		Type: coreV1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			k8sCoreV1.ConnectionUsername: []byte(""),
+			k8sCoreV1.ConnectionPassword: []byte(""),
+			k8sCoreV1.ConnectionRWHost:   []byte(rwHost),
+			k8sCoreV1.ConnectionRWPort:   []byte(strconv.Itoa(int(rwPort))),
+			k8sCoreV1.ConnectionRWURI:    []byte(uri(rwHost, rwPort)),
+			k8sCoreV1.ConnectionROHost:   []byte(roHost),
+			k8sCoreV1.ConnectionROPort:   []byte(strconv.Itoa(int(roPort))),
+			k8sCoreV1.ConnectionROURI:    []byte(uri(roHost, roPort)),
+		},
+	}
+}
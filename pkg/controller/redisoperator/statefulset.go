@@ -18,21 +18,22 @@ func NewStatefulSet(foo *redisOperatorV1.RedisOperator, rds *redisOperatorV1.Red
 		k8sCoreV1.LabelController: foo.Name,
 		k8sCoreV1.LabelRole:       rds.Role,
 	}
+	// The access of the pods would be changed by the operator at runtime, so that it wasn't a part of the selector
+	podLabels := map[string]string{
+		k8sCoreV1.LabelAccess: k8sCoreV1.AccessReadOnly,
+	}
+	for k, v := range labels {
+		podLabels[k] = v
+	}
+	if rds.Role == k8sCoreV1.MasterName {
+		podLabels[k8sCoreV1.LabelAccess] = k8sCoreV1.AccessReadWrite
+	}
 	t := coreV1.HostPathDirectoryOrCreate
 	hostPath := &coreV1.HostPathVolumeSource{
 		Type: &t,
 		Path: fmt.Sprintf("%s/%s/redis/%s", rds.VolumePath, foo.Namespace, rds.Name),
 	}
-	port := strconv.Itoa(RedisDefaultPort)
-	ports := []coreV1.ContainerPort{
-		{
-			ContainerPort: RedisDefaultPort,
-		},
-	}
-	if len(rds.ContainerPorts) > 0 {
-		ports = rds.ContainerPorts
-		port = strconv.Itoa(int(rds.ContainerPorts[0].ContainerPort))
-	}
+	port := strconv.Itoa(int(rds.ContainerPorts[0].ContainerPort))
 	objectName := k8sCoreV1.GetStatefulSetName(rds.Name)
 	containerName := k8sCoreV1.GetContainerName(rds.Name)
 
@@ -87,13 +88,14 @@ func NewStatefulSet(foo *redisOperatorV1.RedisOperator, rds *redisOperatorV1.Red
 			Labels: labels,
 		},
 		Spec: appsV1.StatefulSetSpec{
-			Replicas: rds.Replicas,
+			ServiceName: k8sCoreV1.GetHeadlessServiceName(foo.Name),
+			Replicas:    rds.Replicas,
 			Selector: &metaV1.LabelSelector{
 				MatchLabels: labels,
 			},
 			Template: coreV1.PodTemplateSpec{
 				ObjectMeta: metaV1.ObjectMeta{
-					Labels: labels,
+					Labels: podLabels,
 				},
 				Spec: coreV1.PodSpec{
 					Volumes: []coreV1.Volume{
@@ -111,7 +113,7 @@ func NewStatefulSet(foo *redisOperatorV1.RedisOperator, rds *redisOperatorV1.Red
 						{
 							Name:      containerName,
 							Image:     rds.Image,
-							Ports:     ports,
+							Ports:     rds.ContainerPorts,
 							Env:       envs,
 							Resources: rds.Resources,
 							VolumeMounts: []coreV1.VolumeMount{
@@ -120,7 +122,7 @@ func NewStatefulSet(foo *redisOperatorV1.RedisOperator, rds *redisOperatorV1.Red
 									Name:      "task-pv-storage",
 								},
 							},
-							ImagePullPolicy: coreV1.PullAlways,
+							ImagePullPolicy: rds.ImagePullPolicy,
 						},
 					},
 					ImagePullSecrets: rds.ImagePullSecrets,
@@ -0,0 +1,104 @@
+package redisoperator
+
+import (
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	redisOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/redisoperator/v1"
+)
+
+var (
+	masterSpecPath = field.NewPath("spec", "masterSpec", "spec")
+	slaveSpecPath  = field.NewPath("spec", "slaveSpec", "spec")
+)
+
+// ValidateCreate validates the RedisOperator in the admission webhook, the names of its children
+// mustn't be taken by the others in the namespace.
+func (r *Reconciler) ValidateCreate(foo *redisOperatorV1.RedisOperator, others []*redisOperatorV1.RedisOperator) field.ErrorList {
+	return append(validateSpec(foo), validateChildNames(foo, others)...)
+}
+
+// ValidateUpdate validates the RedisOperator in the admission webhook. The names and the volume paths were immutable,
+// the spec was validated only if it was changed so that the controller could still update the legacy objects.
+func (r *Reconciler) ValidateUpdate(old, foo *redisOperatorV1.RedisOperator, others []*redisOperatorV1.RedisOperator) field.ErrorList {
+	if foo.DeletionTimestamp != nil {
+		return nil
+	}
+	res := field.ErrorList{}
+	for _, v := range []struct {
+		path     *field.Path
+		old, new *redisOperatorV1.RedisSpec
+	}{
+		{masterSpecPath, &old.Spec.MasterSpec.Spec, &foo.Spec.MasterSpec.Spec},
+		{slaveSpecPath, &old.Spec.SlaveSpec.Spec, &foo.Spec.SlaveSpec.Spec},
+	} {
+		res = append(res, apivalidation.ValidateImmutableField(v.new.Name, v.old.Name, v.path.Child("name"))...)
+		res = append(res, apivalidation.ValidateImmutableField(v.new.VolumePath, v.old.VolumePath, v.path.Child("volumePath"))...)
+	}
+	if !apiequality.Semantic.DeepEqual(old.Spec.MasterSpec.Spec, foo.Spec.MasterSpec.Spec) ||
+		!apiequality.Semantic.DeepEqual(old.Spec.SlaveSpec.Spec, foo.Spec.SlaveSpec.Spec) {
+		res = append(res, validateSpec(foo)...)
+	}
+	return res
+}
+
+// validateSpec returns the problems of the spec of foo which the sync couldn't get over,
+// the RedisOperators which were stored before the schema of the CustomResourceDefinition might have them.
+func validateSpec(foo *redisOperatorV1.RedisOperator) field.ErrorList {
+	res := validateRedisSpec(&foo.Spec.MasterSpec.Spec, k8sCoreV1.MasterName, masterSpecPath)
+	return append(res, validateRedisSpec(&foo.Spec.SlaveSpec.Spec, k8sCoreV1.SlaveName, slaveSpecPath)...)
+}
+
+func validateRedisSpec(rds *redisOperatorV1.RedisSpec, role string, path *field.Path) field.ErrorList {
+	res := field.ErrorList{}
+	if rds.Name == "" {
+		res = append(res, field.Required(path.Child("name"), ""))
+	} else {
+		// The StatefulSet and the Service were named after the name with the role
+		for _, msg := range validation.IsDNS1035Label(childName(rds.Name, role)) {
+			res = append(res, field.Invalid(path.Child("name"), rds.Name, msg))
+		}
+	}
+	if rds.Replicas == nil {
+		res = append(res, field.Required(path.Child("replicas"), ""))
+	} else if *rds.Replicas < 0 {
+		res = append(res, field.Invalid(path.Child("replicas"), *rds.Replicas, "must be greater than or equal to 0"))
+	}
+	if rds.Image == "" {
+		res = append(res, field.Required(path.Child("image"), ""))
+	}
+	return res
+}
+
+// validateChildNames rejects the names whose StatefulSets and Services were taken by the children of the others,
+// they would be refused as ErrResourceExists otherwise.
+func validateChildNames(foo *redisOperatorV1.RedisOperator, others []*redisOperatorV1.RedisOperator) field.ErrorList {
+	taken := make(map[string]string)
+	for _, other := range others {
+		taken[childName(other.Spec.MasterSpec.Spec.Name, k8sCoreV1.MasterName)] = other.Name
+		taken[childName(other.Spec.SlaveSpec.Spec.Name, k8sCoreV1.SlaveName)] = other.Name
+	}
+	res := field.ErrorList{}
+	for _, v := range []struct {
+		path       *field.Path
+		name, role string
+	}{
+		{masterSpecPath.Child("name"), foo.Spec.MasterSpec.Spec.Name, k8sCoreV1.MasterName},
+		{slaveSpecPath.Child("name"), foo.Spec.SlaveSpec.Spec.Name, k8sCoreV1.SlaveName},
+	} {
+		if owner, ok := taken[childName(v.name, v.role)]; ok && v.name != "" {
+			res = append(res, field.Duplicate(v.path, fmt.Sprintf("%s (taken by %s %s)", v.name, OperatorKindName, owner)))
+		}
+	}
+	return res
+}
+
+// childName returns the name of the StatefulSet and the Service of the spec name with the role
+func childName(name, role string) string {
+	return fmt.Sprintf("%s-%s", name, role)
+}
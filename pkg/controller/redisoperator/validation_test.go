@@ -0,0 +1,133 @@
+package redisoperator
+
+import (
+	"sort"
+	"testing"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	k8sTesting "github.com/nevercase/k8s-controller-custom-resource/core/v1/testing"
+	redisOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/redisoperator/v1"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/generated/redisoperator/clientset/versioned/fake"
+)
+
+func TestValidate(t *testing.T) {
+	other := newTestRedisOperator(1, 2)
+	other.Name = "other-redis"
+
+	tests := []struct {
+		name   string
+		old    *redisOperatorV1.RedisOperator
+		modify func(foo *redisOperatorV1.RedisOperator)
+		others []*redisOperatorV1.RedisOperator
+		want   []string
+	}{
+		{
+			name:   "accepts the same name of the master and the slaves",
+			modify: func(foo *redisOperatorV1.RedisOperator) {},
+		},
+		{
+			name: "requires the replicas, the image and the name",
+			modify: func(foo *redisOperatorV1.RedisOperator) {
+				foo.Spec.MasterSpec.Spec.Replicas = nil
+				foo.Spec.SlaveSpec.Spec.Image = ""
+				foo.Spec.SlaveSpec.Spec.Name = ""
+			},
+			want: []string{"spec.masterSpec.spec.replicas", "spec.slaveSpec.spec.name", "spec.slaveSpec.spec.image"},
+		},
+		{
+			name: "rejects the names which aren't DNS labels",
+			modify: func(foo *redisOperatorV1.RedisOperator) {
+				foo.Spec.MasterSpec.Spec.Name = "Redis_CN1"
+			},
+			want: []string{"spec.masterSpec.spec.name"},
+		},
+		{
+			name:   "rejects the names of the children of the others",
+			modify: func(foo *redisOperatorV1.RedisOperator) {},
+			others: []*redisOperatorV1.RedisOperator{other},
+			want:   []string{"spec.masterSpec.spec.name", "spec.slaveSpec.spec.name"},
+		},
+		{
+			name: "rejects the renamed spec and the changed volume path",
+			old:  newTestRedisOperator(1, 2),
+			modify: func(foo *redisOperatorV1.RedisOperator) {
+				foo.Spec.SlaveSpec.Spec.Name = "cn2"
+				foo.Spec.MasterSpec.Spec.VolumePath = "/mnt/nas2"
+			},
+			want: []string{"spec.masterSpec.spec.volumePath", "spec.slaveSpec.spec.name"},
+		},
+		{
+			name: "accepts the unchanged spec of a legacy object",
+			old: func() *redisOperatorV1.RedisOperator {
+				foo := newTestRedisOperator(1, 2)
+				foo.Spec.SlaveSpec.Spec.Replicas = nil
+				return foo
+			}(),
+			modify: func(foo *redisOperatorV1.RedisOperator) {
+				foo.Spec.SlaveSpec.Spec.Replicas = nil
+				foo.Spec.SlaveSpec.Status.ReadyReplicas = 2
+			},
+		},
+		{
+			name: "accepts any update of the object being deleted",
+			old:  newTestRedisOperator(1, 2),
+			modify: func(foo *redisOperatorV1.RedisOperator) {
+				now := metaV1.Now()
+				foo.DeletionTimestamp = &now
+				foo.Spec.SlaveSpec.Spec.Name = "cn2"
+			},
+		},
+	}
+	r := NewReconciler(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			foo := newTestRedisOperator(1, 2)
+			tt.modify(foo)
+			var errs field.ErrorList
+			if tt.old == nil {
+				errs = r.ValidateCreate(foo, tt.others)
+			} else {
+				errs = r.ValidateUpdate(tt.old, foo, tt.others)
+			}
+			got := make([]string, 0)
+			for _, e := range errs {
+				got = append(got, e.Field)
+			}
+			if !sameFields(got, tt.want) {
+				t.Errorf("fields = %v, want %v (%v)", got, tt.want, errs)
+			}
+		})
+	}
+}
+
+func TestValidatingOption(t *testing.T) {
+	f := k8sTesting.NewFixture(t)
+	opt, ok := newOption(k8sTesting.AgentName, fake.NewSimpleClientset(), f.StopCh()).(k8sCoreV1.ValidatingOption)
+	if !ok {
+		t.Fatal("the Option of the RedisOperator was not a ValidatingOption")
+	}
+	foo := newTestRedisOperator(1, 2)
+	foo.Spec.MasterSpec.Spec.Image = ""
+	if errs := opt.ValidateCreate(foo); len(errs) != 1 {
+		t.Errorf("ValidateCreate() = %v, want the image to be required", errs)
+	}
+}
+
+// sameFields reports whether a and b hold the same fields in any order
+func sameFields(a, b []string) bool {
+	a, b = append([]string{}, a...), append([]string{}, b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
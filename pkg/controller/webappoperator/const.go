@@ -0,0 +1,29 @@
+package webappoperator
+
+const OperatorKindName = "WebAppOperator"
+
+const (
+	// SuccessSynced is used as part of the Event 'reason' when a WebAppOperator is synced
+	SuccessSynced = "Synced"
+	// MessageResourceSynced is the message used for an Event fired when a WebAppOperator
+	// is synced successfully
+	MessageResourceSynced = "WebAppOperator synced successfully"
+)
+
+const (
+	WebAppDefaultContainerPort = 8080
+	WebAppDefaultServicePort   = 80
+	// WebAppDefaultTargetCPUUtilization is the target of the HorizontalPodAutoscaler without any target
+	WebAppDefaultTargetCPUUtilization = 80
+)
+
+// The phases of the status subresource of the WebAppOperator
+const (
+	WebAppPhasePending = "Pending"
+	WebAppPhaseReady   = "Ready"
+	WebAppPhasePaused  = "Paused"
+)
+
+// TemplateHashAnnotation is the hash of the pod template of the WebAppOperator on its Deployment,
+// the Deployment was updated once the hash changed since the apiserver defaults the template in place.
+const TemplateHashAnnotation = "nevercase.io/template-hash"
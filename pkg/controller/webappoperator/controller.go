@@ -0,0 +1,162 @@
+package webappoperator
+
+import (
+	"context"
+
+	appsV1 "k8s.io/api/apps/v1"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	webAppOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/webappoperator/v1"
+	webAppOperatorClientSet "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/webappoperator/clientset/versioned"
+	webAppOperatorScheme "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/webappoperator/clientset/versioned/scheme"
+)
+
+// ownerKind is the kind of the controller ownerRef of the children
+var ownerKind = webAppOperatorV1.SchemeGroupVersion.WithKind(OperatorKindName)
+
+// Reconciler reconciles the WebAppOperators with the Deployments, the Services, the Ingresses
+// and the HorizontalPodAutoscalers which were named after them
+type Reconciler struct {
+	clientSet webAppOperatorClientSet.Interface
+}
+
+// NewReconciler returns the Reconciler which updates the status of the WebAppOperators with clientSet
+func NewReconciler(clientSet webAppOperatorClientSet.Interface) *Reconciler {
+	return &Reconciler{clientSet: clientSet}
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, foo *webAppOperatorV1.WebAppOperator) (k8sCoreV1.Result, error) {
+	return Sync(ctx, foo, r.clientSet, k8sCoreV1.ResourceFromContext(ctx), k8sCoreV1.RecorderFromContext(ctx))
+}
+
+// Finalize does nothing, the children were collected by their owner references
+func (r *Reconciler) Finalize(ctx context.Context, foo *webAppOperatorV1.WebAppOperator) error {
+	return nil
+}
+
+// ReconcilePaused sets the phase to Paused, the phase was refreshed by the sync once it was resumed
+func (r *Reconciler) ReconcilePaused(ctx context.Context, foo *webAppOperatorV1.WebAppOperator, paused bool) error {
+	if !paused {
+		return nil
+	}
+	status := foo.Status
+	status.Phase = WebAppPhasePaused
+	return updateStatus(ctx, foo, r.clientSet, status)
+}
+
+// ReconcileStatus copies the status of the Deployment into the WebAppOperator
+func (r *Reconciler) ReconcileStatus(ctx context.Context, foo *webAppOperatorV1.WebAppOperator, child metaV1.Object) error {
+	d, ok := child.(*appsV1.Deployment)
+	if !ok {
+		return nil
+	}
+	return updateStatus(ctx, foo, r.clientSet, deploymentStatus(withDefaults(foo), d))
+}
+
+func NewOption(controllerName string, cfg *rest.Config, stopCh <-chan struct{}) k8sCoreV1.Option {
+	c, err := webAppOperatorClientSet.NewForConfig(cfg)
+	if err != nil {
+		klog.Fatalf("Error building clientSet: %s", err.Error())
+	}
+	return newOption(controllerName, c, stopCh)
+}
+
+func newOption(controllerName string, clientSet webAppOperatorClientSet.Interface, stopCh <-chan struct{}) k8sCoreV1.Option {
+	return k8sCoreV1.NewBuilder[webAppOperatorV1.WebAppOperator, *webAppOperatorV1.WebAppOperatorList](clientSet.NevercaseV1().WebAppOperators).
+		AgentName(controllerName).
+		Scheme(webAppOperatorScheme.AddToScheme).
+		Reconciler(NewReconciler(clientSet)).
+		Build(stopCh)
+}
+
+func Sync(ctx context.Context, foo *webAppOperatorV1.WebAppOperator, clientSet webAppOperatorClientSet.Interface, ks k8sCoreV1.KubernetesResource, recorder record.EventRecorder) (k8sCoreV1.Result, error) {
+	// The invalid spec would fail again on each retry, the WebAppOperator was synced again once it was fixed
+	foo = withDefaults(foo)
+	if errs := validateSpec(foo); len(errs) > 0 {
+		recorder.Event(foo, coreV1.EventTypeWarning, k8sCoreV1.ErrInvalidSpec, errs.ToAggregate().Error())
+		return k8sCoreV1.Result{}, nil
+	}
+	d, err := deployment(ks, foo, recorder)
+	if err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	if err = service(ks, foo, recorder); err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	// The Ingress and the HorizontalPodAutoscaler were deleted once they were removed from the spec
+	if foo.Spec.Ingress != nil {
+		err = k8sCoreV1.ApplyIngress(ctx, ks.ClientSet(), NewIngress(foo))
+	} else {
+		err = k8sCoreV1.DeleteIngress(ctx, ks.ClientSet(), foo, foo.Name)
+	}
+	if err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	if foo.Spec.Autoscaling != nil {
+		err = k8sCoreV1.ApplyHorizontalPodAutoscaler(ctx, ks.ClientSet(), NewHorizontalPodAutoscaler(foo))
+	} else {
+		err = k8sCoreV1.DeleteHorizontalPodAutoscaler(ctx, ks.ClientSet(), foo, foo.Name)
+	}
+	if err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	// The pods which couldn't pull their images keep the WebAppOperator being requeued
+	if err = k8sCoreV1.CheckImagePull(ks, foo.Namespace, selectorLabels(foo)); err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	recorder.Event(foo, coreV1.EventTypeNormal, SuccessSynced, MessageResourceSynced)
+	if err = updateStatus(ctx, foo, clientSet, deploymentStatus(foo, d)); err != nil {
+		return k8sCoreV1.Result{}, err
+	}
+	// The status follows the rollout without waiting for the events of the Deployment
+	if !k8sCoreV1.DeploymentRolledOut(d) {
+		return k8sCoreV1.RequeueAfter(k8sCoreV1.RolloutRequeueAfter), nil
+	}
+	return k8sCoreV1.Result{}, nil
+}
+
+// deployment creates the Deployment, or updates the claimed one once its pod template or its replicas changed
+func deployment(ks k8sCoreV1.KubernetesResource, foo *webAppOperatorV1.WebAppOperator, recorder record.EventRecorder) (*appsV1.Deployment, error) {
+	desired := NewDeployment(foo)
+	d, err := ks.Deployment().Get(foo.Namespace, foo.Name)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, err
+		}
+		return ks.Deployment().Create(foo.Namespace, desired)
+	}
+	if d, err = k8sCoreV1.ClaimDeployment(ks.Deployment(), foo, ownerKind, d, recorder); err != nil {
+		return nil, err
+	}
+	if !deploymentChanged(d, desired) {
+		return d, nil
+	}
+	// The replicas of the HorizontalPodAutoscaler were kept
+	if desired.Spec.Replicas == nil {
+		desired.Spec.Replicas = d.Spec.Replicas
+	}
+	desired.ResourceVersion = d.ResourceVersion
+	return ks.Deployment().Update(foo.Namespace, desired)
+}
+
+// service applies the Service, the Service which was just adopted waits for the next sync
+func service(ks k8sCoreV1.KubernetesResource, foo *webAppOperatorV1.WebAppOperator, recorder record.EventRecorder) error {
+	svc := NewService(foo)
+	current, err := ks.Service().Get(foo.Namespace, svc.Name)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if err == nil {
+		adopted, err := k8sCoreV1.ClaimService(ks.Service(), foo, ownerKind, current, recorder)
+		if err != nil || adopted {
+			return err
+		}
+	}
+	return k8sCoreV1.ApplyService(ks.Service(), svc)
+}
@@ -0,0 +1,190 @@
+package webappoperator
+
+import (
+	"testing"
+
+	appsV1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	k8sTesting "github.com/nevercase/k8s-controller-custom-resource/core/v1/testing"
+	webAppOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/webappoperator/v1"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/generated/webappoperator/clientset/versioned/fake"
+)
+
+func newTestWebAppOperator(replicas int32) *webAppOperatorV1.WebAppOperator {
+	return &webAppOperatorV1.WebAppOperator{
+		TypeMeta:   metaV1.TypeMeta{APIVersion: webAppOperatorV1.SchemeGroupVersion.String(), Kind: OperatorKindName},
+		ObjectMeta: k8sTesting.ObjectMeta("example-web"),
+		Spec: webAppOperatorV1.WebAppOperatorSpec{
+			Replicas: k8sTesting.Int32Ptr(replicas),
+			Image:    "nginx:1.21",
+		},
+	}
+}
+
+func TestSync(t *testing.T) {
+	withIngress := newTestWebAppOperator(2)
+	withIngress.Spec.Ingress = &webAppOperatorV1.IngressSpec{Host: "web.example.com", TLSSecretName: "web-tls"}
+
+	autoscaled := newTestWebAppOperator(2)
+	autoscaled.Spec.Autoscaling = &webAppOperatorV1.AutoscalingSpec{MaxReplicas: 5}
+	// The Deployment which was scaled by the HorizontalPodAutoscaler
+	scaledByHPA := NewDeployment(withDefaults(autoscaled))
+	scaledByHPA.Spec.Replicas = k8sTesting.Int32Ptr(4)
+
+	unowned := NewDeployment(withDefaults(newTestWebAppOperator(1)))
+	unowned.OwnerReferences = nil
+
+	invalid := newTestWebAppOperator(1)
+	invalid.Spec.Image = ""
+
+	tests := []struct {
+		k8sTesting.SyncCase
+		foo          *webAppOperatorV1.WebAppOperator
+		wantReplicas int32
+		wantIngress  bool
+		wantHPA      bool
+		wantURL      string
+	}{
+		{
+			SyncCase:     k8sTesting.SyncCase{Name: "creates the deployment and the service", WantReasons: []string{SuccessSynced}},
+			foo:          newTestWebAppOperator(2),
+			wantReplicas: 2,
+		},
+		{
+			SyncCase:     k8sTesting.SyncCase{Name: "creates the ingress", WantReasons: []string{SuccessSynced}},
+			foo:          withIngress,
+			wantReplicas: 2,
+			wantIngress:  true,
+			wantURL:      "https://web.example.com/",
+		},
+		{
+			SyncCase: k8sTesting.SyncCase{
+				Name:        "leaves the replicas to the autoscaler",
+				Objects:     []runtime.Object{scaledByHPA},
+				WantReasons: []string{SuccessSynced},
+			},
+			foo:          autoscaled,
+			wantReplicas: 4,
+			wantHPA:      true,
+		},
+		{
+			SyncCase: k8sTesting.SyncCase{
+				Name:        "refuses the deployment of someone else",
+				Objects:     []runtime.Object{unowned},
+				WantErr:     true,
+				WantReasons: []string{k8sCoreV1.ErrResourceExists},
+			},
+			foo: newTestWebAppOperator(1),
+		},
+		{
+			SyncCase: k8sTesting.SyncCase{Name: "skips the invalid spec", WantReasons: []string{k8sCoreV1.ErrInvalidSpec}},
+			foo:      invalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			f := k8sTesting.NewFixture(t, tt.Objects...)
+			clientSet := fake.NewSimpleClientset(tt.foo)
+			f.Start(newOption(k8sTesting.AgentName, clientSet, f.StopCh()))
+
+			if !f.ExpectSync(tt.foo, tt.SyncCase) || tt.wantReplicas == 0 {
+				return
+			}
+			d := f.Deployment(k8sTesting.Namespace, tt.foo.Name)
+			if !metaV1.IsControlledBy(d, tt.foo) {
+				t.Errorf("deployment %v is not controlled by %s", d.OwnerReferences, tt.foo.Name)
+			}
+			if got := *d.Spec.Replicas; got != tt.wantReplicas {
+				t.Errorf("deployment replicas = %d, want %d", got, tt.wantReplicas)
+			}
+			svc := f.Service(k8sTesting.Namespace, tt.foo.Name)
+			if got := svc.Spec.Ports[0].TargetPort.IntValue(); got != WebAppDefaultContainerPort {
+				t.Errorf("service target port = %d, want %d", got, WebAppDefaultContainerPort)
+			}
+			_, err := f.KubeClientSet.NetworkingV1().Ingresses(k8sTesting.Namespace).Get(f.Context(), tt.foo.Name, metaV1.GetOptions{})
+			if exists := !errors.IsNotFound(err); exists != tt.wantIngress {
+				t.Errorf("ingress exists = %v, want %v", exists, tt.wantIngress)
+			}
+			_, err = f.KubeClientSet.AutoscalingV2beta2().HorizontalPodAutoscalers(k8sTesting.Namespace).Get(f.Context(), tt.foo.Name, metaV1.GetOptions{})
+			if exists := !errors.IsNotFound(err); exists != tt.wantHPA {
+				t.Errorf("horizontalPodAutoscaler exists = %v, want %v", exists, tt.wantHPA)
+			}
+			current, err := clientSet.NevercaseV1().WebAppOperators(k8sTesting.Namespace).Get(f.Context(), tt.foo.Name, metaV1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if current.Status.Phase != WebAppPhasePending || current.Status.URL != tt.wantURL {
+				t.Errorf("status = %+v, want the phase %s and the url %q", current.Status, WebAppPhasePending, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestSyncRolledOut(t *testing.T) {
+	foo := newTestWebAppOperator(2)
+	foo.Spec.Ingress = &webAppOperatorV1.IngressSpec{Host: "web.example.com"}
+	f := k8sTesting.NewFixture(t)
+	clientSet := fake.NewSimpleClientset(foo)
+	f.Start(newOption(k8sTesting.AgentName, clientSet, f.StopCh()))
+
+	result, err := f.Reconcile(foo)
+	if err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if want := k8sCoreV1.RequeueAfter(k8sCoreV1.RolloutRequeueAfter); result != want {
+		t.Errorf("Sync() result = %v, want %v while rolling out", result, want)
+	}
+	f.SetDeploymentStatus(k8sTesting.Namespace, foo.Name, appsV1.DeploymentStatus{Replicas: 2, UpdatedReplicas: 2, ReadyReplicas: 2, AvailableReplicas: 2})
+
+	current, err := clientSet.NevercaseV1().WebAppOperators(k8sTesting.Namespace).Get(f.Context(), foo.Name, metaV1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The ingress was removed from the spec
+	current.Spec.Ingress = nil
+	if current, err = clientSet.NevercaseV1().WebAppOperators(k8sTesting.Namespace).Update(f.Context(), current, metaV1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if result, err = f.Reconcile(current); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if !result.IsZero() {
+		t.Errorf("Sync() result = %v, want no requeue once rolled out", result)
+	}
+	if _, err = f.KubeClientSet.NetworkingV1().Ingresses(k8sTesting.Namespace).Get(f.Context(), foo.Name, metaV1.GetOptions{}); !errors.IsNotFound(err) {
+		t.Errorf("ingress was not deleted: %v", err)
+	}
+	current, err = clientSet.NevercaseV1().WebAppOperators(k8sTesting.Namespace).Get(f.Context(), foo.Name, metaV1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current.Status.Phase != WebAppPhaseReady || current.Status.ReadyReplicas != 2 {
+		t.Errorf("status = %+v, want %s with 2 ready replicas", current.Status, WebAppPhaseReady)
+	}
+}
+
+func TestAdmissionOption(t *testing.T) {
+	f := k8sTesting.NewFixture(t)
+	opt := newOption(k8sTesting.AgentName, fake.NewSimpleClientset(), f.StopCh())
+	vo, ok := opt.(k8sCoreV1.ValidatingOption)
+	if !ok {
+		t.Fatal("the Option of the WebAppOperator was not a ValidatingOption")
+	}
+	do, ok := opt.(k8sCoreV1.DefaultingOption)
+	if !ok {
+		t.Fatal("the Option of the WebAppOperator was not a DefaultingOption")
+	}
+	foo := newTestWebAppOperator(1)
+	foo.Spec.Replicas = nil
+	if err := do.Default(foo); err != nil || foo.Spec.Replicas == nil {
+		t.Errorf("Default() = %v, want the replicas to be defaulted", err)
+	}
+	foo.Spec.Image = ""
+	if errs := vo.ValidateCreate(foo); len(errs) == 0 {
+		t.Error("expected the missing image being refused")
+	}
+}
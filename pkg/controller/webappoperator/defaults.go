@@ -0,0 +1,50 @@
+package webappoperator
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	webAppOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/webappoperator/v1"
+)
+
+// Default fills the WebAppOperator in the admission webhook, so that the stored object shows what was deployed
+func (r *Reconciler) Default(foo *webAppOperatorV1.WebAppOperator) {
+	SetDefaults(foo)
+}
+
+// SetDefaults fills the replicas, the ports, the service type, the resources, the image pull policy,
+// the path of the ingress and the target of the autoscaling which were not specified
+func SetDefaults(foo *webAppOperatorV1.WebAppOperator) {
+	spec := &foo.Spec
+	k8sCoreV1.DefaultReplicas(&spec.Replicas)
+	spec.ContainerPorts = k8sCoreV1.DefaultContainerPorts(spec.ContainerPorts, WebAppDefaultContainerPort)
+	if len(spec.ServicePorts) == 0 {
+		spec.ServicePorts = []corev1.ServicePort{{
+			Name:       "http",
+			Port:       WebAppDefaultServicePort,
+			TargetPort: intstr.FromInt(int(spec.ContainerPorts[0].ContainerPort)),
+		}}
+	}
+	k8sCoreV1.DefaultServicePorts(spec.ServicePorts)
+	k8sCoreV1.DefaultServiceType(&spec.ServiceType)
+	k8sCoreV1.DefaultImagePullPolicy(&spec.ImagePullPolicy)
+	k8sCoreV1.DefaultResources(&spec.Resources)
+	if spec.Ingress != nil && spec.Ingress.Path == "" {
+		spec.Ingress.Path = "/"
+	}
+	if a := spec.Autoscaling; a != nil {
+		k8sCoreV1.DefaultReplicas(&a.MinReplicas)
+		if a.TargetCPUUtilizationPercentage == nil && a.TargetMemoryUtilizationPercentage == nil {
+			target := int32(WebAppDefaultTargetCPUUtilization)
+			a.TargetCPUUtilizationPercentage = &target
+		}
+	}
+}
+
+// withDefaults returns the defaulted copy of the WebAppOperator
+func withDefaults(foo *webAppOperatorV1.WebAppOperator) *webAppOperatorV1.WebAppOperator {
+	foo = foo.DeepCopy()
+	SetDefaults(foo)
+	return foo
+}
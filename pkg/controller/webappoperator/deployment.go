@@ -0,0 +1,94 @@
+package webappoperator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	appsV1 "k8s.io/api/apps/v1"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	webAppOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/webappoperator/v1"
+)
+
+// NewDeployment returns the Deployment of the WebAppOperator, which was annotated with the hash of its pod template.
+// The replicas were left to the HorizontalPodAutoscaler once the autoscaling was specified.
+func NewDeployment(foo *webAppOperatorV1.WebAppOperator) *appsV1.Deployment {
+	labels := selectorLabels(foo)
+	spec := foo.Spec
+	template := coreV1.PodTemplateSpec{
+		ObjectMeta: metaV1.ObjectMeta{
+			Labels: labels,
+		},
+		Spec: coreV1.PodSpec{
+			Containers: []coreV1.Container{
+				{
+					Name:            k8sCoreV1.GetContainerName(foo.Name),
+					Image:           spec.Image,
+					ImagePullPolicy: spec.ImagePullPolicy,
+					Command:         spec.Command,
+					Args:            spec.Args,
+					Env:             spec.Env,
+					Resources:       spec.Resources,
+					Ports:           spec.ContainerPorts,
+					LivenessProbe:   spec.LivenessProbe,
+					ReadinessProbe:  spec.ReadinessProbe,
+					StartupProbe:    spec.StartupProbe,
+				},
+			},
+			ImagePullSecrets: spec.ImagePullSecrets,
+			Affinity:         spec.Affinity,
+			Tolerations:      spec.Tolerations,
+		},
+	}
+	d := &appsV1.Deployment{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      foo.Name,
+			Namespace: foo.Namespace,
+			OwnerReferences: []metaV1.OwnerReference{
+				*metaV1.NewControllerRef(foo, ownerKind),
+			},
+			Labels:      labels,
+			Annotations: map[string]string{TemplateHashAnnotation: templateHash(template)},
+		},
+		Spec: appsV1.DeploymentSpec{
+			Replicas: spec.Replicas,
+			Selector: &metaV1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: template,
+		},
+	}
+	if spec.Autoscaling != nil {
+		d.Spec.Replicas = nil
+	}
+	return d
+}
+
+// templateHash returns the hash of the pod template before it was defaulted by the apiserver
+func templateHash(template coreV1.PodTemplateSpec) string {
+	data, _ := json.Marshal(template)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
+// deploymentChanged reports whether the Deployment should be updated with desired.
+// The replicas of the current one were scaled by the HorizontalPodAutoscaler while desired had none.
+func deploymentChanged(current, desired *appsV1.Deployment) bool {
+	if current.Annotations[TemplateHashAnnotation] != desired.Annotations[TemplateHashAnnotation] {
+		return true
+	}
+	if desired.Spec.Replicas == nil {
+		return false
+	}
+	return current.Spec.Replicas == nil || *current.Spec.Replicas != *desired.Spec.Replicas
+}
+
+func selectorLabels(foo *webAppOperatorV1.WebAppOperator) map[string]string {
+	return map[string]string{
+		k8sCoreV1.LabelApp:        OperatorKindName,
+		k8sCoreV1.LabelController: foo.Name,
+	}
+}
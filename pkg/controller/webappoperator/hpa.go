@@ -0,0 +1,56 @@
+package webappoperator
+
+import (
+	autoscalingV2beta2 "k8s.io/api/autoscaling/v2beta2"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	webAppOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/webappoperator/v1"
+)
+
+// NewHorizontalPodAutoscaler returns the HorizontalPodAutoscaler which scales the Deployment by the utilizations
+func NewHorizontalPodAutoscaler(foo *webAppOperatorV1.WebAppOperator) *autoscalingV2beta2.HorizontalPodAutoscaler {
+	spec := foo.Spec.Autoscaling
+	metrics := make([]autoscalingV2beta2.MetricSpec, 0, 2)
+	for _, target := range []struct {
+		name        coreV1.ResourceName
+		utilization *int32
+	}{
+		{coreV1.ResourceCPU, spec.TargetCPUUtilizationPercentage},
+		{coreV1.ResourceMemory, spec.TargetMemoryUtilizationPercentage},
+	} {
+		if target.utilization == nil {
+			continue
+		}
+		metrics = append(metrics, autoscalingV2beta2.MetricSpec{
+			Type: autoscalingV2beta2.ResourceMetricSourceType,
+			Resource: &autoscalingV2beta2.ResourceMetricSource{
+				Name: target.name,
+				Target: autoscalingV2beta2.MetricTarget{
+					Type:               autoscalingV2beta2.UtilizationMetricType,
+					AverageUtilization: target.utilization,
+				},
+			},
+		})
+	}
+	return &autoscalingV2beta2.HorizontalPodAutoscaler{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      foo.Name,
+			Namespace: foo.Namespace,
+			OwnerReferences: []metaV1.OwnerReference{
+				*metaV1.NewControllerRef(foo, ownerKind),
+			},
+			Labels: selectorLabels(foo),
+		},
+		Spec: autoscalingV2beta2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingV2beta2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       foo.Name,
+			},
+			MinReplicas: spec.MinReplicas,
+			MaxReplicas: spec.MaxReplicas,
+			Metrics:     metrics,
+		},
+	}
+}
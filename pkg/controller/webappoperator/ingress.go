@@ -0,0 +1,68 @@
+package webappoperator
+
+import (
+	"fmt"
+
+	networkingV1 "k8s.io/api/networking/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	webAppOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/webappoperator/v1"
+)
+
+// NewIngress returns the Ingress which routes the path of the host to the first port of the Service
+func NewIngress(foo *webAppOperatorV1.WebAppOperator) *networkingV1.Ingress {
+	spec := foo.Spec.Ingress
+	pathType := networkingV1.PathTypePrefix
+	ingress := &networkingV1.Ingress{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      foo.Name,
+			Namespace: foo.Namespace,
+			OwnerReferences: []metaV1.OwnerReference{
+				*metaV1.NewControllerRef(foo, ownerKind),
+			},
+			Labels:      selectorLabels(foo),
+			Annotations: spec.Annotations,
+		},
+		Spec: networkingV1.IngressSpec{
+			IngressClassName: spec.IngressClassName,
+			Rules: []networkingV1.IngressRule{
+				{
+					Host: spec.Host,
+					IngressRuleValue: networkingV1.IngressRuleValue{
+						HTTP: &networkingV1.HTTPIngressRuleValue{
+							Paths: []networkingV1.HTTPIngressPath{
+								{
+									Path:     spec.Path,
+									PathType: &pathType,
+									Backend: networkingV1.IngressBackend{
+										Service: &networkingV1.IngressServiceBackend{
+											Name: foo.Name,
+											Port: networkingV1.ServiceBackendPort{Number: foo.Spec.ServicePorts[0].Port},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if spec.TLSSecretName != "" {
+		ingress.Spec.TLS = []networkingV1.IngressTLS{{Hosts: []string{spec.Host}, SecretName: spec.TLSSecretName}}
+	}
+	return ingress
+}
+
+// ingressURL returns where the Ingress serves the WebAppOperator, or nothing without the Ingress
+func ingressURL(foo *webAppOperatorV1.WebAppOperator) string {
+	spec := foo.Spec.Ingress
+	if spec == nil {
+		return ""
+	}
+	scheme := "http"
+	if spec.TLSSecretName != "" {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s%s", scheme, spec.Host, spec.Path)
+}
@@ -0,0 +1,30 @@
+package webappoperator
+
+import (
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	webAppOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/webappoperator/v1"
+)
+
+// NewService returns the Service of the WebAppOperator, which was named after it
+func NewService(foo *webAppOperatorV1.WebAppOperator) *coreV1.Service {
+	labels := selectorLabels(foo)
+	return &coreV1.Service{
+		ObjectMeta: metaV1.ObjectMeta{
+			Annotations: k8sCoreV1.LoadBalancerAnnotation(foo.Spec.ServiceType, false),
+			Name:        foo.Name,
+			Namespace:   foo.Namespace,
+			OwnerReferences: []metaV1.OwnerReference{
+				*metaV1.NewControllerRef(foo, ownerKind),
+			},
+			Labels: labels,
+		},
+		Spec: coreV1.ServiceSpec{
+			Type:     foo.Spec.ServiceType,
+			Ports:    foo.Spec.ServicePorts,
+			Selector: labels,
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package webappoperator
+
+import (
+	"context"
+	"time"
+
+	appsV1 "k8s.io/api/apps/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	webAppOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/webappoperator/v1"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/env"
+	webAppOperatorClientSet "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/webappoperator/clientset/versioned"
+)
+
+// deploymentStatus returns the status of the WebAppOperator which was copied from its Deployment
+func deploymentStatus(foo *webAppOperatorV1.WebAppOperator, d *appsV1.Deployment) webAppOperatorV1.WebAppOperatorStatus {
+	status := webAppOperatorV1.WebAppOperatorStatus{
+		Phase:              WebAppPhaseReady,
+		ObservedGeneration: foo.Generation,
+		Selector:           labels.SelectorFromSet(selectorLabels(foo)).String(),
+		URL:                ingressURL(foo),
+	}
+	if d != nil {
+		status.Replicas = d.Status.Replicas
+		status.ReadyReplicas = d.Status.ReadyReplicas
+		status.UpdatedReplicas = d.Status.UpdatedReplicas
+		status.AvailableReplicas = d.Status.AvailableReplicas
+	}
+	switch {
+	case k8sCoreV1.IsPaused(foo):
+		status.Phase = WebAppPhasePaused
+	case d == nil || !k8sCoreV1.DeploymentRolledOut(d):
+		status.Phase = WebAppPhasePending
+	}
+	return status
+}
+
+// updateStatus writes the status through the status subresource, the update was skipped if nothing changed
+// since it would bring the WebAppOperator back
+func updateStatus(ctx context.Context, foo *webAppOperatorV1.WebAppOperator, clientSet webAppOperatorClientSet.Interface, status webAppOperatorV1.WebAppOperatorStatus) error {
+	if foo.Status == status || k8sCoreV1.SuppressedByDryRun(OperatorKindName, foo) {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(env.DefaultExecutionDuration))
+	defer cancel()
+	fooCopy := foo.DeepCopy()
+	fooCopy.Status = status
+	_, err := clientSet.NevercaseV1().WebAppOperators(foo.Namespace).UpdateStatus(ctx, fooCopy, metaV1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,94 @@
+package webappoperator
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	webAppOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/webappoperator/v1"
+)
+
+var (
+	specPath        = field.NewPath("spec")
+	ingressPath     = specPath.Child("ingress")
+	autoscalingPath = specPath.Child("autoscaling")
+)
+
+// ValidateCreate validates the WebAppOperator in the admission webhook, the host and the path of its Ingress
+// mustn't be taken by the others in the namespace.
+func (r *Reconciler) ValidateCreate(foo *webAppOperatorV1.WebAppOperator, others []*webAppOperatorV1.WebAppOperator) field.ErrorList {
+	return append(validateSpec(foo), validateIngressHost(foo, others)...)
+}
+
+// ValidateUpdate validates the WebAppOperator in the admission webhook, the WebAppOperator being deleted was let through
+func (r *Reconciler) ValidateUpdate(old, foo *webAppOperatorV1.WebAppOperator, others []*webAppOperatorV1.WebAppOperator) field.ErrorList {
+	if foo.DeletionTimestamp != nil {
+		return nil
+	}
+	return append(validateSpec(foo), validateIngressHost(foo, others)...)
+}
+
+// validateSpec returns the problems of the spec of foo which the sync couldn't get over
+func validateSpec(foo *webAppOperatorV1.WebAppOperator) field.ErrorList {
+	res := field.ErrorList{}
+	// The Deployment, the Service, the Ingress and the HorizontalPodAutoscaler were named after the WebAppOperator
+	for _, msg := range validation.IsDNS1035Label(foo.Name) {
+		res = append(res, field.Invalid(field.NewPath("metadata", "name"), foo.Name, msg))
+	}
+	spec := foo.Spec
+	if spec.Image == "" {
+		res = append(res, field.Required(specPath.Child("image"), ""))
+	}
+	if spec.Replicas != nil && *spec.Replicas < 0 {
+		res = append(res, field.Invalid(specPath.Child("replicas"), *spec.Replicas, "must be greater than or equal to 0"))
+	}
+	if in := spec.Ingress; in != nil {
+		if in.Host == "" {
+			res = append(res, field.Required(ingressPath.Child("host"), ""))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(in.Host) {
+				res = append(res, field.Invalid(ingressPath.Child("host"), in.Host, msg))
+			}
+		}
+		if in.Path != "" && !strings.HasPrefix(in.Path, "/") {
+			res = append(res, field.Invalid(ingressPath.Child("path"), in.Path, "must be an absolute path"))
+		}
+	}
+	if a := spec.Autoscaling; a != nil {
+		if a.MaxReplicas < 1 {
+			res = append(res, field.Invalid(autoscalingPath.Child("maxReplicas"), a.MaxReplicas, "must be greater than or equal to 1"))
+		}
+		if a.MinReplicas != nil && *a.MinReplicas > a.MaxReplicas {
+			res = append(res, field.Invalid(autoscalingPath.Child("minReplicas"), *a.MinReplicas, "must be less than or equal to maxReplicas"))
+		}
+	}
+	return res
+}
+
+// validateIngressHost rejects the host and the path which were routed by the Ingress of another WebAppOperator
+func validateIngressHost(foo *webAppOperatorV1.WebAppOperator, others []*webAppOperatorV1.WebAppOperator) field.ErrorList {
+	if foo.Spec.Ingress == nil {
+		return nil
+	}
+	res := field.ErrorList{}
+	for _, other := range others {
+		if other.Spec.Ingress == nil {
+			continue
+		}
+		if other.Spec.Ingress.Host == foo.Spec.Ingress.Host && ingressPathOf(other) == ingressPathOf(foo) {
+			res = append(res, field.Duplicate(ingressPath.Child("host"),
+				fmt.Sprintf("%s%s (taken by %s %s)", foo.Spec.Ingress.Host, ingressPathOf(foo), OperatorKindName, other.Name)))
+		}
+	}
+	return res
+}
+
+// ingressPathOf returns the path of the Ingress, the empty one was defaulted to /
+func ingressPathOf(foo *webAppOperatorV1.WebAppOperator) string {
+	if foo.Spec.Ingress.Path == "" {
+		return "/"
+	}
+	return foo.Spec.Ingress.Path
+}
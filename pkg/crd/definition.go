@@ -0,0 +1,225 @@
+// Package crd generates the apiextensions.k8s.io/v1 CustomResourceDefinitions of the custom resources
+// from their Go types, and installs them into the cluster.
+package crd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// Definition describes the CustomResourceDefinition of a custom resource,
+// the schema was generated from Object and then refined with the Rules.
+type Definition struct {
+	GroupVersion schema.GroupVersion
+	Kind         string
+	Plural       string
+	ShortNames   []string
+	// Object is the zero value of the Go type of the custom resource
+	Object interface{}
+	Rules  []Rule
+	// Scale enables the scale subresource, the status subresource was always enabled
+	Scale          *CustomResourceSubresourceScale
+	PrinterColumns []PrinterColumn
+	// Versions are the versions besides GroupVersion, which was the storage version. They were served
+	// only once Webhook was set, since their objects must be converted by the conversion webhook.
+	Versions []Version
+	// Webhook is the client config of the conversion webhook
+	Webhook *WebhookClientConfig
+}
+
+// Version is a version of a Definition besides the storage version
+type Version struct {
+	Name           string
+	Object         interface{}
+	Rules          []Rule
+	Scale          *CustomResourceSubresourceScale
+	PrinterColumns []PrinterColumn
+	Conversion     Conversion
+}
+
+// Conversion converts the JSON objects of a Version from and into the storage version
+type Conversion interface {
+	FromStorage(obj []byte) (interface{}, error)
+	ToStorage(obj []byte) (interface{}, error)
+}
+
+// Converter returns the Conversion of the typed conversion functions between the storage version S and the version V
+func Converter[S, V any](fromStorage func(*S) (*V, error), toStorage func(*V) (*S, error)) Conversion {
+	return converter[S, V]{fromStorage: fromStorage, toStorage: toStorage}
+}
+
+type converter[S, V any] struct {
+	fromStorage func(*S) (*V, error)
+	toStorage   func(*V) (*S, error)
+}
+
+func (c converter[S, V]) FromStorage(obj []byte) (interface{}, error) {
+	in := new(S)
+	if err := json.Unmarshal(obj, in); err != nil {
+		return nil, err
+	}
+	return c.fromStorage(in)
+}
+
+func (c converter[S, V]) ToStorage(obj []byte) (interface{}, error) {
+	in := new(V)
+	if err := json.Unmarshal(obj, in); err != nil {
+		return nil, err
+	}
+	return c.toStorage(in)
+}
+
+// Name returns the name of the CustomResourceDefinition, e.g. redisoperators.nevercase.io
+func (d Definition) Name() string {
+	return fmt.Sprintf("%s.%s", d.Plural, d.GroupVersion.Group)
+}
+
+// Rule refines the generated schema of a Definition
+type Rule func(root *JSONSchemaProps) error
+
+// Required marks the fields of the object at path as required
+func Required(path string, fields ...string) Rule {
+	return func(root *JSONSchemaProps) error {
+		p, err := lookup(root, path)
+		if err != nil {
+			return err
+		}
+		for _, f := range fields {
+			if _, ok := p.Properties[f]; !ok {
+				return fmt.Errorf("no field %q in %s", f, path)
+			}
+		}
+		p.Required = append(p.Required, fields...)
+		return nil
+	}
+}
+
+// Default sets the default of the field at path, which was applied by the apiserver
+func Default(path string, value interface{}) Rule {
+	return func(root *JSONSchemaProps) error {
+		p, err := lookup(root, path)
+		if err != nil {
+			return err
+		}
+		p.Default = value
+		return nil
+	}
+}
+
+// Minimum sets the minimum of the number at path
+func Minimum(path string, min float64) Rule {
+	return func(root *JSONSchemaProps) error {
+		p, err := lookup(root, path)
+		if err != nil {
+			return err
+		}
+		p.Minimum = &min
+		return nil
+	}
+}
+
+// MinLength sets the min length of the string at path
+func MinLength(path string, n int64) Rule {
+	return func(root *JSONSchemaProps) error {
+		p, err := lookup(root, path)
+		if err != nil {
+			return err
+		}
+		p.MinLength = &n
+		return nil
+	}
+}
+
+// Enum restricts the field at path to values
+func Enum(path string, values ...interface{}) Rule {
+	return func(root *JSONSchemaProps) error {
+		p, err := lookup(root, path)
+		if err != nil {
+			return err
+		}
+		p.Enum = values
+		return nil
+	}
+}
+
+// Build returns the CustomResourceDefinition of d
+func (d Definition) Build() (*CustomResourceDefinition, error) {
+	storage, err := buildVersion(d.Kind, Version{
+		Name:           d.GroupVersion.Version,
+		Object:         d.Object,
+		Rules:          d.Rules,
+		Scale:          d.Scale,
+		PrinterColumns: d.PrinterColumns,
+	})
+	if err != nil {
+		return nil, err
+	}
+	storage.Served, storage.Storage = true, true
+	versions := []CustomResourceDefinitionVersion{storage}
+	for _, v := range d.Versions {
+		version, err := buildVersion(d.Kind, v)
+		if err != nil {
+			return nil, err
+		}
+		version.Served = d.Webhook != nil
+		versions = append(versions, version)
+	}
+	res := &CustomResourceDefinition{
+		APIVersion: "apiextensions.k8s.io/v1",
+		Kind:       "CustomResourceDefinition",
+		Metadata:   ObjectMeta{Name: d.Name()},
+		Spec: CustomResourceDefinitionSpec{
+			Group: d.GroupVersion.Group,
+			Names: CustomResourceDefinitionNames{
+				Plural:     d.Plural,
+				Singular:   strings.ToLower(d.Kind),
+				ShortNames: d.ShortNames,
+				Kind:       d.Kind,
+				ListKind:   d.Kind + "List",
+			},
+			Scope:    "Namespaced",
+			Versions: versions,
+		},
+	}
+	if len(d.Versions) > 0 && d.Webhook != nil {
+		res.Spec.Conversion = &CustomResourceConversion{
+			Strategy: "Webhook",
+			Webhook: &WebhookConversion{
+				ClientConfig:             d.Webhook,
+				ConversionReviewVersions: []string{"v1"},
+			},
+		}
+	}
+	return res, nil
+}
+
+func buildVersion(kind string, v Version) (CustomResourceDefinitionVersion, error) {
+	root := SchemaOf(v.Object)
+	for _, r := range v.Rules {
+		if err := r(&root); err != nil {
+			return CustomResourceDefinitionVersion{}, fmt.Errorf("%s %s: %v", kind, v.Name, err)
+		}
+	}
+	return CustomResourceDefinitionVersion{
+		Name:   v.Name,
+		Schema: &CustomResourceValidation{OpenAPIV3Schema: &root},
+		Subresources: &CustomResourceSubresources{
+			Status: &struct{}{},
+			Scale:  v.Scale,
+		},
+		AdditionalPrinterColumns: v.PrinterColumns,
+	}, nil
+}
+
+// Marshal returns the YAML of the CustomResourceDefinition of d
+func (d Definition) Marshal() ([]byte, error) {
+	crd, err := d.Build()
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(crd)
+}
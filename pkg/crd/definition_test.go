@@ -0,0 +1,125 @@
+package crd
+
+import (
+	"testing"
+)
+
+func TestDefinitions(t *testing.T) {
+	for _, d := range Definitions() {
+		t.Run(d.Kind, func(t *testing.T) {
+			crd, err := d.Build()
+			if err != nil {
+				t.Fatalf("Build() error = %v", err)
+			}
+			version := crd.Spec.Versions[0]
+			if version.Subresources.Status == nil {
+				t.Error("the status subresource was not enabled")
+			}
+			root := version.Schema.OpenAPIV3Schema
+			status, err := lookup(root, "status.phase")
+			if err != nil || status.Type != "string" {
+				t.Errorf("status.phase = %v, %v", status, err)
+			}
+			if d.Scale == nil {
+				return
+			}
+			// The WebAppOperator has a single spec without the master and the slaves
+			paths, wantRequired := coreSpecs, []string{"name", "image"}
+			if _, err := lookup(root, "spec.masterSpec"); err != nil {
+				paths, wantRequired = []string{"spec"}, []string{"image"}
+			}
+			for _, path := range paths {
+				replicas, err := lookup(root, path+".replicas")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if replicas.Type != "integer" || replicas.Default != 1 || replicas.Minimum == nil {
+					t.Errorf("%s.replicas = %+v, want the integer defaulting to 1", path, replicas)
+				}
+				spec, _ := lookup(root, path)
+				for _, f := range wantRequired {
+					if !contains(spec.Required, f) {
+						t.Errorf("%s requires %v, want %v", path, spec.Required, wantRequired)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestVersions(t *testing.T) {
+	d := Definitions()[0]
+	crd, err := d.Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if len(crd.Spec.Versions) != 2 || crd.Spec.Versions[1].Served || crd.Spec.Conversion != nil {
+		t.Errorf("versions = %+v, want v2 not served without the conversion webhook", crd.Spec.Versions)
+	}
+	url := "https://192.168.1.10:9443/convert-nevercase-io-v1-redisoperator"
+	d.Webhook = &WebhookClientConfig{URL: &url}
+	if crd, err = d.Build(); err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if !crd.Spec.Versions[1].Served || crd.Spec.Versions[1].Storage {
+		t.Errorf("v2 = %+v, want served but not stored", crd.Spec.Versions[1])
+	}
+	if c := crd.Spec.Conversion; c == nil || c.Strategy != "Webhook" || c.Webhook.ClientConfig.URL != &url {
+		t.Errorf("conversion = %+v, want the conversion webhook", c)
+	}
+	if _, err = lookup(crd.Spec.Versions[1].Schema.OpenAPIV3Schema, "spec.master.storage.size"); err != nil {
+		t.Errorf("the schema of v2 was not generated from its type: %v", err)
+	}
+	master, err := lookup(crd.Spec.Versions[1].Schema.OpenAPIV3Schema, "spec.master")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !contains(master.Required, "storage") {
+		t.Errorf("spec.master of v2 requires %v, want the storage which was not omitempty", master.Required)
+	}
+}
+
+func TestSchemaOf(t *testing.T) {
+	type inner struct {
+		Value string `json:"value"`
+	}
+	type object struct {
+		inner    `json:",inline"`
+		Pointer  *int32            `json:"pointer"`
+		Omitted  *int32            `json:"omitted,omitempty"`
+		Labels   map[string]string `json:"labels,omitempty"`
+		Items    []inner           `json:"items"`
+		Skipped  string            `json:"-"`
+		internal string
+	}
+	root := SchemaOf(object{})
+	if _, ok := root.Properties["value"]; !ok {
+		t.Error("the inlined fields were not flattened")
+	}
+	if p := root.Properties["pointer"]; !p.Nullable || p.Format != "int32" {
+		t.Errorf("pointer = %+v, want the nullable int32", p)
+	}
+	if p := root.Properties["omitted"]; p.Nullable {
+		t.Error("the omitted pointer was nullable")
+	}
+	if p := root.Properties["labels"]; p.AdditionalProperties == nil || p.AdditionalProperties.Type != "string" {
+		t.Errorf("labels = %+v, want the map of strings", p)
+	}
+	if p := root.Properties["items"]; p.Items == nil || p.Items.Properties["value"] == nil {
+		t.Errorf("items = %+v, want the array of objects", p)
+	}
+	for _, name := range []string{"Skipped", "-", "internal"} {
+		if _, ok := root.Properties[name]; ok {
+			t.Errorf("%s was not skipped", name)
+		}
+	}
+}
+
+func contains(s []string, v string) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
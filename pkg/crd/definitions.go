@@ -0,0 +1,229 @@
+package crd
+
+import (
+	mysqlOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+	mysqlOperatorV2 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v2"
+	redisOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/redisoperator/v1"
+	redisOperatorV2 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/redisoperator/v2"
+	webAppOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/webappoperator/v1"
+)
+
+// The paths of the master and the slave specs of the RedisOperator and the MysqlOperator
+var coreSpecs = []string{"spec.masterSpec.spec", "spec.slaveSpec.spec"}
+
+// coreRules requires the name and the image of the master and the slaves, and defaults the static fields
+// of theirs, the ports and the resources were defaulted by the defaulting webhook
+func coreRules() []Rule {
+	res := []Rule{
+		Required("", "spec"),
+		Required("spec", "masterSpec", "slaveSpec"),
+	}
+	for _, p := range coreSpecs {
+		res = append(res,
+			Required(p, "name", "image"),
+			MinLength(p+".name", 1),
+			MinLength(p+".image", 1),
+			Default(p+".replicas", 1),
+			Minimum(p+".replicas", 0),
+			Enum(p+".serviceType", "", "ClusterIP", "NodePort", "LoadBalancer"),
+			Default(p+".serviceType", "ClusterIP"),
+			Enum(p+".imagePullPolicy", "Always", "Never", "IfNotPresent"),
+			Default(p+".imagePullPolicy", "Always"),
+			Default(p+".containerPorts.*.protocol", "TCP"),
+			Default(p+".servicePorts.*.protocol", "TCP"),
+		)
+	}
+	return res
+}
+
+// coreRulesV2 are the coreRules of the master and the slaves of v2, whose storage was always serialized
+func coreRulesV2() []Rule {
+	res := []Rule{
+		Required("", "spec"),
+		Required("spec", "master", "slave"),
+	}
+	for _, p := range []string{"spec.master", "spec.slave"} {
+		res = append(res,
+			Required(p, "name", "image", "storage"),
+			MinLength(p+".name", 1),
+			MinLength(p+".image", 1),
+			Default(p+".replicas", 1),
+			Minimum(p+".replicas", 0),
+			Enum(p+".serviceType", "ClusterIP", "NodePort", "LoadBalancer"),
+			Default(p+".serviceType", "ClusterIP"),
+			Enum(p+".imagePullPolicy", "Always", "Never", "IfNotPresent"),
+			Default(p+".imagePullPolicy", "Always"),
+			Default(p+".containerPorts.*.protocol", "TCP"),
+			Default(p+".servicePorts.*.protocol", "TCP"),
+		)
+	}
+	return res
+}
+
+// coreScale scales the slaves, the selector of the status was that of the pods of the slaves
+var coreScale = &CustomResourceSubresourceScale{
+	SpecReplicasPath:   ".spec.slaveSpec.spec.replicas",
+	StatusReplicasPath: ".status.replicas",
+	LabelSelectorPath:  ".status.selector",
+}
+
+var coreScaleV2 = &CustomResourceSubresourceScale{
+	SpecReplicasPath:   ".spec.slave.replicas",
+	StatusReplicasPath: ".status.replicas",
+	LabelSelectorPath:  ".status.selector",
+}
+
+var corePrinterColumns = []PrinterColumn{
+	{Name: "Image", Type: "string", JSONPath: ".spec.masterSpec.spec.image"},
+	{Name: "Replicas", Type: "integer", JSONPath: ".status.replicas", Priority: 1},
+	{Name: "Ready", Type: "integer", JSONPath: ".status.readyReplicas"},
+	{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+	{Name: "Age", Type: "date", JSONPath: ".metadata.creationTimestamp"},
+}
+
+var corePrinterColumnsV2 = []PrinterColumn{
+	{Name: "Image", Type: "string", JSONPath: ".spec.master.image"},
+	{Name: "Replicas", Type: "integer", JSONPath: ".status.replicas", Priority: 1},
+	{Name: "Ready", Type: "integer", JSONPath: ".status.readyReplicas"},
+	{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+	{Name: "Age", Type: "date", JSONPath: ".metadata.creationTimestamp"},
+}
+
+var dependentPrinterColumns = []PrinterColumn{
+	{Name: "Operator", Type: "string", JSONPath: ".spec.operator"},
+	{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+	{Name: "Message", Type: "string", JSONPath: ".status.message", Priority: 1},
+	{Name: "Age", Type: "date", JSONPath: ".metadata.creationTimestamp"},
+}
+
+// Definitions returns the Definitions of the custom resources of this repository
+func Definitions() []Definition {
+	return []Definition{
+		{
+			GroupVersion:   redisOperatorV1.SchemeGroupVersion,
+			Kind:           "RedisOperator",
+			Plural:         "redisoperators",
+			ShortNames:     []string{"ro"},
+			Object:         redisOperatorV1.RedisOperator{},
+			Rules:          coreRules(),
+			Scale:          coreScale,
+			PrinterColumns: corePrinterColumns,
+			Versions: []Version{{
+				Name:   redisOperatorV2.Version,
+				Object: redisOperatorV2.RedisOperator{},
+				Rules: append(coreRulesV2(),
+					Enum("spec.mode", "MasterSlave", "Sentinel", "Cluster"),
+					Default("spec.mode", "MasterSlave"),
+					Default("spec.sentinel.replicas", 3),
+					Minimum("spec.sentinel.replicas", 1),
+					Minimum("spec.sentinel.quorum", 1),
+					Required("spec.cluster", "shards"),
+					Minimum("spec.cluster.shards", 3),
+					Minimum("spec.cluster.replicasPerShard", 0),
+				),
+				Scale: coreScaleV2,
+				PrinterColumns: append([]PrinterColumn{{Name: "Mode", Type: "string", JSONPath: ".spec.mode"}},
+					corePrinterColumnsV2...),
+				Conversion: Converter(redisOperatorV2.ConvertFromV1, redisOperatorV2.ConvertToV1),
+			}},
+		},
+		{
+			GroupVersion: mysqlOperatorV1.SchemeGroupVersion,
+			Kind:         "MysqlOperator",
+			Plural:       "mysqloperators",
+			ShortNames:   []string{"mo"},
+			Object:       mysqlOperatorV1.MysqlOperator{},
+			Rules: append(coreRules(),
+				Required("spec.backup", "image", "archivePath"),
+				MinLength("spec.backup.image", 1),
+				Minimum("spec.backup.fullDumpIntervalSeconds", 1),
+				Minimum("spec.backup.retentionCount", 1),
+				Minimum("spec.failover.gracePeriodSeconds", 0),
+			),
+			Scale:          coreScale,
+			PrinterColumns: corePrinterColumns,
+			Versions: []Version{{
+				Name:   mysqlOperatorV2.Version,
+				Object: mysqlOperatorV2.MysqlOperator{},
+				Rules: append(coreRulesV2(),
+					Required("spec.backup", "image", "archivePath"),
+					MinLength("spec.backup.image", 1),
+					Minimum("spec.backup.fullDumpIntervalSeconds", 1),
+					Minimum("spec.backup.retentionCount", 1),
+					Minimum("spec.failover.gracePeriodSeconds", 0),
+				),
+				Scale:          coreScaleV2,
+				PrinterColumns: corePrinterColumnsV2,
+				Conversion:     Converter(mysqlOperatorV2.ConvertFromV1, mysqlOperatorV2.ConvertToV1),
+			}},
+		},
+		{
+			GroupVersion: mysqlOperatorV1.SchemeGroupVersion,
+			Kind:         "MysqlDatabase",
+			Plural:       "mysqldatabases",
+			ShortNames:   []string{"mdb"},
+			Object:       mysqlOperatorV1.MysqlDatabase{},
+			Rules: []Rule{
+				Required("", "spec"),
+				Required("spec", "operator"),
+				MinLength("spec.operator", 1),
+			},
+			PrinterColumns: dependentPrinterColumns,
+		},
+		{
+			GroupVersion: mysqlOperatorV1.SchemeGroupVersion,
+			Kind:         "MysqlUser",
+			Plural:       "mysqlusers",
+			ShortNames:   []string{"mu"},
+			Object:       mysqlOperatorV1.MysqlUser{},
+			Rules: []Rule{
+				Required("", "spec"),
+				Required("spec", "operator"),
+				MinLength("spec.operator", 1),
+				Required("spec.grants.*", "database", "privileges"),
+			},
+			PrinterColumns: dependentPrinterColumns,
+		},
+		{
+			GroupVersion: webAppOperatorV1.SchemeGroupVersion,
+			Kind:         "WebAppOperator",
+			Plural:       "webappoperators",
+			ShortNames:   []string{"wao"},
+			Object:       webAppOperatorV1.WebAppOperator{},
+			Rules: []Rule{
+				Required("", "spec"),
+				Required("spec", "image"),
+				MinLength("spec.image", 1),
+				Default("spec.replicas", 1),
+				Minimum("spec.replicas", 0),
+				Enum("spec.serviceType", "ClusterIP", "NodePort", "LoadBalancer"),
+				Default("spec.serviceType", "ClusterIP"),
+				Enum("spec.imagePullPolicy", "Always", "Never", "IfNotPresent"),
+				Default("spec.imagePullPolicy", "Always"),
+				Default("spec.containerPorts.*.protocol", "TCP"),
+				Default("spec.servicePorts.*.protocol", "TCP"),
+				Required("spec.ingress", "host"),
+				MinLength("spec.ingress.host", 1),
+				Default("spec.ingress.path", "/"),
+				Required("spec.autoscaling", "maxReplicas"),
+				Minimum("spec.autoscaling.minReplicas", 1),
+				Minimum("spec.autoscaling.maxReplicas", 1),
+				Minimum("spec.autoscaling.targetCPUUtilizationPercentage", 1),
+				Minimum("spec.autoscaling.targetMemoryUtilizationPercentage", 1),
+			},
+			Scale: &CustomResourceSubresourceScale{
+				SpecReplicasPath:   ".spec.replicas",
+				StatusReplicasPath: ".status.replicas",
+				LabelSelectorPath:  ".status.selector",
+			},
+			PrinterColumns: []PrinterColumn{
+				{Name: "Image", Type: "string", JSONPath: ".spec.image"},
+				{Name: "Replicas", Type: "integer", JSONPath: ".status.replicas", Priority: 1},
+				{Name: "Available", Type: "integer", JSONPath: ".status.availableReplicas"},
+				{Name: "URL", Type: "string", JSONPath: ".status.url"},
+				{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+				{Name: "Age", Type: "date", JSONPath: ".metadata.creationTimestamp"},
+			},
+		},
+	}
+}
@@ -0,0 +1,98 @@
+package crd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+)
+
+// Resource is the GroupVersionResource of the CustomResourceDefinitions
+var Resource = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+
+// EstablishedTimeout bounds how long Install waits for a CustomResourceDefinition to be served
+const EstablishedTimeout = time.Second * 30
+
+// Install creates the CustomResourceDefinitions of defs, or upgrades the existing ones in place,
+// and then waits for them to be established so that the informers could list the custom resources.
+func Install(ctx context.Context, client dynamic.Interface, defs ...Definition) error {
+	crds := client.Resource(Resource)
+	for _, d := range defs {
+		desired, err := toUnstructured(d)
+		if err != nil {
+			return err
+		}
+		current, err := crds.Get(ctx, d.Name(), metav1.GetOptions{})
+		switch {
+		case errors.IsNotFound(err):
+			klog.Infof("creating CustomResourceDefinition %s", d.Name())
+			if _, err = crds.Create(ctx, desired, metav1.CreateOptions{}); err != nil {
+				return fmt.Errorf("creating CustomResourceDefinition %s: %v", d.Name(), err)
+			}
+		case err != nil:
+			return fmt.Errorf("getting CustomResourceDefinition %s: %v", d.Name(), err)
+		default:
+			klog.Infof("upgrading CustomResourceDefinition %s", d.Name())
+			desired.SetResourceVersion(current.GetResourceVersion())
+			desired.SetLabels(current.GetLabels())
+			desired.SetAnnotations(current.GetAnnotations())
+			if _, err = crds.Update(ctx, desired, metav1.UpdateOptions{}); err != nil {
+				return fmt.Errorf("upgrading CustomResourceDefinition %s: %v", d.Name(), err)
+			}
+		}
+	}
+	for _, d := range defs {
+		if err := waitForEstablished(ctx, client, d.Name()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toUnstructured(d Definition) (*unstructured.Unstructured, error) {
+	crd, err := d.Build()
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(crd)
+	if err != nil {
+		return nil, err
+	}
+	res := &unstructured.Unstructured{}
+	if err = res.UnmarshalJSON(b); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func waitForEstablished(ctx context.Context, client dynamic.Interface, name string) error {
+	err := wait.PollImmediate(time.Millisecond*500, EstablishedTimeout, func() (bool, error) {
+		crd, err := client.Resource(Resource).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		conditions, _, err := unstructured.NestedSlice(crd.Object, "status", "conditions")
+		if err != nil {
+			return false, err
+		}
+		for _, c := range conditions {
+			m, ok := c.(map[string]interface{})
+			if ok && m["type"] == "Established" && m["status"] == "True" {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("waiting for CustomResourceDefinition %s to be established: %v", name, err)
+	}
+	return nil
+}
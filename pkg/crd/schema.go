@@ -0,0 +1,168 @@
+package crd
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var (
+	quantityType     = reflect.TypeOf(resource.Quantity{})
+	intOrStringType  = reflect.TypeOf(intstr.IntOrString{})
+	timeType         = reflect.TypeOf(metav1.Time{})
+	microTimeType    = reflect.TypeOf(metav1.MicroTime{})
+	durationType     = reflect.TypeOf(metav1.Duration{})
+	objectMetaType   = reflect.TypeOf(metav1.ObjectMeta{})
+	typeMetaType     = reflect.TypeOf(metav1.TypeMeta{})
+	rawExtensionName = "RawExtension"
+)
+
+// SchemaOf returns the structural schema of the json encoding of the type of obj,
+// which was walked through the json tags of the fields.
+func SchemaOf(obj interface{}) JSONSchemaProps {
+	return newSchemaWalker().schema(reflect.TypeOf(obj))
+}
+
+type schemaWalker struct {
+	// visiting holds the types on the current path, a recursive type was left unknown
+	visiting map[reflect.Type]bool
+}
+
+func newSchemaWalker() *schemaWalker {
+	return &schemaWalker{visiting: make(map[reflect.Type]bool)}
+}
+
+func (w *schemaWalker) schema(t reflect.Type) JSONSchemaProps {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t {
+	case quantityType, intOrStringType:
+		return JSONSchemaProps{XIntOrString: true, AnyOf: intOrString()}
+	case timeType, microTimeType:
+		return JSONSchemaProps{Type: "string", Format: "date-time", Nullable: true}
+	case durationType:
+		return JSONSchemaProps{Type: "string"}
+	case objectMetaType:
+		return JSONSchemaProps{Type: "object"}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return JSONSchemaProps{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return JSONSchemaProps{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return JSONSchemaProps{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return JSONSchemaProps{Type: "number"}
+	case reflect.String:
+		return JSONSchemaProps{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return JSONSchemaProps{Type: "string", Format: "byte"}
+		}
+		items := w.schema(t.Elem())
+		return JSONSchemaProps{Type: "array", Items: &items}
+	case reflect.Map:
+		values := w.schema(t.Elem())
+		return JSONSchemaProps{Type: "object", AdditionalProperties: &values}
+	case reflect.Struct:
+		if t.Name() == rawExtensionName {
+			return unknown("object")
+		}
+		if w.visiting[t] {
+			return unknown("object")
+		}
+		w.visiting[t] = true
+		defer delete(w.visiting, t)
+		res := JSONSchemaProps{Type: "object", Properties: make(map[string]*JSONSchemaProps)}
+		w.fields(t, &res)
+		return res
+	default:
+		// interface{} and the likes could be anything
+		return unknown("")
+	}
+}
+
+// fields adds the json fields of the struct t to the properties of res, the inlined structs were flattened
+func (w *schemaWalker) fields(t reflect.Type, res *JSONSchemaProps) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue
+		}
+		name, opts := parseTag(f.Tag.Get("json"))
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && (name == "" || opts["inline"]) {
+			ft := f.Type
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft == typeMetaType {
+				res.Properties["apiVersion"] = &JSONSchemaProps{Type: "string"}
+				res.Properties["kind"] = &JSONSchemaProps{Type: "string"}
+				continue
+			}
+			if ft.Kind() == reflect.Struct {
+				w.fields(ft, res)
+				continue
+			}
+		}
+		if name == "" {
+			name = f.Name
+		}
+		p := w.schema(f.Type)
+		// The nil pointers, slices and maps were encoded as null unless they were omitted
+		if !opts["omitempty"] {
+			switch f.Type.Kind() {
+			case reflect.Ptr, reflect.Slice, reflect.Map:
+				p.Nullable = true
+			}
+		}
+		res.Properties[name] = &p
+	}
+}
+
+func parseTag(tag string) (string, map[string]bool) {
+	s := strings.Split(tag, ",")
+	opts := make(map[string]bool)
+	for _, v := range s[1:] {
+		opts[v] = true
+	}
+	return s[0], opts
+}
+
+func intOrString() []JSONSchemaProps {
+	return []JSONSchemaProps{{Type: "integer"}, {Type: "string"}}
+}
+
+// unknown returns the schema which keeps whatever it holds, typ might be empty
+func unknown(typ string) JSONSchemaProps {
+	preserve := true
+	return JSONSchemaProps{Type: typ, XPreserveUnknownFields: &preserve}
+}
+
+// lookup returns the schema at the dot separated path of the json fields below root, "*" stands for the items
+func lookup(root *JSONSchemaProps, path string) (*JSONSchemaProps, error) {
+	cur := root
+	if path == "" {
+		return cur, nil
+	}
+	for _, name := range strings.Split(path, ".") {
+		if name == "*" {
+			cur = cur.Items
+		} else {
+			cur = cur.Properties[name]
+		}
+		if cur == nil {
+			return nil, fmt.Errorf("no field %q in %s", name, path)
+		}
+	}
+	return cur, nil
+}
@@ -0,0 +1,139 @@
+package crd
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// The types below were the subset of apiextensions.k8s.io/v1 which was written by the generator,
+// the apiextensions-apiserver module was left out since it pulls in the whole apiserver.
+
+// CustomResourceDefinition is an apiextensions.k8s.io/v1 CustomResourceDefinition
+type CustomResourceDefinition struct {
+	APIVersion string                       `json:"apiVersion"`
+	Kind       string                       `json:"kind"`
+	Metadata   ObjectMeta                   `json:"metadata"`
+	Spec       CustomResourceDefinitionSpec `json:"spec"`
+}
+
+// ObjectMeta is the metadata of a CustomResourceDefinition
+type ObjectMeta struct {
+	Name            string `json:"name"`
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+}
+
+type CustomResourceDefinitionSpec struct {
+	Group      string                            `json:"group"`
+	Names      CustomResourceDefinitionNames     `json:"names"`
+	Scope      string                            `json:"scope"`
+	Versions   []CustomResourceDefinitionVersion `json:"versions"`
+	Conversion *CustomResourceConversion         `json:"conversion,omitempty"`
+}
+
+// CustomResourceConversion is the strategy of the conversion between the versions, None or Webhook
+type CustomResourceConversion struct {
+	Strategy string             `json:"strategy"`
+	Webhook  *WebhookConversion `json:"webhook,omitempty"`
+}
+
+type WebhookConversion struct {
+	ClientConfig             *WebhookClientConfig `json:"clientConfig,omitempty"`
+	ConversionReviewVersions []string             `json:"conversionReviewVersions"`
+}
+
+// WebhookClientConfig tells the apiserver how to reach the conversion webhook, either by URL or by Service
+type WebhookClientConfig struct {
+	URL      *string           `json:"url,omitempty"`
+	Service  *ServiceReference `json:"service,omitempty"`
+	CABundle []byte            `json:"caBundle,omitempty"`
+}
+
+type ServiceReference struct {
+	Namespace string  `json:"namespace"`
+	Name      string  `json:"name"`
+	Path      *string `json:"path,omitempty"`
+	Port      *int32  `json:"port,omitempty"`
+}
+
+type CustomResourceDefinitionNames struct {
+	Plural     string   `json:"plural"`
+	Singular   string   `json:"singular,omitempty"`
+	ShortNames []string `json:"shortNames,omitempty"`
+	Kind       string   `json:"kind"`
+	ListKind   string   `json:"listKind,omitempty"`
+}
+
+type CustomResourceDefinitionVersion struct {
+	Name                     string                      `json:"name"`
+	Served                   bool                        `json:"served"`
+	Storage                  bool                        `json:"storage"`
+	Schema                   *CustomResourceValidation   `json:"schema,omitempty"`
+	Subresources             *CustomResourceSubresources `json:"subresources,omitempty"`
+	AdditionalPrinterColumns []PrinterColumn             `json:"additionalPrinterColumns,omitempty"`
+}
+
+type CustomResourceValidation struct {
+	OpenAPIV3Schema *JSONSchemaProps `json:"openAPIV3Schema"`
+}
+
+type CustomResourceSubresources struct {
+	// Status is always the empty object, which enables the status subresource
+	Status *struct{}                       `json:"status,omitempty"`
+	Scale  *CustomResourceSubresourceScale `json:"scale,omitempty"`
+}
+
+type CustomResourceSubresourceScale struct {
+	SpecReplicasPath   string `json:"specReplicasPath"`
+	StatusReplicasPath string `json:"statusReplicasPath"`
+	LabelSelectorPath  string `json:"labelSelectorPath,omitempty"`
+}
+
+// PrinterColumn is a column of `kubectl get`, JSONPath was evaluated against each object
+type PrinterColumn struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Format      string `json:"format,omitempty"`
+	Description string `json:"description,omitempty"`
+	Priority    int32  `json:"priority,omitempty"`
+	JSONPath    string `json:"jsonPath"`
+}
+
+// JSONSchemaProps is a structural OpenAPI v3 schema
+type JSONSchemaProps struct {
+	Type                 string                      `json:"type,omitempty"`
+	Format               string                      `json:"format,omitempty"`
+	Description          string                      `json:"description,omitempty"`
+	Nullable             bool                        `json:"nullable,omitempty"`
+	Default              interface{}                 `json:"default,omitempty"`
+	Minimum              *float64                    `json:"minimum,omitempty"`
+	MinLength            *int64                      `json:"minLength,omitempty"`
+	Enum                 []interface{}               `json:"enum,omitempty"`
+	Required             []string                    `json:"required,omitempty"`
+	AnyOf                []JSONSchemaProps           `json:"anyOf,omitempty"`
+	Properties           map[string]*JSONSchemaProps `json:"properties,omitempty"`
+	Items                *JSONSchemaProps            `json:"items,omitempty"`
+	AdditionalProperties *JSONSchemaProps            `json:"additionalProperties,omitempty"`
+
+	XPreserveUnknownFields *bool `json:"x-kubernetes-preserve-unknown-fields,omitempty"`
+	XIntOrString           bool  `json:"x-kubernetes-int-or-string,omitempty"`
+}
+
+// ConversionReview is an apiextensions.k8s.io/v1 ConversionReview, which was sent to the conversion webhook
+type ConversionReview struct {
+	metav1.TypeMeta `json:",inline"`
+	Request         *ConversionRequest  `json:"request,omitempty"`
+	Response        *ConversionResponse `json:"response,omitempty"`
+}
+
+type ConversionRequest struct {
+	UID               types.UID              `json:"uid"`
+	DesiredAPIVersion string                 `json:"desiredAPIVersion"`
+	Objects           []runtime.RawExtension `json:"objects"`
+}
+
+type ConversionResponse struct {
+	UID              types.UID              `json:"uid"`
+	ConvertedObjects []runtime.RawExtension `json:"convertedObjects"`
+	Result           metav1.Status          `json:"result"`
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	nevercasev1 "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned/typed/mysqloperator/v1"
+	nevercasev2 "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned/typed/mysqloperator/v2"
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
@@ -30,6 +31,7 @@ import (
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
 	NevercaseV1() nevercasev1.NevercaseV1Interface
+	NevercaseV2() nevercasev2.NevercaseV2Interface
 }
 
 // Clientset contains the clients for groups. Each group has exactly one
@@ -37,6 +39,7 @@ type Interface interface {
 type Clientset struct {
 	*discovery.DiscoveryClient
 	nevercaseV1 *nevercasev1.NevercaseV1Client
+	nevercaseV2 *nevercasev2.NevercaseV2Client
 }
 
 // NevercaseV1 retrieves the NevercaseV1Client
@@ -44,6 +47,11 @@ func (c *Clientset) NevercaseV1() nevercasev1.NevercaseV1Interface {
 	return c.nevercaseV1
 }
 
+// NevercaseV2 retrieves the NevercaseV2Client
+func (c *Clientset) NevercaseV2() nevercasev2.NevercaseV2Interface {
+	return c.nevercaseV2
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -69,6 +77,10 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 	if err != nil {
 		return nil, err
 	}
+	cs.nevercaseV2, err = nevercasev2.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfig(&configShallowCopy)
 	if err != nil {
@@ -82,6 +94,7 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 func NewForConfigOrDie(c *rest.Config) *Clientset {
 	var cs Clientset
 	cs.nevercaseV1 = nevercasev1.NewForConfigOrDie(c)
+	cs.nevercaseV2 = nevercasev2.NewForConfigOrDie(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClientForConfigOrDie(c)
 	return &cs
@@ -91,6 +104,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.nevercaseV1 = nevercasev1.New(c)
+	cs.nevercaseV2 = nevercasev2.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
@@ -102,6 +102,18 @@ func (c *FakeMysqlOperators) Update(ctx context.Context, mysqlOperator *mysqlope
 	return obj.(*mysqloperatorv1.MysqlOperator), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeMysqlOperators) UpdateStatus(ctx context.Context, mysqlOperator *mysqloperatorv1.MysqlOperator, opts v1.UpdateOptions) (*mysqloperatorv1.MysqlOperator, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(mysqloperatorsResource, "status", c.ns, mysqlOperator), &mysqloperatorv1.MysqlOperator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*mysqloperatorv1.MysqlOperator), err
+}
+
 // Delete takes name of the mysqlOperator and deletes it. Returns an error if one occurs.
 func (c *FakeMysqlOperators) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
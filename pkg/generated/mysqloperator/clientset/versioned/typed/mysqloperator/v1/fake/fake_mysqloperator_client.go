@@ -28,10 +28,18 @@ type FakeNevercaseV1 struct {
 	*testing.Fake
 }
 
+func (c *FakeNevercaseV1) MysqlDatabases(namespace string) v1.MysqlDatabaseInterface {
+	return &FakeMysqlDatabases{c, namespace}
+}
+
 func (c *FakeNevercaseV1) MysqlOperators(namespace string) v1.MysqlOperatorInterface {
 	return &FakeMysqlOperators{c, namespace}
 }
 
+func (c *FakeNevercaseV1) MysqlUsers(namespace string) v1.MysqlUserInterface {
+	return &FakeMysqlUsers{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeNevercaseV1) RESTClient() rest.Interface {
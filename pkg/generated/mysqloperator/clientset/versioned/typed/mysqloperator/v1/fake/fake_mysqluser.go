@@ -0,0 +1,142 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	mysqloperatorv1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeMysqlUsers implements MysqlUserInterface
+type FakeMysqlUsers struct {
+	Fake *FakeNevercaseV1
+	ns   string
+}
+
+var mysqlusersResource = schema.GroupVersionResource{Group: "nevercase.io", Version: "v1", Resource: "mysqlusers"}
+
+var mysqlusersKind = schema.GroupVersionKind{Group: "nevercase.io", Version: "v1", Kind: "MysqlUser"}
+
+// Get takes name of the mysqlUser, and returns the corresponding mysqlUser object, and an error if there is any.
+func (c *FakeMysqlUsers) Get(ctx context.Context, name string, options v1.GetOptions) (result *mysqloperatorv1.MysqlUser, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(mysqlusersResource, c.ns, name), &mysqloperatorv1.MysqlUser{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*mysqloperatorv1.MysqlUser), err
+}
+
+// List takes label and field selectors, and returns the list of MysqlUsers that match those selectors.
+func (c *FakeMysqlUsers) List(ctx context.Context, opts v1.ListOptions) (result *mysqloperatorv1.MysqlUserList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(mysqlusersResource, mysqlusersKind, c.ns, opts), &mysqloperatorv1.MysqlUserList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &mysqloperatorv1.MysqlUserList{ListMeta: obj.(*mysqloperatorv1.MysqlUserList).ListMeta}
+	for _, item := range obj.(*mysqloperatorv1.MysqlUserList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested mysqlUsers.
+func (c *FakeMysqlUsers) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(mysqlusersResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a mysqlUser and creates it.  Returns the server's representation of the mysqlUser, and an error, if there is any.
+func (c *FakeMysqlUsers) Create(ctx context.Context, mysqlUser *mysqloperatorv1.MysqlUser, opts v1.CreateOptions) (result *mysqloperatorv1.MysqlUser, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(mysqlusersResource, c.ns, mysqlUser), &mysqloperatorv1.MysqlUser{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*mysqloperatorv1.MysqlUser), err
+}
+
+// Update takes the representation of a mysqlUser and updates it. Returns the server's representation of the mysqlUser, and an error, if there is any.
+func (c *FakeMysqlUsers) Update(ctx context.Context, mysqlUser *mysqloperatorv1.MysqlUser, opts v1.UpdateOptions) (result *mysqloperatorv1.MysqlUser, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(mysqlusersResource, c.ns, mysqlUser), &mysqloperatorv1.MysqlUser{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*mysqloperatorv1.MysqlUser), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeMysqlUsers) UpdateStatus(ctx context.Context, mysqlUser *mysqloperatorv1.MysqlUser, opts v1.UpdateOptions) (*mysqloperatorv1.MysqlUser, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(mysqlusersResource, "status", c.ns, mysqlUser), &mysqloperatorv1.MysqlUser{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*mysqloperatorv1.MysqlUser), err
+}
+
+// Delete takes name of the mysqlUser and deletes it. Returns an error if one occurs.
+func (c *FakeMysqlUsers) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(mysqlusersResource, c.ns, name), &mysqloperatorv1.MysqlUser{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeMysqlUsers) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(mysqlusersResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &mysqloperatorv1.MysqlUserList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched mysqlUser.
+func (c *FakeMysqlUsers) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *mysqloperatorv1.MysqlUser, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(mysqlusersResource, c.ns, name, pt, data, subresources...), &mysqloperatorv1.MysqlUser{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*mysqloperatorv1.MysqlUser), err
+}
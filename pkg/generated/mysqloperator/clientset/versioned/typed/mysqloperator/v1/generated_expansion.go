@@ -18,4 +18,8 @@ limitations under the License.
 
 package v1
 
+type MysqlDatabaseExpansion interface{}
+
 type MysqlOperatorExpansion interface{}
+
+type MysqlUserExpansion interface{}
@@ -40,6 +40,7 @@ type MysqlOperatorsGetter interface {
 type MysqlOperatorInterface interface {
 	Create(ctx context.Context, mysqlOperator *v1.MysqlOperator, opts metav1.CreateOptions) (*v1.MysqlOperator, error)
 	Update(ctx context.Context, mysqlOperator *v1.MysqlOperator, opts metav1.UpdateOptions) (*v1.MysqlOperator, error)
+	UpdateStatus(ctx context.Context, mysqlOperator *v1.MysqlOperator, opts metav1.UpdateOptions) (*v1.MysqlOperator, error)
 	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
 	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.MysqlOperator, error)
@@ -135,6 +136,22 @@ func (c *mysqlOperators) Update(ctx context.Context, mysqlOperator *v1.MysqlOper
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *mysqlOperators) UpdateStatus(ctx context.Context, mysqlOperator *v1.MysqlOperator, opts metav1.UpdateOptions) (result *v1.MysqlOperator, err error) {
+	result = &v1.MysqlOperator{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("mysqloperators").
+		Name(mysqlOperator.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(mysqlOperator).
+		Do(ctx).
+		Into(result)
+	return
+}
+
 // Delete takes name of the mysqlOperator and deletes it. Returns an error if one occurs.
 func (c *mysqlOperators) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
 	return c.client.Delete().
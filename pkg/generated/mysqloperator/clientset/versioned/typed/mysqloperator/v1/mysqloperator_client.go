@@ -26,7 +26,9 @@ import (
 
 type NevercaseV1Interface interface {
 	RESTClient() rest.Interface
+	MysqlDatabasesGetter
 	MysqlOperatorsGetter
+	MysqlUsersGetter
 }
 
 // NevercaseV1Client is used to interact with features provided by the nevercase.io group.
@@ -34,10 +36,18 @@ type NevercaseV1Client struct {
 	restClient rest.Interface
 }
 
+func (c *NevercaseV1Client) MysqlDatabases(namespace string) MysqlDatabaseInterface {
+	return newMysqlDatabases(c, namespace)
+}
+
 func (c *NevercaseV1Client) MysqlOperators(namespace string) MysqlOperatorInterface {
 	return newMysqlOperators(c, namespace)
 }
 
+func (c *NevercaseV1Client) MysqlUsers(namespace string) MysqlUserInterface {
+	return newMysqlUsers(c, namespace)
+}
+
 // NewForConfig creates a new NevercaseV1Client for the given config.
 func NewForConfig(c *rest.Config) (*NevercaseV1Client, error) {
 	config := *c
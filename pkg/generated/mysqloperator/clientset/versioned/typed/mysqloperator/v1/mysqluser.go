@@ -0,0 +1,195 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+	scheme "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// MysqlUsersGetter has a method to return a MysqlUserInterface.
+// A group's client should implement this interface.
+type MysqlUsersGetter interface {
+	MysqlUsers(namespace string) MysqlUserInterface
+}
+
+// MysqlUserInterface has methods to work with MysqlUser resources.
+type MysqlUserInterface interface {
+	Create(ctx context.Context, mysqlUser *v1.MysqlUser, opts metav1.CreateOptions) (*v1.MysqlUser, error)
+	Update(ctx context.Context, mysqlUser *v1.MysqlUser, opts metav1.UpdateOptions) (*v1.MysqlUser, error)
+	UpdateStatus(ctx context.Context, mysqlUser *v1.MysqlUser, opts metav1.UpdateOptions) (*v1.MysqlUser, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.MysqlUser, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.MysqlUserList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.MysqlUser, err error)
+	MysqlUserExpansion
+}
+
+// mysqlUsers implements MysqlUserInterface
+type mysqlUsers struct {
+	client rest.Interface
+	ns     string
+}
+
+// newMysqlUsers returns a MysqlUsers
+func newMysqlUsers(c *NevercaseV1Client, namespace string) *mysqlUsers {
+	return &mysqlUsers{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the mysqlUser, and returns the corresponding mysqlUser object, and an error if there is any.
+func (c *mysqlUsers) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.MysqlUser, err error) {
+	result = &v1.MysqlUser{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("mysqlusers").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of MysqlUsers that match those selectors.
+func (c *mysqlUsers) List(ctx context.Context, opts metav1.ListOptions) (result *v1.MysqlUserList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1.MysqlUserList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("mysqlusers").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested mysqlUsers.
+func (c *mysqlUsers) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("mysqlusers").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a mysqlUser and creates it.  Returns the server's representation of the mysqlUser, and an error, if there is any.
+func (c *mysqlUsers) Create(ctx context.Context, mysqlUser *v1.MysqlUser, opts metav1.CreateOptions) (result *v1.MysqlUser, err error) {
+	result = &v1.MysqlUser{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("mysqlusers").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(mysqlUser).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a mysqlUser and updates it. Returns the server's representation of the mysqlUser, and an error, if there is any.
+func (c *mysqlUsers) Update(ctx context.Context, mysqlUser *v1.MysqlUser, opts metav1.UpdateOptions) (result *v1.MysqlUser, err error) {
+	result = &v1.MysqlUser{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("mysqlusers").
+		Name(mysqlUser.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(mysqlUser).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *mysqlUsers) UpdateStatus(ctx context.Context, mysqlUser *v1.MysqlUser, opts metav1.UpdateOptions) (result *v1.MysqlUser, err error) {
+	result = &v1.MysqlUser{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("mysqlusers").
+		Name(mysqlUser.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(mysqlUser).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the mysqlUser and deletes it. Returns an error if one occurs.
+func (c *mysqlUsers) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("mysqlusers").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *mysqlUsers) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("mysqlusers").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched mysqlUser.
+func (c *mysqlUsers) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.MysqlUser, err error) {
+	result = &v1.MysqlUser{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("mysqlusers").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,195 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	"context"
+	"time"
+
+	v2 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v2"
+	scheme "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// MysqlOperatorsGetter has a method to return a MysqlOperatorInterface.
+// A group's client should implement this interface.
+type MysqlOperatorsGetter interface {
+	MysqlOperators(namespace string) MysqlOperatorInterface
+}
+
+// MysqlOperatorInterface has methods to work with MysqlOperator resources.
+type MysqlOperatorInterface interface {
+	Create(ctx context.Context, mysqlOperator *v2.MysqlOperator, opts v1.CreateOptions) (*v2.MysqlOperator, error)
+	Update(ctx context.Context, mysqlOperator *v2.MysqlOperator, opts v1.UpdateOptions) (*v2.MysqlOperator, error)
+	UpdateStatus(ctx context.Context, mysqlOperator *v2.MysqlOperator, opts v1.UpdateOptions) (*v2.MysqlOperator, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v2.MysqlOperator, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v2.MysqlOperatorList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v2.MysqlOperator, err error)
+	MysqlOperatorExpansion
+}
+
+// mysqlOperators implements MysqlOperatorInterface
+type mysqlOperators struct {
+	client rest.Interface
+	ns     string
+}
+
+// newMysqlOperators returns a MysqlOperators
+func newMysqlOperators(c *NevercaseV2Client, namespace string) *mysqlOperators {
+	return &mysqlOperators{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the mysqlOperator, and returns the corresponding mysqlOperator object, and an error if there is any.
+func (c *mysqlOperators) Get(ctx context.Context, name string, options v1.GetOptions) (result *v2.MysqlOperator, err error) {
+	result = &v2.MysqlOperator{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("mysqloperators").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of MysqlOperators that match those selectors.
+func (c *mysqlOperators) List(ctx context.Context, opts v1.ListOptions) (result *v2.MysqlOperatorList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v2.MysqlOperatorList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("mysqloperators").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested mysqlOperators.
+func (c *mysqlOperators) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("mysqloperators").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a mysqlOperator and creates it.  Returns the server's representation of the mysqlOperator, and an error, if there is any.
+func (c *mysqlOperators) Create(ctx context.Context, mysqlOperator *v2.MysqlOperator, opts v1.CreateOptions) (result *v2.MysqlOperator, err error) {
+	result = &v2.MysqlOperator{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("mysqloperators").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(mysqlOperator).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a mysqlOperator and updates it. Returns the server's representation of the mysqlOperator, and an error, if there is any.
+func (c *mysqlOperators) Update(ctx context.Context, mysqlOperator *v2.MysqlOperator, opts v1.UpdateOptions) (result *v2.MysqlOperator, err error) {
+	result = &v2.MysqlOperator{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("mysqloperators").
+		Name(mysqlOperator.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(mysqlOperator).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *mysqlOperators) UpdateStatus(ctx context.Context, mysqlOperator *v2.MysqlOperator, opts v1.UpdateOptions) (result *v2.MysqlOperator, err error) {
+	result = &v2.MysqlOperator{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("mysqloperators").
+		Name(mysqlOperator.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(mysqlOperator).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the mysqlOperator and deletes it. Returns an error if one occurs.
+func (c *mysqlOperators) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("mysqloperators").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *mysqlOperators) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("mysqloperators").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched mysqlOperator.
+func (c *mysqlOperators) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v2.MysqlOperator, err error) {
+	result = &v2.MysqlOperator{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("mysqloperators").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
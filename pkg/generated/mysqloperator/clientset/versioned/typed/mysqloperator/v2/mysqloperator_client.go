@@ -0,0 +1,89 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	v2 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v2"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned/scheme"
+	rest "k8s.io/client-go/rest"
+)
+
+type NevercaseV2Interface interface {
+	RESTClient() rest.Interface
+	MysqlOperatorsGetter
+}
+
+// NevercaseV2Client is used to interact with features provided by the nevercase.io group.
+type NevercaseV2Client struct {
+	restClient rest.Interface
+}
+
+func (c *NevercaseV2Client) MysqlOperators(namespace string) MysqlOperatorInterface {
+	return newMysqlOperators(c, namespace)
+}
+
+// NewForConfig creates a new NevercaseV2Client for the given config.
+func NewForConfig(c *rest.Config) (*NevercaseV2Client, error) {
+	config := *c
+	if err := setConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	client, err := rest.RESTClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return &NevercaseV2Client{client}, nil
+}
+
+// NewForConfigOrDie creates a new NevercaseV2Client for the given config and
+// panics if there is an error in the config.
+func NewForConfigOrDie(c *rest.Config) *NevercaseV2Client {
+	client, err := NewForConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// New creates a new NevercaseV2Client for the given RESTClient.
+func New(c rest.Interface) *NevercaseV2Client {
+	return &NevercaseV2Client{c}
+}
+
+func setConfigDefaults(config *rest.Config) error {
+	gv := v2.SchemeGroupVersion
+	config.GroupVersion = &gv
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+
+	return nil
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *NevercaseV2Client) RESTClient() rest.Interface {
+	if c == nil {
+		return nil
+	}
+	return c.restClient
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	v1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+	v2 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v2"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -53,8 +54,16 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=nevercase.io, Version=v1
+	case v1.SchemeGroupVersion.WithResource("mysqldatabases"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Nevercase().V1().MysqlDatabases().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("mysqloperators"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Nevercase().V1().MysqlOperators().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("mysqlusers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Nevercase().V1().MysqlUsers().Informer()}, nil
+
+		// Group=nevercase.io, Version=v2
+	case v2.SchemeGroupVersion.WithResource("mysqloperators"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Nevercase().V2().MysqlOperators().Informer()}, nil
 
 	}
 
@@ -24,8 +24,12 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// MysqlDatabases returns a MysqlDatabaseInformer.
+	MysqlDatabases() MysqlDatabaseInformer
 	// MysqlOperators returns a MysqlOperatorInformer.
 	MysqlOperators() MysqlOperatorInformer
+	// MysqlUsers returns a MysqlUserInformer.
+	MysqlUsers() MysqlUserInformer
 }
 
 type version struct {
@@ -39,7 +43,17 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// MysqlDatabases returns a MysqlDatabaseInformer.
+func (v *version) MysqlDatabases() MysqlDatabaseInformer {
+	return &mysqlDatabaseInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // MysqlOperators returns a MysqlOperatorInformer.
 func (v *version) MysqlOperators() MysqlOperatorInformer {
 	return &mysqlOperatorInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// MysqlUsers returns a MysqlUserInformer.
+func (v *version) MysqlUsers() MysqlUserInformer {
+	return &mysqlUserInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
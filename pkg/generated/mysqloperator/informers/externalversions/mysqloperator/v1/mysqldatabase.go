@@ -0,0 +1,90 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	time "time"
+
+	mysqloperatorv1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+	versioned "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned"
+	internalinterfaces "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/informers/externalversions/internalinterfaces"
+	v1 "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/listers/mysqloperator/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// MysqlDatabaseInformer provides access to a shared informer and lister for
+// MysqlDatabases.
+type MysqlDatabaseInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1.MysqlDatabaseLister
+}
+
+type mysqlDatabaseInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewMysqlDatabaseInformer constructs a new informer for MysqlDatabase type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewMysqlDatabaseInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredMysqlDatabaseInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredMysqlDatabaseInformer constructs a new informer for MysqlDatabase type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredMysqlDatabaseInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NevercaseV1().MysqlDatabases(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NevercaseV1().MysqlDatabases(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&mysqloperatorv1.MysqlDatabase{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *mysqlDatabaseInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredMysqlDatabaseInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *mysqlDatabaseInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&mysqloperatorv1.MysqlDatabase{}, f.defaultInformer)
+}
+
+func (f *mysqlDatabaseInformer) Lister() v1.MysqlDatabaseLister {
+	return v1.NewMysqlDatabaseLister(f.Informer().GetIndexer())
+}
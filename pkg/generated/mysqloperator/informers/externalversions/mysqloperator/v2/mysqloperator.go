@@ -0,0 +1,90 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	"context"
+	time "time"
+
+	mysqloperatorv2 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v2"
+	versioned "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/clientset/versioned"
+	internalinterfaces "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/informers/externalversions/internalinterfaces"
+	v2 "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/mysqloperator/listers/mysqloperator/v2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// MysqlOperatorInformer provides access to a shared informer and lister for
+// MysqlOperators.
+type MysqlOperatorInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v2.MysqlOperatorLister
+}
+
+type mysqlOperatorInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewMysqlOperatorInformer constructs a new informer for MysqlOperator type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewMysqlOperatorInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredMysqlOperatorInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredMysqlOperatorInformer constructs a new informer for MysqlOperator type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredMysqlOperatorInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NevercaseV2().MysqlOperators(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NevercaseV2().MysqlOperators(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&mysqloperatorv2.MysqlOperator{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *mysqlOperatorInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredMysqlOperatorInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *mysqlOperatorInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&mysqloperatorv2.MysqlOperator{}, f.defaultInformer)
+}
+
+func (f *mysqlOperatorInformer) Lister() v2.MysqlOperatorLister {
+	return v2.NewMysqlOperatorLister(f.Informer().GetIndexer())
+}
@@ -18,6 +18,14 @@ limitations under the License.
 
 package v1
 
+// MysqlDatabaseListerExpansion allows custom methods to be added to
+// MysqlDatabaseLister.
+type MysqlDatabaseListerExpansion interface{}
+
+// MysqlDatabaseNamespaceListerExpansion allows custom methods to be added to
+// MysqlDatabaseNamespaceLister.
+type MysqlDatabaseNamespaceListerExpansion interface{}
+
 // MysqlOperatorListerExpansion allows custom methods to be added to
 // MysqlOperatorLister.
 type MysqlOperatorListerExpansion interface{}
@@ -25,3 +33,11 @@ type MysqlOperatorListerExpansion interface{}
 // MysqlOperatorNamespaceListerExpansion allows custom methods to be added to
 // MysqlOperatorNamespaceLister.
 type MysqlOperatorNamespaceListerExpansion interface{}
+
+// MysqlUserListerExpansion allows custom methods to be added to
+// MysqlUserLister.
+type MysqlUserListerExpansion interface{}
+
+// MysqlUserNamespaceListerExpansion allows custom methods to be added to
+// MysqlUserNamespaceLister.
+type MysqlUserNamespaceListerExpansion interface{}
@@ -0,0 +1,99 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/mysqloperator/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// MysqlUserLister helps list MysqlUsers.
+// All objects returned here must be treated as read-only.
+type MysqlUserLister interface {
+	// List lists all MysqlUsers in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.MysqlUser, err error)
+	// MysqlUsers returns an object that can list and get MysqlUsers.
+	MysqlUsers(namespace string) MysqlUserNamespaceLister
+	MysqlUserListerExpansion
+}
+
+// mysqlUserLister implements the MysqlUserLister interface.
+type mysqlUserLister struct {
+	indexer cache.Indexer
+}
+
+// NewMysqlUserLister returns a new MysqlUserLister.
+func NewMysqlUserLister(indexer cache.Indexer) MysqlUserLister {
+	return &mysqlUserLister{indexer: indexer}
+}
+
+// List lists all MysqlUsers in the indexer.
+func (s *mysqlUserLister) List(selector labels.Selector) (ret []*v1.MysqlUser, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.MysqlUser))
+	})
+	return ret, err
+}
+
+// MysqlUsers returns an object that can list and get MysqlUsers.
+func (s *mysqlUserLister) MysqlUsers(namespace string) MysqlUserNamespaceLister {
+	return mysqlUserNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// MysqlUserNamespaceLister helps list and get MysqlUsers.
+// All objects returned here must be treated as read-only.
+type MysqlUserNamespaceLister interface {
+	// List lists all MysqlUsers in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.MysqlUser, err error)
+	// Get retrieves the MysqlUser from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1.MysqlUser, error)
+	MysqlUserNamespaceListerExpansion
+}
+
+// mysqlUserNamespaceLister implements the MysqlUserNamespaceLister
+// interface.
+type mysqlUserNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all MysqlUsers in the indexer for a given namespace.
+func (s mysqlUserNamespaceLister) List(selector labels.Selector) (ret []*v1.MysqlUser, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.MysqlUser))
+	})
+	return ret, err
+}
+
+// Get retrieves the MysqlUser from the indexer for a given namespace and name.
+func (s mysqlUserNamespaceLister) Get(name string) (*v1.MysqlUser, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("mysqluser"), name)
+	}
+	return obj.(*v1.MysqlUser), nil
+}
@@ -102,6 +102,18 @@ func (c *FakeRedisOperators) Update(ctx context.Context, redisOperator *redisope
 	return obj.(*redisoperatorv1.RedisOperator), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeRedisOperators) UpdateStatus(ctx context.Context, redisOperator *redisoperatorv1.RedisOperator, opts v1.UpdateOptions) (*redisoperatorv1.RedisOperator, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(redisoperatorsResource, "status", c.ns, redisOperator), &redisoperatorv1.RedisOperator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*redisoperatorv1.RedisOperator), err
+}
+
 // Delete takes name of the redisOperator and deletes it. Returns an error if one occurs.
 func (c *FakeRedisOperators) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
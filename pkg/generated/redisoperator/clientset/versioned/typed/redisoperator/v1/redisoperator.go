@@ -40,6 +40,7 @@ type RedisOperatorsGetter interface {
 type RedisOperatorInterface interface {
 	Create(ctx context.Context, redisOperator *v1.RedisOperator, opts metav1.CreateOptions) (*v1.RedisOperator, error)
 	Update(ctx context.Context, redisOperator *v1.RedisOperator, opts metav1.UpdateOptions) (*v1.RedisOperator, error)
+	UpdateStatus(ctx context.Context, redisOperator *v1.RedisOperator, opts metav1.UpdateOptions) (*v1.RedisOperator, error)
 	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
 	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.RedisOperator, error)
@@ -135,6 +136,22 @@ func (c *redisOperators) Update(ctx context.Context, redisOperator *v1.RedisOper
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *redisOperators) UpdateStatus(ctx context.Context, redisOperator *v1.RedisOperator, opts metav1.UpdateOptions) (result *v1.RedisOperator, err error) {
+	result = &v1.RedisOperator{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("redisoperators").
+		Name(redisOperator.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(redisOperator).
+		Do(ctx).
+		Into(result)
+	return
+}
+
 // Delete takes name of the redisOperator and deletes it. Returns an error if one occurs.
 func (c *redisOperators) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
 	return c.client.Delete().
@@ -0,0 +1,142 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v2 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/redisoperator/v2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeRedisOperators implements RedisOperatorInterface
+type FakeRedisOperators struct {
+	Fake *FakeNevercaseV2
+	ns   string
+}
+
+var redisoperatorsResource = schema.GroupVersionResource{Group: "nevercase.io", Version: "v2", Resource: "redisoperators"}
+
+var redisoperatorsKind = schema.GroupVersionKind{Group: "nevercase.io", Version: "v2", Kind: "RedisOperator"}
+
+// Get takes name of the redisOperator, and returns the corresponding redisOperator object, and an error if there is any.
+func (c *FakeRedisOperators) Get(ctx context.Context, name string, options v1.GetOptions) (result *v2.RedisOperator, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(redisoperatorsResource, c.ns, name), &v2.RedisOperator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.RedisOperator), err
+}
+
+// List takes label and field selectors, and returns the list of RedisOperators that match those selectors.
+func (c *FakeRedisOperators) List(ctx context.Context, opts v1.ListOptions) (result *v2.RedisOperatorList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(redisoperatorsResource, redisoperatorsKind, c.ns, opts), &v2.RedisOperatorList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v2.RedisOperatorList{ListMeta: obj.(*v2.RedisOperatorList).ListMeta}
+	for _, item := range obj.(*v2.RedisOperatorList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested redisOperators.
+func (c *FakeRedisOperators) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(redisoperatorsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a redisOperator and creates it.  Returns the server's representation of the redisOperator, and an error, if there is any.
+func (c *FakeRedisOperators) Create(ctx context.Context, redisOperator *v2.RedisOperator, opts v1.CreateOptions) (result *v2.RedisOperator, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(redisoperatorsResource, c.ns, redisOperator), &v2.RedisOperator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.RedisOperator), err
+}
+
+// Update takes the representation of a redisOperator and updates it. Returns the server's representation of the redisOperator, and an error, if there is any.
+func (c *FakeRedisOperators) Update(ctx context.Context, redisOperator *v2.RedisOperator, opts v1.UpdateOptions) (result *v2.RedisOperator, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(redisoperatorsResource, c.ns, redisOperator), &v2.RedisOperator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.RedisOperator), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeRedisOperators) UpdateStatus(ctx context.Context, redisOperator *v2.RedisOperator, opts v1.UpdateOptions) (*v2.RedisOperator, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(redisoperatorsResource, "status", c.ns, redisOperator), &v2.RedisOperator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.RedisOperator), err
+}
+
+// Delete takes name of the redisOperator and deletes it. Returns an error if one occurs.
+func (c *FakeRedisOperators) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(redisoperatorsResource, c.ns, name), &v2.RedisOperator{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeRedisOperators) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(redisoperatorsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v2.RedisOperatorList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched redisOperator.
+func (c *FakeRedisOperators) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v2.RedisOperator, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(redisoperatorsResource, c.ns, name, pt, data, subresources...), &v2.RedisOperator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.RedisOperator), err
+}
@@ -0,0 +1,195 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	"context"
+	"time"
+
+	v2 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/redisoperator/v2"
+	scheme "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/redisoperator/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// RedisOperatorsGetter has a method to return a RedisOperatorInterface.
+// A group's client should implement this interface.
+type RedisOperatorsGetter interface {
+	RedisOperators(namespace string) RedisOperatorInterface
+}
+
+// RedisOperatorInterface has methods to work with RedisOperator resources.
+type RedisOperatorInterface interface {
+	Create(ctx context.Context, redisOperator *v2.RedisOperator, opts v1.CreateOptions) (*v2.RedisOperator, error)
+	Update(ctx context.Context, redisOperator *v2.RedisOperator, opts v1.UpdateOptions) (*v2.RedisOperator, error)
+	UpdateStatus(ctx context.Context, redisOperator *v2.RedisOperator, opts v1.UpdateOptions) (*v2.RedisOperator, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v2.RedisOperator, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v2.RedisOperatorList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v2.RedisOperator, err error)
+	RedisOperatorExpansion
+}
+
+// redisOperators implements RedisOperatorInterface
+type redisOperators struct {
+	client rest.Interface
+	ns     string
+}
+
+// newRedisOperators returns a RedisOperators
+func newRedisOperators(c *NevercaseV2Client, namespace string) *redisOperators {
+	return &redisOperators{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the redisOperator, and returns the corresponding redisOperator object, and an error if there is any.
+func (c *redisOperators) Get(ctx context.Context, name string, options v1.GetOptions) (result *v2.RedisOperator, err error) {
+	result = &v2.RedisOperator{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("redisoperators").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of RedisOperators that match those selectors.
+func (c *redisOperators) List(ctx context.Context, opts v1.ListOptions) (result *v2.RedisOperatorList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v2.RedisOperatorList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("redisoperators").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested redisOperators.
+func (c *redisOperators) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("redisoperators").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a redisOperator and creates it.  Returns the server's representation of the redisOperator, and an error, if there is any.
+func (c *redisOperators) Create(ctx context.Context, redisOperator *v2.RedisOperator, opts v1.CreateOptions) (result *v2.RedisOperator, err error) {
+	result = &v2.RedisOperator{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("redisoperators").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(redisOperator).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a redisOperator and updates it. Returns the server's representation of the redisOperator, and an error, if there is any.
+func (c *redisOperators) Update(ctx context.Context, redisOperator *v2.RedisOperator, opts v1.UpdateOptions) (result *v2.RedisOperator, err error) {
+	result = &v2.RedisOperator{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("redisoperators").
+		Name(redisOperator.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(redisOperator).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *redisOperators) UpdateStatus(ctx context.Context, redisOperator *v2.RedisOperator, opts v1.UpdateOptions) (result *v2.RedisOperator, err error) {
+	result = &v2.RedisOperator{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("redisoperators").
+		Name(redisOperator.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(redisOperator).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the redisOperator and deletes it. Returns an error if one occurs.
+func (c *redisOperators) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("redisoperators").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *redisOperators) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("redisoperators").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched redisOperator.
+func (c *redisOperators) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v2.RedisOperator, err error) {
+	result = &v2.RedisOperator{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("redisoperators").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	v1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/redisoperator/v1"
+	v2 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/redisoperator/v2"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -56,6 +57,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case v1.SchemeGroupVersion.WithResource("redisoperators"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Nevercase().V1().RedisOperators().Informer()}, nil
 
+		// Group=nevercase.io, Version=v2
+	case v2.SchemeGroupVersion.WithResource("redisoperators"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Nevercase().V2().RedisOperators().Informer()}, nil
+
 	}
 
 	return nil, fmt.Errorf("no informer found for %v", resource)
@@ -0,0 +1,142 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	webappoperatorv1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/webappoperator/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeWebAppOperators implements WebAppOperatorInterface
+type FakeWebAppOperators struct {
+	Fake *FakeNevercaseV1
+	ns   string
+}
+
+var webappoperatorsResource = schema.GroupVersionResource{Group: "nevercase.io", Version: "v1", Resource: "webappoperators"}
+
+var webappoperatorsKind = schema.GroupVersionKind{Group: "nevercase.io", Version: "v1", Kind: "WebAppOperator"}
+
+// Get takes name of the webAppOperator, and returns the corresponding webAppOperator object, and an error if there is any.
+func (c *FakeWebAppOperators) Get(ctx context.Context, name string, options v1.GetOptions) (result *webappoperatorv1.WebAppOperator, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(webappoperatorsResource, c.ns, name), &webappoperatorv1.WebAppOperator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*webappoperatorv1.WebAppOperator), err
+}
+
+// List takes label and field selectors, and returns the list of WebAppOperators that match those selectors.
+func (c *FakeWebAppOperators) List(ctx context.Context, opts v1.ListOptions) (result *webappoperatorv1.WebAppOperatorList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(webappoperatorsResource, webappoperatorsKind, c.ns, opts), &webappoperatorv1.WebAppOperatorList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &webappoperatorv1.WebAppOperatorList{ListMeta: obj.(*webappoperatorv1.WebAppOperatorList).ListMeta}
+	for _, item := range obj.(*webappoperatorv1.WebAppOperatorList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested webAppOperators.
+func (c *FakeWebAppOperators) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(webappoperatorsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a webAppOperator and creates it.  Returns the server's representation of the webAppOperator, and an error, if there is any.
+func (c *FakeWebAppOperators) Create(ctx context.Context, webAppOperator *webappoperatorv1.WebAppOperator, opts v1.CreateOptions) (result *webappoperatorv1.WebAppOperator, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(webappoperatorsResource, c.ns, webAppOperator), &webappoperatorv1.WebAppOperator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*webappoperatorv1.WebAppOperator), err
+}
+
+// Update takes the representation of a webAppOperator and updates it. Returns the server's representation of the webAppOperator, and an error, if there is any.
+func (c *FakeWebAppOperators) Update(ctx context.Context, webAppOperator *webappoperatorv1.WebAppOperator, opts v1.UpdateOptions) (result *webappoperatorv1.WebAppOperator, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(webappoperatorsResource, c.ns, webAppOperator), &webappoperatorv1.WebAppOperator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*webappoperatorv1.WebAppOperator), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeWebAppOperators) UpdateStatus(ctx context.Context, webAppOperator *webappoperatorv1.WebAppOperator, opts v1.UpdateOptions) (*webappoperatorv1.WebAppOperator, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(webappoperatorsResource, "status", c.ns, webAppOperator), &webappoperatorv1.WebAppOperator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*webappoperatorv1.WebAppOperator), err
+}
+
+// Delete takes name of the webAppOperator and deletes it. Returns an error if one occurs.
+func (c *FakeWebAppOperators) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(webappoperatorsResource, c.ns, name), &webappoperatorv1.WebAppOperator{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeWebAppOperators) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(webappoperatorsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &webappoperatorv1.WebAppOperatorList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched webAppOperator.
+func (c *FakeWebAppOperators) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *webappoperatorv1.WebAppOperator, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(webappoperatorsResource, c.ns, name, pt, data, subresources...), &webappoperatorv1.WebAppOperator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*webappoperatorv1.WebAppOperator), err
+}
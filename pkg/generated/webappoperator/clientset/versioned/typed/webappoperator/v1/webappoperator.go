@@ -0,0 +1,195 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/webappoperator/v1"
+	scheme "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/webappoperator/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// WebAppOperatorsGetter has a method to return a WebAppOperatorInterface.
+// A group's client should implement this interface.
+type WebAppOperatorsGetter interface {
+	WebAppOperators(namespace string) WebAppOperatorInterface
+}
+
+// WebAppOperatorInterface has methods to work with WebAppOperator resources.
+type WebAppOperatorInterface interface {
+	Create(ctx context.Context, webAppOperator *v1.WebAppOperator, opts metav1.CreateOptions) (*v1.WebAppOperator, error)
+	Update(ctx context.Context, webAppOperator *v1.WebAppOperator, opts metav1.UpdateOptions) (*v1.WebAppOperator, error)
+	UpdateStatus(ctx context.Context, webAppOperator *v1.WebAppOperator, opts metav1.UpdateOptions) (*v1.WebAppOperator, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.WebAppOperator, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.WebAppOperatorList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.WebAppOperator, err error)
+	WebAppOperatorExpansion
+}
+
+// webAppOperators implements WebAppOperatorInterface
+type webAppOperators struct {
+	client rest.Interface
+	ns     string
+}
+
+// newWebAppOperators returns a WebAppOperators
+func newWebAppOperators(c *NevercaseV1Client, namespace string) *webAppOperators {
+	return &webAppOperators{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the webAppOperator, and returns the corresponding webAppOperator object, and an error if there is any.
+func (c *webAppOperators) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.WebAppOperator, err error) {
+	result = &v1.WebAppOperator{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("webappoperators").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of WebAppOperators that match those selectors.
+func (c *webAppOperators) List(ctx context.Context, opts metav1.ListOptions) (result *v1.WebAppOperatorList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1.WebAppOperatorList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("webappoperators").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested webAppOperators.
+func (c *webAppOperators) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("webappoperators").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a webAppOperator and creates it.  Returns the server's representation of the webAppOperator, and an error, if there is any.
+func (c *webAppOperators) Create(ctx context.Context, webAppOperator *v1.WebAppOperator, opts metav1.CreateOptions) (result *v1.WebAppOperator, err error) {
+	result = &v1.WebAppOperator{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("webappoperators").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(webAppOperator).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a webAppOperator and updates it. Returns the server's representation of the webAppOperator, and an error, if there is any.
+func (c *webAppOperators) Update(ctx context.Context, webAppOperator *v1.WebAppOperator, opts metav1.UpdateOptions) (result *v1.WebAppOperator, err error) {
+	result = &v1.WebAppOperator{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("webappoperators").
+		Name(webAppOperator.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(webAppOperator).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *webAppOperators) UpdateStatus(ctx context.Context, webAppOperator *v1.WebAppOperator, opts metav1.UpdateOptions) (result *v1.WebAppOperator, err error) {
+	result = &v1.WebAppOperator{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("webappoperators").
+		Name(webAppOperator.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(webAppOperator).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the webAppOperator and deletes it. Returns an error if one occurs.
+func (c *webAppOperators) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("webappoperators").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *webAppOperators) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("webappoperators").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched webAppOperator.
+func (c *webAppOperators) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.WebAppOperator, err error) {
+	result = &v1.WebAppOperator{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("webappoperators").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
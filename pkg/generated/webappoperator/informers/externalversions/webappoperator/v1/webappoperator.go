@@ -0,0 +1,90 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	time "time"
+
+	webappoperatorv1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/webappoperator/v1"
+	versioned "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/webappoperator/clientset/versioned"
+	internalinterfaces "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/webappoperator/informers/externalversions/internalinterfaces"
+	v1 "github.com/nevercase/k8s-controller-custom-resource/pkg/generated/webappoperator/listers/webappoperator/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// WebAppOperatorInformer provides access to a shared informer and lister for
+// WebAppOperators.
+type WebAppOperatorInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1.WebAppOperatorLister
+}
+
+type webAppOperatorInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewWebAppOperatorInformer constructs a new informer for WebAppOperator type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewWebAppOperatorInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredWebAppOperatorInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredWebAppOperatorInformer constructs a new informer for WebAppOperator type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredWebAppOperatorInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NevercaseV1().WebAppOperators(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NevercaseV1().WebAppOperators(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&webappoperatorv1.WebAppOperator{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *webAppOperatorInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredWebAppOperatorInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *webAppOperatorInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&webappoperatorv1.WebAppOperator{}, f.defaultInformer)
+}
+
+func (f *webAppOperatorInformer) Lister() v1.WebAppOperatorLister {
+	return v1.NewWebAppOperatorLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,99 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/webappoperator/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// WebAppOperatorLister helps list WebAppOperators.
+// All objects returned here must be treated as read-only.
+type WebAppOperatorLister interface {
+	// List lists all WebAppOperators in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.WebAppOperator, err error)
+	// WebAppOperators returns an object that can list and get WebAppOperators.
+	WebAppOperators(namespace string) WebAppOperatorNamespaceLister
+	WebAppOperatorListerExpansion
+}
+
+// webAppOperatorLister implements the WebAppOperatorLister interface.
+type webAppOperatorLister struct {
+	indexer cache.Indexer
+}
+
+// NewWebAppOperatorLister returns a new WebAppOperatorLister.
+func NewWebAppOperatorLister(indexer cache.Indexer) WebAppOperatorLister {
+	return &webAppOperatorLister{indexer: indexer}
+}
+
+// List lists all WebAppOperators in the indexer.
+func (s *webAppOperatorLister) List(selector labels.Selector) (ret []*v1.WebAppOperator, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.WebAppOperator))
+	})
+	return ret, err
+}
+
+// WebAppOperators returns an object that can list and get WebAppOperators.
+func (s *webAppOperatorLister) WebAppOperators(namespace string) WebAppOperatorNamespaceLister {
+	return webAppOperatorNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// WebAppOperatorNamespaceLister helps list and get WebAppOperators.
+// All objects returned here must be treated as read-only.
+type WebAppOperatorNamespaceLister interface {
+	// List lists all WebAppOperators in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.WebAppOperator, err error)
+	// Get retrieves the WebAppOperator from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1.WebAppOperator, error)
+	WebAppOperatorNamespaceListerExpansion
+}
+
+// webAppOperatorNamespaceLister implements the WebAppOperatorNamespaceLister
+// interface.
+type webAppOperatorNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all WebAppOperators in the indexer for a given namespace.
+func (s webAppOperatorNamespaceLister) List(selector labels.Selector) (ret []*v1.WebAppOperator, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.WebAppOperator))
+	})
+	return ret, err
+}
+
+// Get retrieves the WebAppOperator from the indexer for a given namespace and name.
+func (s webAppOperatorNamespaceLister) Get(name string) (*v1.WebAppOperator, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("webappoperator"), name)
+	}
+	return obj.(*v1.WebAppOperator), nil
+}
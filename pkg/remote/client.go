@@ -0,0 +1,57 @@
+package remote
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	remoteV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/remote/v1"
+)
+
+// Client calls the reconciler at the address, such as my-reconciler.my-namespace.svc:9090
+type Client struct {
+	conn   *grpc.ClientConn
+	client remoteV1.ReconcilerClient
+}
+
+// NewClient returns the Client of address with the transport credentials, which were usually the ones of
+// TLSConfig.ClientCredentials. The connection was dialed in the background and redialed on the failures.
+func NewClient(address string, creds credentials.TransportCredentials) (*Client, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{conn: conn, client: remoteV1.NewReconcilerClient(conn)}, nil
+}
+
+// Close closes the connection
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Reconcile calls MethodReconcile, the failed call returns the error of the status package
+func (c *Client) Reconcile(ctx context.Context, req *ReconcileRequest) (*ReconcileResponse, error) {
+	in, err := encodeRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	out, err := c.client.Reconcile(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse(out)
+}
+
+// ReconcileStatus calls MethodReconcileStatus
+func (c *Client) ReconcileStatus(ctx context.Context, req *ReconcileRequest) (*ReconcileResponse, error) {
+	in, err := encodeRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	out, err := c.client.ReconcileStatus(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse(out)
+}
@@ -0,0 +1,121 @@
+package remote
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+
+	remoteV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/remote/v1"
+)
+
+// encodeObject returns the JSON of u, nil was encoded as empty
+func encodeObject(u *unstructured.Unstructured) ([]byte, error) {
+	if u == nil {
+		return nil, nil
+	}
+	return u.MarshalJSON()
+}
+
+// decodeObject is the reverse of encodeObject
+func decodeObject(data []byte) (*unstructured.Unstructured, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	u := &unstructured.Unstructured{}
+	if err := u.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func encodeObjects(in []*unstructured.Unstructured) ([][]byte, error) {
+	res := make([][]byte, 0, len(in))
+	for _, u := range in {
+		data, err := encodeObject(u)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, data)
+	}
+	return res, nil
+}
+
+func decodeObjects(in [][]byte) ([]*unstructured.Unstructured, error) {
+	res := make([]*unstructured.Unstructured, 0, len(in))
+	for k, data := range in {
+		u, err := decodeObject(data)
+		if err != nil {
+			return nil, fmt.Errorf("decoding the object %d: %v", k, err)
+		}
+		res = append(res, u)
+	}
+	return res, nil
+}
+
+func encodeRequest(in *ReconcileRequest) (*remoteV1.ReconcileRequest, error) {
+	var err error
+	res := &remoteV1.ReconcileRequest{}
+	if res.Object, err = encodeObject(in.Object); err != nil {
+		return nil, err
+	}
+	if res.Children, err = encodeObjects(in.Children); err != nil {
+		return nil, err
+	}
+	if res.Child, err = encodeObject(in.Child); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func decodeRequest(in *remoteV1.ReconcileRequest) (*ReconcileRequest, error) {
+	var err error
+	res := &ReconcileRequest{}
+	if res.Object, err = decodeObject(in.GetObject()); err != nil {
+		return nil, fmt.Errorf("decoding the object: %v", err)
+	}
+	if res.Children, err = decodeObjects(in.GetChildren()); err != nil {
+		return nil, err
+	}
+	if res.Child, err = decodeObject(in.GetChild()); err != nil {
+		return nil, fmt.Errorf("decoding the child: %v", err)
+	}
+	return res, nil
+}
+
+func encodeResponse(in *ReconcileResponse) (*remoteV1.ReconcileResponse, error) {
+	var err error
+	res := &remoteV1.ReconcileResponse{RequeueAfterSeconds: in.RequeueAfterSeconds}
+	if res.Children, err = encodeObjects(in.Children); err != nil {
+		return nil, err
+	}
+	if in.Status != nil {
+		if res.Status, err = json.Marshal(in.Status); err != nil {
+			return nil, err
+		}
+	}
+	for _, e := range in.Events {
+		res.Events = append(res.Events, &remoteV1.Event{Type: e.Type, Reason: e.Reason, Message: e.Message})
+	}
+	return res, nil
+}
+
+// decodeResponse decodes the integers of the status into int64 as the unstructured objects do,
+// so that the status was compared with the live one without the float64
+func decodeResponse(in *remoteV1.ReconcileResponse) (*ReconcileResponse, error) {
+	var err error
+	res := &ReconcileResponse{RequeueAfterSeconds: in.GetRequeueAfterSeconds()}
+	if res.Children, err = decodeObjects(in.GetChildren()); err != nil {
+		return nil, err
+	}
+	if len(in.GetStatus()) > 0 {
+		if err = utiljson.Unmarshal(in.GetStatus(), &res.Status); err != nil {
+			return nil, fmt.Errorf("decoding the status: %v", err)
+		}
+	}
+	for _, e := range in.GetEvents() {
+		res.Events = append(res.Events, Event{Type: e.GetType(), Reason: e.GetReason(), Message: e.GetMessage()})
+	}
+	return res, nil
+}
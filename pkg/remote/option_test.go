@@ -0,0 +1,172 @@
+package remote_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/record"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/remote"
+)
+
+var (
+	memcached  = k8sCoreV1.DynamicResource{Group: "example.com", Version: "v1", Kind: "Memcached", Resource: "memcacheds"}
+	configMaps = k8sCoreV1.DynamicResource{Version: "v1", Kind: "ConfigMap", Resource: "configmaps"}
+)
+
+// memcachedReconciler desires the ConfigMap of the size of the Memcached
+type memcachedReconciler struct{}
+
+func (memcachedReconciler) Reconcile(ctx context.Context, req *remote.ReconcileRequest) (*remote.ReconcileResponse, error) {
+	size, _, _ := unstructured.NestedInt64(req.Object.Object, "spec", "size")
+	cm := &unstructured.Unstructured{Object: map[string]interface{}{
+		"data": map[string]interface{}{"size": strings.Repeat("x", int(size))},
+	}}
+	cm.SetGroupVersionKind(configMaps.GroupVersionKind())
+	cm.SetName(req.Object.GetName() + "-config")
+	return &remote.ReconcileResponse{
+		Children:            []*unstructured.Unstructured{cm},
+		Status:              map[string]interface{}{"size": size},
+		RequeueAfterSeconds: 60,
+		Events:              []remote.Event{{Type: "Normal", Reason: "Sized", Message: "sized by the test"}},
+	}, nil
+}
+
+func (memcachedReconciler) ReconcileStatus(ctx context.Context, req *remote.ReconcileRequest) (*remote.ReconcileResponse, error) {
+	return &remote.ReconcileResponse{}, nil
+}
+
+func newMemcached() *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"size": int64(3)},
+	}}
+	u.SetGroupVersionKind(memcached.GroupVersionKind())
+	u.SetNamespace("default")
+	u.SetName("cache")
+	u.SetUID("cache-uid")
+	return u
+}
+
+// newChild returns the ConfigMap of name which was labelled and controlled by owner
+func newChild(owner *unstructured.Unstructured, name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(configMaps.GroupVersionKind())
+	u.SetNamespace(owner.GetNamespace())
+	u.SetName(name)
+	u.SetLabels(map[string]string{k8sCoreV1.LabelApp: memcached.Kind, k8sCoreV1.LabelController: owner.GetName()})
+	u.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(owner, memcached.GroupVersionKind())})
+	return u
+}
+
+func TestRemoteOption(t *testing.T) {
+	address := serve(t, memcachedReconciler{})
+
+	foo := newMemcached()
+	client := dynamicFake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		memcached.GroupVersionResource():  "MemcachedList",
+		configMaps.GroupVersionResource(): "ConfigMapList",
+	}, foo, newChild(foo, "cache-stale"))
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	opt, err := k8sCoreV1.NewRemoteOption("test", k8sCoreV1.RemoteConfig{
+		Resource: memcached,
+		Children: []k8sCoreV1.DynamicResource{configMaps},
+		Endpoint: address,
+		Insecure: true,
+	}, client, stopCh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opt.GetReflectType() != k8sCoreV1.ObjectType(foo) {
+		t.Errorf("the reflect type %v of the option didn't match the object", opt.GetReflectType())
+	}
+
+	recorder := record.NewFakeRecorder(10)
+	res, err := opt.SyncHandleObject(context.Background(), foo, nil, recorder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.RequeueAfter != time.Minute {
+		t.Errorf("expected the requeue after a minute, got %v", res)
+	}
+
+	cms := client.Resource(configMaps.GroupVersionResource()).Namespace("default")
+	cm, err := cms.Get(context.Background(), "cache-config", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !metav1.IsControlledBy(cm, foo) || cm.GetLabels()[k8sCoreV1.LabelController] != "cache" {
+		t.Errorf("the ConfigMap was not labelled and controlled by the Memcached: %v", cm.Object)
+	}
+	if size, _, _ := unstructured.NestedString(cm.Object, "data", "size"); size != "xxx" {
+		t.Errorf("unexpected data of the ConfigMap %v", cm.Object)
+	}
+	if _, err = cms.Get(context.Background(), "cache-stale", metav1.GetOptions{}); !errors.IsNotFound(err) {
+		t.Errorf("the undesired ConfigMap was not deleted: %v", err)
+	}
+
+	live, err := client.Resource(memcached.GroupVersionResource()).Namespace("default").Get(context.Background(), "cache", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size, _, _ := unstructured.NestedInt64(live.Object, "status", "size"); size != 3 {
+		t.Errorf("unexpected status of the Memcached %v", live.Object["status"])
+	}
+
+	events := make([]string, 0)
+	for len(recorder.Events) > 0 {
+		events = append(events, <-recorder.Events)
+	}
+	if len(events) != 3 || !strings.HasPrefix(events[0], "Normal Sized") || !strings.HasPrefix(events[len(events)-1], "Normal Synced") {
+		t.Errorf("unexpected events %v", events)
+	}
+}
+
+func TestRemoteOptionRefusesUnconfiguredKinds(t *testing.T) {
+	address := serve(t, memcachedReconciler{})
+
+	foo := newMemcached()
+	client := dynamicFake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		memcached.GroupVersionResource(): "MemcachedList",
+	}, foo)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	opt, err := k8sCoreV1.NewRemoteOption("test", k8sCoreV1.RemoteConfig{
+		Resource: memcached,
+		Endpoint: address,
+		Insecure: true,
+	}, client, stopCh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = opt.SyncHandleObject(context.Background(), foo, nil, record.NewFakeRecorder(10)); err == nil {
+		t.Error("expected the ConfigMap which was not configured as a child being refused")
+	}
+}
+
+func TestRemoteConfigValidate(t *testing.T) {
+	config := k8sCoreV1.RemoteConfig{
+		Resource: memcached,
+		Endpoint: "memcached-reconciler.default.svc:9090",
+		TLS:      remote.TLSConfig{CAFile: "/etc/reconciler/ca.crt"},
+		Insecure: true,
+	}
+	if err := config.Validate(); err == nil {
+		t.Error("expected the tls together with insecure being refused")
+	}
+	config.Insecure = false
+	if err := config.Validate(); err != nil {
+		t.Errorf("Validate() = %v", err)
+	}
+}
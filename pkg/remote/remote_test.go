@@ -0,0 +1,146 @@
+package remote_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/remote"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/webhook"
+)
+
+// fakeReconciler desires a ConfigMap named after the object, and fails the objects named "broken"
+type fakeReconciler struct{}
+
+func (fakeReconciler) Reconcile(ctx context.Context, req *remote.ReconcileRequest) (*remote.ReconcileResponse, error) {
+	if req.Object.GetName() == "broken" {
+		return nil, status.Error(codes.FailedPrecondition, "broken: spec.size must be positive")
+	}
+	cm := &unstructured.Unstructured{}
+	cm.SetAPIVersion("v1")
+	cm.SetKind("ConfigMap")
+	cm.SetName(req.Object.GetName() + "-config")
+	return &remote.ReconcileResponse{
+		Children:            []*unstructured.Unstructured{cm},
+		Status:              map[string]interface{}{"children": int64(len(req.Children))},
+		RequeueAfterSeconds: 30,
+	}, nil
+}
+
+func (fakeReconciler) ReconcileStatus(ctx context.Context, req *remote.ReconcileRequest) (*remote.ReconcileResponse, error) {
+	return nil, errors.New("not supported")
+}
+
+func newObject(name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("example.com/v1")
+	u.SetKind("Memcached")
+	u.SetNamespace("default")
+	u.SetName(name)
+	return u
+}
+
+// serve serves reconciler on a local port until the test finished, and returns the address
+func serve(t *testing.T, reconciler remote.Reconciler, opts ...grpc.ServerOption) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := remote.NewServer(reconciler, opts...)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+	return lis.Addr().String()
+}
+
+// newTLSConfig generates the self-signed CA and the certificate of 127.0.0.1
+func newTLSConfig(t *testing.T) remote.TLSConfig {
+	t.Helper()
+	dir := t.TempDir()
+	if _, err := webhook.EnsureSelfSignedCerts(dir, []string{"127.0.0.1"}); err != nil {
+		t.Fatal(err)
+	}
+	return remote.TLSConfig{
+		CAFile:   filepath.Join(dir, webhook.CAFile),
+		CertFile: filepath.Join(dir, webhook.CertFile),
+		KeyFile:  filepath.Join(dir, webhook.KeyFile),
+	}
+}
+
+func TestClientServer(t *testing.T) {
+	config := newTLSConfig(t)
+	serverCreds, err := remote.TLSConfig{CertFile: config.CertFile, KeyFile: config.KeyFile}.ServerCredentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientCreds, err := remote.TLSConfig{CAFile: config.CAFile}.ClientCredentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := serve(t, fakeReconciler{}, grpc.Creds(serverCreds))
+	client, err := remote.NewClient(address, clientCreds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	res, err := client.Reconcile(context.Background(), &remote.ReconcileRequest{Object: newObject("cache"), Children: []*unstructured.Unstructured{newObject("old")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Children) != 1 || res.Children[0].GetName() != "cache-config" || res.Children[0].GetKind() != "ConfigMap" {
+		t.Errorf("unexpected children %v", res.Children)
+	}
+	if res.Status["children"] != int64(1) || res.RequeueAfterSeconds != 30 {
+		t.Errorf("unexpected status %v and requeue %d", res.Status, res.RequeueAfterSeconds)
+	}
+
+	_, err = client.Reconcile(context.Background(), &remote.ReconcileRequest{Object: newObject("broken")})
+	if s := status.Convert(err); s.Code() != codes.FailedPrecondition || s.Message() != "broken: spec.size must be positive" {
+		t.Errorf("expected the FailedPrecondition status, got %v", err)
+	}
+
+	_, err = client.ReconcileStatus(context.Background(), &remote.ReconcileRequest{Object: newObject("cache")})
+	if s := status.Convert(err); s.Code() != codes.Unknown || s.Message() != "not supported" {
+		t.Errorf("expected the Unknown status, got %v", err)
+	}
+
+	_, err = client.Reconcile(context.Background(), &remote.ReconcileRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected the request without the object being refused, got %v", err)
+	}
+}
+
+func TestClientRefusesInsecureServer(t *testing.T) {
+	clientCreds, err := remote.TLSConfig{CAFile: newTLSConfig(t).CAFile}.ClientCredentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := remote.NewClient(serve(t, fakeReconciler{}), clientCreds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	if _, err = client.Reconcile(context.Background(), &remote.ReconcileRequest{Object: newObject("cache")}); status.Code(err) != codes.Unavailable {
+		t.Errorf("expected the server without TLS being unavailable, got %v", err)
+	}
+
+	client, err = remote.NewClient(serve(t, fakeReconciler{}), insecure.NewCredentials())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	if _, err = client.Reconcile(context.Background(), &remote.ReconcileRequest{Object: newObject("cache")}); err != nil {
+		t.Errorf("expected the insecure call being served, got %v", err)
+	}
+}
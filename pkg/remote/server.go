@@ -0,0 +1,92 @@
+package remote
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+
+	remoteV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/remote/v1"
+)
+
+// Server serves the Reconciler over gRPC, it was meant for the reconcilers written in Go
+type Server struct {
+	server *grpc.Server
+}
+
+// NewServer returns the Server of reconciler. The server options should carry the transport credentials,
+// e.g. grpc.Creds of TLSConfig.ServerCredentials, otherwise the calls were served without TLS.
+func NewServer(reconciler Reconciler, opts ...grpc.ServerOption) *Server {
+	s := grpc.NewServer(opts...)
+	remoteV1.RegisterReconcilerServer(s, &reconcilerServer{reconciler: reconciler})
+	return &Server{server: s}
+}
+
+// Serve serves the calls which were accepted on lis until Stop was called
+func (s *Server) Serve(lis net.Listener) error {
+	return s.server.Serve(lis)
+}
+
+// Stop stops the server once the pending calls were finished
+func (s *Server) Stop() {
+	s.server.GracefulStop()
+}
+
+// ListenAndServe serves the Reconciler on addr until ctx was done
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		klog.Infof("starting the remote reconciler on %s", addr)
+		errCh <- s.Serve(lis)
+	}()
+	select {
+	case err = <-errCh:
+		return err
+	case <-ctx.Done():
+		s.Stop()
+		return nil
+	}
+}
+
+// reconcilerServer adapts the Reconciler to the generated service
+type reconcilerServer struct {
+	remoteV1.UnimplementedReconcilerServer
+	reconciler Reconciler
+}
+
+func (s *reconcilerServer) Reconcile(ctx context.Context, in *remoteV1.ReconcileRequest) (*remoteV1.ReconcileResponse, error) {
+	return s.handle(ctx, in, s.reconciler.Reconcile)
+}
+
+func (s *reconcilerServer) ReconcileStatus(ctx context.Context, in *remoteV1.ReconcileRequest) (*remoteV1.ReconcileResponse, error) {
+	return s.handle(ctx, in, s.reconciler.ReconcileStatus)
+}
+
+func (s *reconcilerServer) handle(ctx context.Context, in *remoteV1.ReconcileRequest, fn func(context.Context, *ReconcileRequest) (*ReconcileResponse, error)) (*remoteV1.ReconcileResponse, error) {
+	req, err := decodeRequest(in)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "decoding the request: %v", err)
+	}
+	if req.Object == nil {
+		return nil, status.Error(codes.InvalidArgument, "the object must be specified")
+	}
+	res, err := fn(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		res = &ReconcileResponse{}
+	}
+	out, err := encodeResponse(res)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encoding the response: %v", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,74 @@
+package remote
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// TLSConfig holds the PEM files of the transport credentials of the calls
+type TLSConfig struct {
+	// CAFile verifies the peer, the client verifies the server with the system roots if it was empty,
+	// and the server requires the client certificates signed by it if it was set.
+	CAFile string `json:"caFile,omitempty"`
+	// CertFile and KeyFile were the certificate of the server, or the one which the client presents
+	CertFile string `json:"certFile,omitempty"`
+	KeyFile  string `json:"keyFile,omitempty"`
+	// ServerName overrides the name which the certificate of the server was verified against
+	ServerName string `json:"serverName,omitempty"`
+}
+
+// IsZero returns true if nothing was set
+func (c TLSConfig) IsZero() bool {
+	return c == TLSConfig{}
+}
+
+// ClientCredentials returns the credentials which the client calls the server with
+func (c TLSConfig) ClientCredentials() (credentials.TransportCredentials, error) {
+	config := &tls.Config{ServerName: c.ServerName, MinVersion: tls.VersionTLS12}
+	var err error
+	if c.CAFile != "" {
+		if config.RootCAs, err = loadCertPool(c.CAFile); err != nil {
+			return nil, err
+		}
+	}
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(config), nil
+}
+
+// ServerCredentials returns the credentials which the server serves with, CertFile and KeyFile must be set
+func (c TLSConfig) ServerCredentials() (credentials.TransportCredentials, error) {
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	if c.CAFile != "" {
+		if config.ClientCAs, err = loadCertPool(c.CAFile); err != nil {
+			return nil, err
+		}
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return credentials.NewTLS(config), nil
+}
+
+func loadCertPool(file string) (*x509.CertPool, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificate was found in %s", file)
+	}
+	return pool, nil
+}
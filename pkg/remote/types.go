@@ -0,0 +1,55 @@
+// Package remote carries the reconciles of the custom resources to the reconcilers which run in their own processes.
+// It calls the gRPC service Reconciler of v1/reconciler.proto, so that the reconciler could be served by Server
+// or by the gRPC server of any language which was generated from the same proto.
+package remote
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	remoteV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/remote/v1"
+)
+
+const (
+	// ServiceName is the name of the gRPC service of the reconciler
+	ServiceName = "nevercase.remote.v1.Reconciler"
+	// MethodReconcile returns the desired children and status of the object
+	MethodReconcile = remoteV1.Reconciler_Reconcile_FullMethodName
+	// MethodReconcileStatus returns the desired status of the object after its child changed
+	MethodReconcileStatus = remoteV1.Reconciler_ReconcileStatus_FullMethodName
+)
+
+// ReconcileRequest holds the object and the children which were controlled by it
+type ReconcileRequest struct {
+	Object   *unstructured.Unstructured
+	Children []*unstructured.Unstructured
+	// Child is the child whose status changed, it was only set for ReconcileStatus
+	Child *unstructured.Unstructured
+}
+
+// ReconcileResponse holds what the object desires. The children which were not desired any more were deleted,
+// and the children were left alone by ReconcileStatus.
+type ReconcileResponse struct {
+	Children []*unstructured.Unstructured
+	// Status replaces the status of the object unless it was nil
+	Status map[string]interface{}
+	// RequeueAfterSeconds asks for the object being reconciled again after a while
+	RequeueAfterSeconds int64
+	Events              []Event
+}
+
+// Event is recorded on the object, Type was either Normal or Warning
+type Event struct {
+	Type    string
+	Reason  string
+	Message string
+}
+
+// Reconciler is implemented by the out-of-process reconciler and served by Server,
+// ctx was cancelled once the caller gave up. The errors of the status package keep their codes,
+// the other errors were sent as codes.Unknown.
+type Reconciler interface {
+	Reconcile(ctx context.Context, req *ReconcileRequest) (*ReconcileResponse, error)
+	ReconcileStatus(ctx context.Context, req *ReconcileRequest) (*ReconcileResponse, error)
+}
@@ -0,0 +1,154 @@
+// The service of the reconcilers which run in their own processes, see pkg/remote.
+// The objects were carried as the JSON of the Kubernetes objects, so that the reconcilers
+// decode them with the clients of their own languages.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: reconciler.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Reconciler_Reconcile_FullMethodName       = "/nevercase.remote.v1.Reconciler/Reconcile"
+	Reconciler_ReconcileStatus_FullMethodName = "/nevercase.remote.v1.Reconciler/ReconcileStatus"
+)
+
+// ReconcilerClient is the client API for Reconciler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReconcilerClient interface {
+	// Reconcile returns the desired children and status of the object
+	Reconcile(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (*ReconcileResponse, error)
+	// ReconcileStatus returns the desired status of the object after its child changed
+	ReconcileStatus(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (*ReconcileResponse, error)
+}
+
+type reconcilerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReconcilerClient(cc grpc.ClientConnInterface) ReconcilerClient {
+	return &reconcilerClient{cc}
+}
+
+func (c *reconcilerClient) Reconcile(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (*ReconcileResponse, error) {
+	out := new(ReconcileResponse)
+	err := c.cc.Invoke(ctx, Reconciler_Reconcile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reconcilerClient) ReconcileStatus(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (*ReconcileResponse, error) {
+	out := new(ReconcileResponse)
+	err := c.cc.Invoke(ctx, Reconciler_ReconcileStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReconcilerServer is the server API for Reconciler service.
+// All implementations must embed UnimplementedReconcilerServer
+// for forward compatibility
+type ReconcilerServer interface {
+	// Reconcile returns the desired children and status of the object
+	Reconcile(context.Context, *ReconcileRequest) (*ReconcileResponse, error)
+	// ReconcileStatus returns the desired status of the object after its child changed
+	ReconcileStatus(context.Context, *ReconcileRequest) (*ReconcileResponse, error)
+	mustEmbedUnimplementedReconcilerServer()
+}
+
+// UnimplementedReconcilerServer must be embedded to have forward compatible implementations.
+type UnimplementedReconcilerServer struct {
+}
+
+func (UnimplementedReconcilerServer) Reconcile(context.Context, *ReconcileRequest) (*ReconcileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reconcile not implemented")
+}
+func (UnimplementedReconcilerServer) ReconcileStatus(context.Context, *ReconcileRequest) (*ReconcileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileStatus not implemented")
+}
+func (UnimplementedReconcilerServer) mustEmbedUnimplementedReconcilerServer() {}
+
+// UnsafeReconcilerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReconcilerServer will
+// result in compilation errors.
+type UnsafeReconcilerServer interface {
+	mustEmbedUnimplementedReconcilerServer()
+}
+
+func RegisterReconcilerServer(s grpc.ServiceRegistrar, srv ReconcilerServer) {
+	s.RegisterService(&Reconciler_ServiceDesc, srv)
+}
+
+func _Reconciler_Reconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReconcilerServer).Reconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reconciler_Reconcile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReconcilerServer).Reconcile(ctx, req.(*ReconcileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reconciler_ReconcileStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReconcilerServer).ReconcileStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reconciler_ReconcileStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReconcilerServer).ReconcileStatus(ctx, req.(*ReconcileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Reconciler_ServiceDesc is the grpc.ServiceDesc for Reconciler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Reconciler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nevercase.remote.v1.Reconciler",
+	HandlerType: (*ReconcilerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Reconcile",
+			Handler:    _Reconciler_Reconcile_Handler,
+		},
+		{
+			MethodName: "ReconcileStatus",
+			Handler:    _Reconciler_ReconcileStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "reconciler.proto",
+}
@@ -41,3 +41,27 @@ func SetupSignalHandler() (stopCh <-chan struct{}) {
 
 	return stop
 }
+
+// SetupReloadHandler registered for SIGHUP. A channel is returned which receives a value
+// on each of these signals until stopCh was closed, the signals were coalesced while the
+// previous one was not received yet.
+func SetupReloadHandler(stopCh <-chan struct{}) <-chan struct{} {
+	reload := make(chan struct{}, 1)
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGHUP)
+	go func() {
+		defer signal.Stop(c)
+		for {
+			select {
+			case <-c:
+				select {
+				case reload <- struct{}{}:
+				default:
+				}
+			case <-stopCh:
+				return
+			}
+		}
+	}()
+	return reload
+}
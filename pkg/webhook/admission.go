@@ -0,0 +1,97 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/crd"
+)
+
+// Validation is the validating webhook of the custom resource of Definition, whose Option validates the objects
+type Validation struct {
+	Definition crd.Definition
+	Option     k8sCoreV1.ValidatingOption
+}
+
+// Path returns the path of the webhook, e.g. /validate-nevercase-io-v1-redisoperator
+func (v Validation) Path() string {
+	return path("validate", v.Definition)
+}
+
+func path(prefix string, d crd.Definition) string {
+	gv := d.GroupVersion
+	return fmt.Sprintf("/%s-%s-%s-%s", prefix, strings.ReplaceAll(gv.Group, ".", "-"), gv.Version, strings.ToLower(d.Kind))
+}
+
+// ServeHTTP answers the AdmissionReview of the creates and the updates,
+// the rejected objects were reported with the field errors in the Status of the response.
+func (v Validation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	review := &admissionv1.AdmissionReview{}
+	if err := json.NewDecoder(r.Body).Decode(review); err != nil || review.Request == nil {
+		http.Error(w, fmt.Sprintf("invalid AdmissionReview: %v", err), http.StatusBadRequest)
+		return
+	}
+	gk := schema.GroupKind{Group: v.Definition.GroupVersion.Group, Kind: v.Definition.Kind}
+	review.Response = validate(v.Option, gk, review.Request)
+	review.Response.UID = review.Request.UID
+	review.Request = nil
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		klog.Errorf("writing the AdmissionReview of %s: %v", gk.Kind, err)
+	}
+}
+
+func validate(opt k8sCoreV1.ValidatingOption, gk schema.GroupKind, req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	var errs field.ErrorList
+	switch req.Operation {
+	case admissionv1.Create:
+		obj := opt.New()
+		if err := json.Unmarshal(req.Object.Raw, obj); err != nil {
+			return denied(apierrors.NewBadRequest(err.Error()))
+		}
+		errs = opt.ValidateCreate(obj)
+	case admissionv1.Update:
+		obj, old := opt.New(), opt.New()
+		if err := json.Unmarshal(req.Object.Raw, obj); err != nil {
+			return denied(apierrors.NewBadRequest(err.Error()))
+		}
+		if err := json.Unmarshal(req.OldObject.Raw, old); err != nil {
+			return denied(apierrors.NewBadRequest(err.Error()))
+		}
+		errs = opt.ValidateUpdate(old, obj)
+	}
+	if len(errs) == 0 {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+	klog.V(2).Infof("rejected the %s of %s %s/%s: %v", strings.ToLower(string(req.Operation)), gk.Kind, req.Namespace, req.Name, errs.ToAggregate())
+	return denied(apierrors.NewInvalid(gk, req.Name, errs))
+}
+
+func denied(err *apierrors.StatusError) *admissionv1.AdmissionResponse {
+	status := err.Status()
+	return &admissionv1.AdmissionResponse{Allowed: false, Result: &status}
+}
+
+// Validations returns the Validations of the Definitions whose Options in opts were the ValidatingOptions
+func Validations(opts k8sCoreV1.Options, defs ...crd.Definition) []Validation {
+	res := make([]Validation, 0)
+	for _, d := range defs {
+		opt, err := opts.GetWithKindName(d.Kind)
+		if err != nil {
+			continue
+		}
+		if vo, ok := opt.(k8sCoreV1.ValidatingOption); ok {
+			res = append(res, Validation{Definition: d, Option: vo})
+		}
+	}
+	return res
+}
@@ -0,0 +1,152 @@
+package webhook
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// The files in the cert dir, which were laid out as a kubernetes.io/tls Secret with the ca.crt of cert-manager
+const (
+	CAFile   = "ca.crt"
+	CertFile = "tls.crt"
+	KeyFile  = "tls.key"
+)
+
+// selfSignedValidity is the validity of the self-signed CA and the serving certificate
+const selfSignedValidity = time.Hour * 24 * 365
+
+// EnsureSelfSignedCerts generates the CA and the serving certificate for hosts into certDir unless they were there,
+// and returns the PEM of the CA as the caBundle of the webhook configuration. It was meant for the local tests,
+// the certificates of the clusters should be issued by e.g. cert-manager into the same files.
+func EnsureSelfSignedCerts(certDir string, hosts []string) ([]byte, error) {
+	caPath := filepath.Join(certDir, CAFile)
+	if ca, err := ioutil.ReadFile(caPath); err == nil {
+		if _, err = os.Stat(filepath.Join(certDir, CertFile)); err == nil {
+			return ca, nil
+		}
+	}
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("the hosts of the serving certificate must be specified")
+	}
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "k8s-controller-custom-resource-webhook-ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(selfSignedValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, err
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: hosts[0]},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(selfSignedValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, h)
+		}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})
+	if err = os.MkdirAll(certDir, 0700); err != nil {
+		return nil, err
+	}
+	for name, data := range map[string][]byte{
+		CAFile:   caPEM,
+		CertFile: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		KeyFile:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	} {
+		if err = ioutil.WriteFile(filepath.Join(certDir, name), data, 0600); err != nil {
+			return nil, err
+		}
+	}
+	klog.Infof("generated the self-signed certificates for %v into %s", hosts, certDir)
+	return caPEM, nil
+}
+
+// certLoader serves the key pair in the cert dir, it was reloaded once the files were modified,
+// so that the rotated certificates were picked up without restarting.
+type certLoader struct {
+	certFile, keyFile string
+
+	mu      sync.Mutex
+	modTime time.Time
+	cert    *tls.Certificate
+}
+
+func newCertLoader(certDir string) *certLoader {
+	return &certLoader{
+		certFile: filepath.Join(certDir, CertFile),
+		keyFile:  filepath.Join(certDir, KeyFile),
+	}
+}
+
+func (l *certLoader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	info, err := os.Stat(l.certFile)
+	if err != nil {
+		if l.cert != nil {
+			return l.cert, nil
+		}
+		return nil, err
+	}
+	if l.cert != nil && !info.ModTime().After(l.modTime) {
+		return l.cert, nil
+	}
+	cert, err := tls.LoadX509KeyPair(l.certFile, l.keyFile)
+	if err != nil {
+		if l.cert != nil {
+			klog.Warningf("reloading the serving certificate: %v", err)
+			return l.cert, nil
+		}
+		return nil, err
+	}
+	l.cert, l.modTime = &cert, info.ModTime()
+	return l.cert, nil
+}
@@ -0,0 +1,147 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/crd"
+)
+
+// Endpoint tells the apiserver how to reach the Server, either the URL of the Server for the local tests,
+// e.g. https://192.168.1.10:9443, or the Service in the form of namespace/name in front of it.
+type Endpoint struct {
+	URL     string
+	Service string
+	// Port is the port of the Service, it defaults to 443
+	Port int32
+}
+
+func (e Endpoint) clientConfig(path string, caBundle []byte) (admissionregistrationv1.WebhookClientConfig, error) {
+	res := admissionregistrationv1.WebhookClientConfig{CABundle: caBundle}
+	switch {
+	case e.URL != "":
+		url := strings.TrimSuffix(e.URL, "/") + path
+		res.URL = &url
+	case e.Service != "":
+		s := strings.SplitN(e.Service, "/", 2)
+		if len(s) != 2 {
+			return res, fmt.Errorf("invalid Service %q, expected namespace/name", e.Service)
+		}
+		port := e.Port
+		if port == 0 {
+			port = 443
+		}
+		res.Service = &admissionregistrationv1.ServiceReference{Namespace: s[0], Name: s[1], Path: &path, Port: &port}
+	default:
+		return res, fmt.Errorf("either the URL or the Service of the webhook must be specified")
+	}
+	return res, nil
+}
+
+// rules returns the rules of the creates and the updates of the custom resource of Definition
+func rules(d crd.Definition) []admissionregistrationv1.RuleWithOperations {
+	scope := admissionregistrationv1.NamespacedScope
+	gv := d.GroupVersion
+	return []admissionregistrationv1.RuleWithOperations{{
+		Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+		Rule: admissionregistrationv1.Rule{
+			APIGroups:   []string{gv.Group},
+			APIVersions: []string{gv.Version},
+			Resources:   []string{d.Plural},
+			Scope:       &scope,
+		},
+	}}
+}
+
+func webhookName(d crd.Definition) string {
+	return fmt.Sprintf("%s.%s", strings.ToLower(d.Kind), d.GroupVersion.Group)
+}
+
+// ConfigureValidations creates the ValidatingWebhookConfiguration of name for the Validations,
+// or replaces the webhooks of the existing one. The creates and the updates of the custom resources
+// were refused while the Server was unreachable, since their syncs would fail anyway.
+func ConfigureValidations(ctx context.Context, client kubernetes.Interface, name string, endpoint Endpoint, caBundle []byte, validations ...Validation) error {
+	webhooks := make([]admissionregistrationv1.ValidatingWebhook, 0, len(validations))
+	failurePolicy := admissionregistrationv1.Fail
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+	for _, v := range validations {
+		clientConfig, err := endpoint.clientConfig(v.Path(), caBundle)
+		if err != nil {
+			return err
+		}
+		webhooks = append(webhooks, admissionregistrationv1.ValidatingWebhook{
+			Name:                    webhookName(v.Definition),
+			ClientConfig:            clientConfig,
+			Rules:                   rules(v.Definition),
+			FailurePolicy:           &failurePolicy,
+			SideEffects:             &sideEffects,
+			AdmissionReviewVersions: []string{"v1"},
+		})
+	}
+	configurations := client.AdmissionregistrationV1().ValidatingWebhookConfigurations()
+	current, err := configurations.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		klog.Infof("creating ValidatingWebhookConfiguration %s", name)
+		_, err = configurations.Create(ctx, &admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Webhooks:   webhooks,
+		}, metav1.CreateOptions{})
+		return err
+	}
+	klog.Infof("updating ValidatingWebhookConfiguration %s", name)
+	current = current.DeepCopy()
+	current.Webhooks = webhooks
+	_, err = configurations.Update(ctx, current, metav1.UpdateOptions{})
+	return err
+}
+
+// ConfigureDefaultings creates the MutatingWebhookConfiguration of name for the Defaultings,
+// or replaces the webhooks of the existing one. The objects were still admitted while the Server
+// was unreachable, the controllers default them on their copies anyway.
+func ConfigureDefaultings(ctx context.Context, client kubernetes.Interface, name string, endpoint Endpoint, caBundle []byte, defaultings ...Defaulting) error {
+	webhooks := make([]admissionregistrationv1.MutatingWebhook, 0, len(defaultings))
+	failurePolicy := admissionregistrationv1.Ignore
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+	for _, d := range defaultings {
+		clientConfig, err := endpoint.clientConfig(d.Path(), caBundle)
+		if err != nil {
+			return err
+		}
+		webhooks = append(webhooks, admissionregistrationv1.MutatingWebhook{
+			Name:                    webhookName(d.Definition),
+			ClientConfig:            clientConfig,
+			Rules:                   rules(d.Definition),
+			FailurePolicy:           &failurePolicy,
+			SideEffects:             &sideEffects,
+			AdmissionReviewVersions: []string{"v1"},
+		})
+	}
+	configurations := client.AdmissionregistrationV1().MutatingWebhookConfigurations()
+	current, err := configurations.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		klog.Infof("creating MutatingWebhookConfiguration %s", name)
+		_, err = configurations.Create(ctx, &admissionregistrationv1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Webhooks:   webhooks,
+		}, metav1.CreateOptions{})
+		return err
+	}
+	klog.Infof("updating MutatingWebhookConfiguration %s", name)
+	current = current.DeepCopy()
+	current.Webhooks = webhooks
+	_, err = configurations.Update(ctx, current, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,128 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/crd"
+)
+
+// Conversion is the conversion webhook of the custom resource of Definition,
+// which converts the objects between the storage version and the Versions of Definition
+type Conversion struct {
+	Definition crd.Definition
+}
+
+// Path returns the path of the webhook, e.g. /convert-nevercase-io-v1-redisoperator
+func (c Conversion) Path() string {
+	return path("convert", c.Definition)
+}
+
+// ServeHTTP answers the ConversionReview, the objects were converted through the storage version
+func (c Conversion) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	review := &crd.ConversionReview{}
+	if err := json.NewDecoder(r.Body).Decode(review); err != nil || review.Request == nil {
+		http.Error(w, fmt.Sprintf("invalid ConversionReview: %v", err), http.StatusBadRequest)
+		return
+	}
+	res := &crd.ConversionResponse{UID: review.Request.UID, Result: metav1.Status{Status: metav1.StatusSuccess}}
+	for _, obj := range review.Request.Objects {
+		converted, err := c.convert(obj.Raw, review.Request.DesiredAPIVersion)
+		if err != nil {
+			klog.V(2).Infof("converting %s into %s: %v", c.Definition.Kind, review.Request.DesiredAPIVersion, err)
+			res.ConvertedObjects = nil
+			res.Result = metav1.Status{Status: metav1.StatusFailure, Message: err.Error()}
+			break
+		}
+		res.ConvertedObjects = append(res.ConvertedObjects, runtime.RawExtension{Raw: converted})
+	}
+	review.Request, review.Response = nil, res
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		klog.Errorf("writing the ConversionReview of %s: %v", c.Definition.Kind, err)
+	}
+}
+
+func (c Conversion) convert(obj []byte, desired string) ([]byte, error) {
+	var meta metav1.TypeMeta
+	if err := json.Unmarshal(obj, &meta); err != nil {
+		return nil, err
+	}
+	if meta.APIVersion == desired {
+		return obj, nil
+	}
+	storage := c.Definition.GroupVersion.String()
+	if meta.APIVersion != storage {
+		v, err := c.version(meta.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+		in, err := v.Conversion.ToStorage(obj)
+		if err != nil {
+			return nil, err
+		}
+		if obj, err = json.Marshal(in); err != nil {
+			return nil, err
+		}
+	}
+	if desired == storage {
+		return obj, nil
+	}
+	v, err := c.version(desired)
+	if err != nil {
+		return nil, err
+	}
+	out, err := v.Conversion.FromStorage(obj)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(out)
+}
+
+// version returns the Version of apiVersion, e.g. nevercase.io/v2
+func (c Conversion) version(apiVersion string) (crd.Version, error) {
+	for _, v := range c.Definition.Versions {
+		if c.Definition.GroupVersion.Group+"/"+v.Name == apiVersion {
+			return v, nil
+		}
+	}
+	return crd.Version{}, fmt.Errorf("unknown apiVersion %q of %s", apiVersion, c.Definition.Kind)
+}
+
+// Conversions returns the Conversions of the Definitions which had more than one version
+func Conversions(defs ...crd.Definition) []Conversion {
+	res := make([]Conversion, 0)
+	for _, d := range defs {
+		if len(d.Versions) > 0 {
+			res = append(res, Conversion{Definition: d})
+		}
+	}
+	return res
+}
+
+// ConfigureConversions installs the CustomResourceDefinitions of the Conversions with their conversion webhooks,
+// so that their Versions were served as well. The Versions stop working while the Server was unreachable,
+// the storage version keeps being served anyway.
+func ConfigureConversions(ctx context.Context, client dynamic.Interface, endpoint Endpoint, caBundle []byte, conversions ...Conversion) error {
+	defs := make([]crd.Definition, 0, len(conversions))
+	for _, c := range conversions {
+		clientConfig, err := endpoint.clientConfig(c.Path(), caBundle)
+		if err != nil {
+			return err
+		}
+		d := c.Definition
+		d.Webhook = &crd.WebhookClientConfig{URL: clientConfig.URL, CABundle: clientConfig.CABundle}
+		if s := clientConfig.Service; s != nil {
+			d.Webhook.Service = &crd.ServiceReference{Namespace: s.Namespace, Name: s.Name, Path: s.Path, Port: s.Port}
+		}
+		defs = append(defs, d)
+	}
+	return crd.Install(ctx, client, defs...)
+}
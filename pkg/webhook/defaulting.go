@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
+
+	k8sCoreV1 "github.com/nevercase/k8s-controller-custom-resource/core/v1"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/crd"
+)
+
+// Defaulting is the mutating webhook of the custom resource of Definition, whose Option fills the unspecified fields
+type Defaulting struct {
+	Definition crd.Definition
+	Option     k8sCoreV1.DefaultingOption
+}
+
+// Path returns the path of the webhook, e.g. /mutate-nevercase-io-v1-redisoperator
+func (d Defaulting) Path() string {
+	return path("mutate", d.Definition)
+}
+
+// ServeHTTP answers the AdmissionReview of the creates and the updates with the JSONPatch
+// which replaces the spec with the defaulted one, or with no patch if nothing was defaulted.
+func (d Defaulting) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	review := &admissionv1.AdmissionReview{}
+	if err := json.NewDecoder(r.Body).Decode(review); err != nil || review.Request == nil {
+		http.Error(w, fmt.Sprintf("invalid AdmissionReview: %v", err), http.StatusBadRequest)
+		return
+	}
+	review.Response = mutate(d.Option, review.Request)
+	review.Response.UID = review.Request.UID
+	review.Request = nil
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		klog.Errorf("writing the AdmissionReview of %s: %v", d.Definition.Kind, err)
+	}
+}
+
+// patchOperation is an operation of the JSONPatch, see RFC 6902
+type patchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+func mutate(opt k8sCoreV1.DefaultingOption, req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+	obj := opt.New()
+	if err := json.Unmarshal(req.Object.Raw, obj); err != nil {
+		return denied(apierrors.NewBadRequest(err.Error()))
+	}
+	if err := opt.Default(obj); err != nil {
+		return denied(apierrors.NewInternalError(err))
+	}
+	var original, defaulted struct {
+		Spec interface{} `json:"spec"`
+	}
+	if err := json.Unmarshal(req.Object.Raw, &original); err != nil {
+		return denied(apierrors.NewBadRequest(err.Error()))
+	}
+	b, err := json.Marshal(obj)
+	if err == nil {
+		err = json.Unmarshal(b, &defaulted)
+	}
+	if err != nil {
+		return denied(apierrors.NewInternalError(err))
+	}
+	if reflect.DeepEqual(original.Spec, defaulted.Spec) {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+	// The add replaces the spec if it was there
+	patch, err := json.Marshal([]patchOperation{{Op: "add", Path: "/spec", Value: defaulted.Spec}})
+	if err != nil {
+		return denied(apierrors.NewInternalError(err))
+	}
+	patchType := admissionv1.PatchTypeJSONPatch
+	return &admissionv1.AdmissionResponse{Allowed: true, Patch: patch, PatchType: &patchType}
+}
+
+// Defaultings returns the Defaultings of the Definitions whose Options in opts were the DefaultingOptions
+func Defaultings(opts k8sCoreV1.Options, defs ...crd.Definition) []Defaulting {
+	res := make([]Defaulting, 0)
+	for _, d := range defs {
+		opt, err := opts.GetWithKindName(d.Kind)
+		if err != nil {
+			continue
+		}
+		if do, ok := opt.(k8sCoreV1.DefaultingOption); ok {
+			res = append(res, Defaulting{Definition: d, Option: do})
+		}
+	}
+	return res
+}
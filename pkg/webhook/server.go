@@ -0,0 +1,84 @@
+// Package webhook serves the admission and the conversion webhooks of the custom resources over TLS,
+// and registers them into the cluster.
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// Server serves the Validations, the Defaultings and the Conversions on Port with the certificates in CertDir
+type Server struct {
+	Port    int
+	CertDir string
+
+	mux *http.ServeMux
+}
+
+// NewServer returns the Server which listens on port
+func NewServer(port int, certDir string) *Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	return &Server{Port: port, CertDir: certDir, mux: mux}
+}
+
+// Register serves the Validations on their paths
+func (s *Server) Register(validations ...Validation) {
+	for _, v := range validations {
+		klog.Infof("serving the validating webhook of %s on %s", v.Definition.Kind, v.Path())
+		s.mux.Handle(v.Path(), v)
+	}
+}
+
+// RegisterDefaultings serves the Defaultings on their paths
+func (s *Server) RegisterDefaultings(defaultings ...Defaulting) {
+	for _, d := range defaultings {
+		klog.Infof("serving the defaulting webhook of %s on %s", d.Definition.Kind, d.Path())
+		s.mux.Handle(d.Path(), d)
+	}
+}
+
+// RegisterConversions serves the Conversions on their paths
+func (s *Server) RegisterConversions(conversions ...Conversion) {
+	for _, c := range conversions {
+		klog.Infof("serving the conversion webhook of %s on %s", c.Definition.Kind, c.Path())
+		s.mux.Handle(c.Path(), c)
+	}
+}
+
+// Run serves the webhooks until stopCh was closed
+func (s *Server) Run(stopCh <-chan struct{}) error {
+	loader := newCertLoader(s.CertDir)
+	// The certificate was loaded beforehand so that a missing one fails at once
+	if _, err := loader.GetCertificate(nil); err != nil {
+		return fmt.Errorf("loading the serving certificate from %s: %v", s.CertDir, err)
+	}
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", s.Port),
+		Handler: s.mux,
+		TLSConfig: &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: loader.GetCertificate,
+		},
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		klog.Infof("starting the webhook server on %s", server.Addr)
+		errCh <- server.ListenAndServeTLS("", "")
+	}()
+	select {
+	case err := <-errCh:
+		return err
+	case <-stopCh:
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		return server.Shutdown(ctx)
+	}
+}
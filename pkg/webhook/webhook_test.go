@@ -0,0 +1,269 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	redisOperatorV1 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/redisoperator/v1"
+	redisOperatorV2 "github.com/nevercase/k8s-controller-custom-resource/pkg/apis/redisoperator/v2"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/crd"
+)
+
+// fakeOption rejects the RedisOperators without the image of the master, and any rename of the master
+type fakeOption struct{}
+
+func (fakeOption) New() runtime.Object {
+	return &redisOperatorV1.RedisOperator{}
+}
+
+func (fakeOption) ValidateCreate(obj runtime.Object) field.ErrorList {
+	foo := obj.(*redisOperatorV1.RedisOperator)
+	if foo.Spec.MasterSpec.Spec.Image == "" {
+		return field.ErrorList{field.Required(field.NewPath("spec", "masterSpec", "spec", "image"), "")}
+	}
+	return nil
+}
+
+func (fakeOption) ValidateUpdate(old, obj runtime.Object) field.ErrorList {
+	o, foo := old.(*redisOperatorV1.RedisOperator), obj.(*redisOperatorV1.RedisOperator)
+	if o.Spec.MasterSpec.Spec.Name != foo.Spec.MasterSpec.Spec.Name {
+		return field.ErrorList{field.Invalid(field.NewPath("spec", "masterSpec", "spec", "name"), foo.Spec.MasterSpec.Spec.Name, "field is immutable")}
+	}
+	return nil
+}
+
+// Default fills the replicas of the master
+func (fakeOption) Default(obj runtime.Object) error {
+	foo := obj.(*redisOperatorV1.RedisOperator)
+	if foo.Spec.MasterSpec.Spec.Replicas == nil {
+		one := int32(1)
+		foo.Spec.MasterSpec.Spec.Replicas = &one
+	}
+	return nil
+}
+
+func newRedisOperator(name, image string) []byte {
+	foo := &redisOperatorV1.RedisOperator{ObjectMeta: metav1.ObjectMeta{Name: "example-redis", Namespace: "default"}}
+	foo.Spec.MasterSpec.Spec.Name, foo.Spec.MasterSpec.Spec.Image = name, image
+	b, _ := json.Marshal(foo)
+	return b
+}
+
+func TestValidation(t *testing.T) {
+	v := Validation{Definition: crd.Definitions()[0], Option: fakeOption{}}
+	if got, want := v.Path(), "/validate-nevercase-io-v1-redisoperator"; got != want {
+		t.Errorf("Path() = %s, want %s", got, want)
+	}
+	tests := []struct {
+		name        string
+		operation   admissionv1.Operation
+		object, old []byte
+		wantAllowed bool
+		wantField   string
+	}{
+		{"allows the valid create", admissionv1.Create, newRedisOperator("cn1", "redis:6.0"), nil, true, ""},
+		{"denies the create without the image", admissionv1.Create, newRedisOperator("cn1", ""), nil, false, "spec.masterSpec.spec.image"},
+		{"denies the rename", admissionv1.Update, newRedisOperator("cn2", "redis:6.0"), newRedisOperator("cn1", "redis:6.0"), false, "spec.masterSpec.spec.name"},
+		{"allows the delete", admissionv1.Delete, nil, newRedisOperator("cn1", ""), true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			review := admissionv1.AdmissionReview{
+				TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+				Request: &admissionv1.AdmissionRequest{
+					UID:       types.UID("uid"),
+					Name:      "example-redis",
+					Namespace: "default",
+					Operation: tt.operation,
+					Object:    runtime.RawExtension{Raw: tt.object},
+					OldObject: runtime.RawExtension{Raw: tt.old},
+				},
+			}
+			b, _ := json.Marshal(review)
+			w := httptest.NewRecorder()
+			v.ServeHTTP(w, httptest.NewRequest(http.MethodPost, v.Path(), bytes.NewReader(b)))
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d: %s", w.Code, w.Body.String())
+			}
+			res := admissionv1.AdmissionReview{}
+			if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+				t.Fatal(err)
+			}
+			if res.Response == nil || res.Response.UID != "uid" {
+				t.Fatalf("response = %+v, want the UID of the request", res.Response)
+			}
+			if res.Response.Allowed != tt.wantAllowed {
+				t.Fatalf("allowed = %v, want %v", res.Response.Allowed, tt.wantAllowed)
+			}
+			if tt.wantAllowed {
+				return
+			}
+			details := res.Response.Result.Details
+			if details == nil || len(details.Causes) != 1 || details.Causes[0].Field != tt.wantField {
+				t.Errorf("details = %+v, want the cause of %s", details, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestDefaulting(t *testing.T) {
+	d := Defaulting{Definition: crd.Definitions()[0], Option: fakeOption{}}
+	if got, want := d.Path(), "/mutate-nevercase-io-v1-redisoperator"; got != want {
+		t.Errorf("Path() = %s, want %s", got, want)
+	}
+	defaulted := &redisOperatorV1.RedisOperator{}
+	_ = json.Unmarshal(newRedisOperator("cn1", "redis:6.0"), defaulted)
+	_ = fakeOption{}.Default(defaulted)
+	defaultedRaw, _ := json.Marshal(defaulted)
+
+	tests := []struct {
+		name         string
+		object       []byte
+		wantReplicas bool
+	}{
+		{"patches the replicas", newRedisOperator("cn1", "redis:6.0"), true},
+		{"leaves the defaulted object alone", defaultedRaw, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			review := admissionv1.AdmissionReview{
+				TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+				Request: &admissionv1.AdmissionRequest{
+					UID:       types.UID("uid"),
+					Operation: admissionv1.Create,
+					Object:    runtime.RawExtension{Raw: tt.object},
+				},
+			}
+			b, _ := json.Marshal(review)
+			w := httptest.NewRecorder()
+			d.ServeHTTP(w, httptest.NewRequest(http.MethodPost, d.Path(), bytes.NewReader(b)))
+			res := admissionv1.AdmissionReview{}
+			if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+				t.Fatal(err)
+			}
+			if res.Response == nil || res.Response.UID != "uid" || !res.Response.Allowed {
+				t.Fatalf("response = %+v, want the allowed response of the request", res.Response)
+			}
+			if !tt.wantReplicas {
+				if len(res.Response.Patch) != 0 {
+					t.Errorf("patch = %s, want none", res.Response.Patch)
+				}
+				return
+			}
+			var patch []struct {
+				Op    string                            `json:"op"`
+				Path  string                            `json:"path"`
+				Value redisOperatorV1.RedisOperatorSpec `json:"value"`
+			}
+			if err := json.Unmarshal(res.Response.Patch, &patch); err != nil {
+				t.Fatal(err)
+			}
+			if len(patch) != 1 || patch[0].Op != "add" || patch[0].Path != "/spec" {
+				t.Fatalf("patch = %s, want the add of /spec", res.Response.Patch)
+			}
+			if r := patch[0].Value.MasterSpec.Spec.Replicas; r == nil || *r != 1 {
+				t.Errorf("patched replicas = %v, want 1", r)
+			}
+		})
+	}
+}
+
+func TestConversion(t *testing.T) {
+	c := Conversions(crd.Definitions()...)[0]
+	if got, want := c.Path(), "/convert-nevercase-io-v1-redisoperator"; got != want {
+		t.Errorf("Path() = %s, want %s", got, want)
+	}
+	convert := func(desired string, objects ...[]byte) crd.ConversionResponse {
+		review := crd.ConversionReview{
+			TypeMeta: metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "ConversionReview"},
+			Request:  &crd.ConversionRequest{UID: types.UID("uid"), DesiredAPIVersion: desired},
+		}
+		for _, obj := range objects {
+			review.Request.Objects = append(review.Request.Objects, runtime.RawExtension{Raw: obj})
+		}
+		b, _ := json.Marshal(review)
+		w := httptest.NewRecorder()
+		c.ServeHTTP(w, httptest.NewRequest(http.MethodPost, c.Path(), bytes.NewReader(b)))
+		res := crd.ConversionReview{}
+		if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil || res.Response == nil {
+			t.Fatalf("invalid ConversionReview %s: %v", w.Body.String(), err)
+		}
+		if res.Response.UID != "uid" {
+			t.Errorf("uid = %s, want the one of the request", res.Response.UID)
+		}
+		return *res.Response
+	}
+
+	v1Object := &redisOperatorV1.RedisOperator{}
+	_ = json.Unmarshal(newRedisOperator("cn1", "redis:6.0"), v1Object)
+	v1Object.APIVersion, v1Object.Kind = redisOperatorV1.SchemeGroupVersion.String(), "RedisOperator"
+	v1Raw, _ := json.Marshal(v1Object)
+	res := convert(redisOperatorV2.SchemeGroupVersion.String(), v1Raw)
+	if res.Result.Status != metav1.StatusSuccess || len(res.ConvertedObjects) != 1 {
+		t.Fatalf("v1 -> v2 = %+v, want the converted object", res)
+	}
+	v2Object := &redisOperatorV2.RedisOperator{}
+	_ = json.Unmarshal(res.ConvertedObjects[0].Raw, v2Object)
+	if v2Object.APIVersion != redisOperatorV2.SchemeGroupVersion.String() || v2Object.Spec.Master.Image != "redis:6.0" {
+		t.Errorf("v1 -> v2 = %+v, want the v2 object with the image of the master", v2Object)
+	}
+
+	v2Object.Spec.Mode = redisOperatorV2.RedisModeSentinel
+	v2Raw, _ := json.Marshal(v2Object)
+	res = convert(redisOperatorV1.SchemeGroupVersion.String(), v2Raw)
+	if res.Result.Status != metav1.StatusSuccess || len(res.ConvertedObjects) != 1 {
+		t.Fatalf("v2 -> v1 = %+v, want the converted object", res)
+	}
+	stored := &redisOperatorV1.RedisOperator{}
+	_ = json.Unmarshal(res.ConvertedObjects[0].Raw, stored)
+	if _, ok := stored.Annotations[redisOperatorV2.ConversionAnnotation]; !ok || stored.Spec.MasterSpec.Spec.Image != "redis:6.0" {
+		t.Errorf("v2 -> v1 = %+v, want the v1 object keeping the mode in the annotation", stored)
+	}
+
+	if res = convert("nevercase.io/v3", v1Raw); res.Result.Status != metav1.StatusFailure {
+		t.Errorf("v1 -> v3 = %+v, want the failure of the unknown version", res)
+	}
+}
+
+func TestEnsureSelfSignedCerts(t *testing.T) {
+	dir := t.TempDir()
+	ca, err := EnsureSelfSignedCerts(dir, []string{"localhost", "127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The existing certificates were kept
+	again, err := EnsureSelfSignedCerts(dir, []string{"localhost"})
+	if err != nil || !bytes.Equal(ca, again) {
+		t.Fatalf("EnsureSelfSignedCerts() regenerated the certificates, err = %v", err)
+	}
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{GetCertificate: newCertLoader(dir).GetCertificate})
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})}
+	go server.Serve(listener)
+	defer server.Close()
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		t.Fatal("invalid ca.crt")
+	}
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}}
+	res, err := client.Get("https://" + listener.Addr().String())
+	if err != nil {
+		t.Fatalf("the serving certificate was not trusted by the CA: %v", err)
+	}
+	res.Body.Close()
+}
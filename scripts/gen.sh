@@ -10,6 +10,11 @@ ROOT_PACKAGE="github.com/nevercase/k8s-controller-custom-resource"
 CUSTOM_RESOURCE_NAME=${CRD}
 # API Version
 CUSTOM_RESOURCE_VERSION="v1"
+# The versions of the clients, the protobuf was only generated for the storage version
+CUSTOM_RESOURCE_VERSIONS="v1"
+case "${CUSTOM_RESOURCE_NAME}" in
+  redisoperator|mysqloperator) CUSTOM_RESOURCE_VERSIONS="v1,v2" ;;
+esac
 
 if [ "${GENS}" = "api" ] || grep -qw "api" <<<"${GENS}"; then
   cp ${GOPATH}/bin/go-to-protobuf-api ${GOPATH}/bin/go-to-protobuf
@@ -24,15 +29,20 @@ if [ "${GENS}" = "api" ] || grep -qw "api" <<<"${GENS}"; then
 fi
 
 if [ "${GENS}" = "crd" ] || grep -qw "crd" <<<"${GENS}"; then
-  cp ${GOPATH}/bin/go-to-protobuf-crd ${GOPATH}/bin/go-to-protobuf
-  Packages="$ROOT_PACKAGE/pkg/apis/$CUSTOM_RESOURCE_NAME/$CUSTOM_RESOURCE_VERSION"
-  "${GOPATH}/bin/go-to-protobuf" \
+  # The generated.proto and the generated.pb.go were generated from the types.go by the stock go-to-protobuf,
+  # they mustn't be edited by hand. The leading "-" references the messages of k8s.io/api/core/v1 rather than
+  # generating them again. The protoc must be on the PATH.
+  GOBIN=${GOPATH}/bin go install k8s.io/code-generator/cmd/go-to-protobuf@v0.20.4
+  GOBIN=${GOPATH}/bin go install k8s.io/code-generator/cmd/go-to-protobuf/protoc-gen-gogo@v0.20.4
+  Packages="-k8s.io/api/core/v1,$ROOT_PACKAGE/pkg/apis/$CUSTOM_RESOURCE_NAME/$CUSTOM_RESOURCE_VERSION"
+  PATH=${GOPATH}/bin:${PATH} "${GOPATH}/bin/go-to-protobuf" \
      --packages "${Packages}" \
      --clean=false \
      --only-idl=false \
      --keep-gogoproto=false \
      --verify-only=false \
-     --proto-import ${GOPATH}/src/k8s.io/api/core/v1
+     --proto-import ${GOPATH}/src/k8s.io/api/core/v1 \
+     --proto-import ${GOPATH}/src/github.com/gogo/protobuf/protobuf
 fi
 
 if [ "${GENS}" = "api" ] || grep -qw "api" <<<"${GENS}"; then
@@ -48,5 +58,5 @@ fi
 
 if [ "${GENS}" = "crd" ] || grep -qw "crd" <<<"${GENS}"; then
   # 执行代码自动生成，其中pkg/client是生成目标目录，pkg/apis是类型定义目录
-  ${GOPATH}/src/k8s.io/code-generator/generate-groups.sh all "$ROOT_PACKAGE/pkg/generated/$CUSTOM_RESOURCE_NAME" "$ROOT_PACKAGE/pkg/apis" "$CUSTOM_RESOURCE_NAME:$CUSTOM_RESOURCE_VERSION"
+  ${GOPATH}/src/k8s.io/code-generator/generate-groups.sh all "$ROOT_PACKAGE/pkg/generated/$CUSTOM_RESOURCE_NAME" "$ROOT_PACKAGE/pkg/apis" "$CUSTOM_RESOURCE_NAME:$CUSTOM_RESOURCE_VERSIONS"
 fi